@@ -0,0 +1,156 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BatchResult is the outcome of validating one feed of a batch.
+type BatchResult struct {
+	// Index is the position of the feed in the paths given to ValidateBatch.
+	Index int `json:"-"`
+
+	// Name identifies the feed (the file name without extension).
+	Name string `json:"name"`
+
+	// Path is the path of the feed.
+	Path string `json:"path"`
+
+	// Report is the validation report of the feed, nil if it could not be validated.
+	Report *ValidationReport `json:"report,omitempty"`
+
+	// Err is the error returned by the validation, if any.
+	Err error `json:"-"`
+
+	// Duration is how long the feed took to validate.
+	Duration time.Duration `json:"duration"`
+}
+
+// ValidateBatch validates many feeds concurrently, see ValidateBatchWithContext.
+func (v *validatorImpl) ValidateBatch(paths []string) <-chan BatchResult {
+	return v.ValidateBatchWithContext(context.Background(), paths)
+}
+
+// ValidateBatchWithContext validates each feed (ZIP file or directory) independently
+// and sends its result on the returned channel as soon as it completes. The channel
+// is closed once every feed has been validated.
+//
+// ParallelWorkers is a budget shared by the whole batch: up to ParallelWorkers feeds
+// are validated at once and the workers are split between them, so a batch uses
+// about as many goroutines as validating a single feed. When ctx is canceled the
+// feeds not started yet are reported with the context error.
+func (v *validatorImpl) ValidateBatchWithContext(ctx context.Context, paths []string) <-chan BatchResult {
+	results := make(chan BatchResult, len(paths))
+	if len(paths) == 0 {
+		close(results)
+		return results
+	}
+
+	budget := v.config.ParallelWorkers
+	if budget <= 0 {
+		budget = runtime.NumCPU()
+	}
+	concurrency := budget
+	if concurrency > len(paths) {
+		concurrency = len(paths)
+	}
+
+	feedConfig := *v.config
+	feedConfig.ParallelWorkers = budget / concurrency
+	feedValidator := &validatorImpl{config: &feedConfig}
+
+	names := feedNames(paths)
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	go func() {
+		defer close(results)
+		for i, path := range paths {
+			result := BatchResult{Index: i, Name: names[i], Path: path}
+
+			select {
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+				results <- result
+				continue
+			case slots <- struct{}{}:
+			}
+
+			wg.Add(1)
+			go func(result BatchResult) {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				startTime := time.Now()
+				result.Report, result.Err = feedValidator.ValidateFileWithContext(ctx, result.Path)
+				result.Duration = time.Since(startTime)
+				results <- result
+			}(result)
+		}
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// FindFeeds expands a directory or glob pattern into the feeds it contains:
+// ZIP files and directories holding GTFS files (agency.txt or stops.txt).
+// A directory that is itself a feed is returned as is. Paths are sorted.
+func FindFeeds(pattern string) ([]string, error) {
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		if isFeedDir(pattern) {
+			return []string{pattern}, nil
+		}
+		entries, err := os.ReadDir(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", pattern, err)
+		}
+		var feeds []string
+		for _, entry := range entries {
+			path := filepath.Join(pattern, entry.Name())
+			if (entry.IsDir() && isFeedDir(path)) || (!entry.IsDir() && isZipFile(path)) {
+				feeds = append(feeds, path)
+			}
+		}
+		return feeds, nil
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+	}
+	var feeds []string
+	for _, path := range matches {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if (info.IsDir() && isFeedDir(path)) || (!info.IsDir() && isZipFile(path)) {
+			feeds = append(feeds, path)
+		}
+	}
+	sort.Strings(feeds)
+	return feeds, nil
+}
+
+// isFeedDir reports whether dir contains GTFS files at its root.
+func isFeedDir(dir string) bool {
+	for _, name := range []string{"agency.txt", "stops.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// isZipFile reports whether path has a .zip extension.
+func isZipFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".zip")
+}
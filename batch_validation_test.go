@@ -0,0 +1,110 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestValidateBatch(t *testing.T) {
+	validPath := CreateTempZip(t, MinimalValidGTFS())
+	dirPath := writeFeedDir(t, "city", MinimalValidGTFS())
+	missingPath := filepath.Join(t.TempDir(), "missing.zip")
+	paths := []string{validPath, dirPath, missingPath}
+
+	results := make([]BatchResult, len(paths))
+	count := 0
+	for result := range New(WithParallelWorkers(2)).ValidateBatch(paths) {
+		results[result.Index] = result
+		count++
+	}
+
+	if count != len(paths) {
+		t.Fatalf("Expected %d results, got %d", len(paths), count)
+	}
+	for i, result := range results {
+		if result.Path != paths[i] {
+			t.Errorf("Result %d: expected path %s, got %s", i, paths[i], result.Path)
+		}
+	}
+	if results[0].Report == nil || results[0].Err != nil {
+		t.Errorf("Expected a report for the ZIP feed, got error %v", results[0].Err)
+	}
+	if results[1].Report == nil || results[1].Name != "city" {
+		t.Errorf("Expected a report named city for the directory feed, got %q", results[1].Name)
+	}
+	if results[2].Err == nil {
+		t.Error("Expected an error for the missing feed")
+	}
+}
+
+func TestValidateBatchWithContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	paths := []string{CreateTempZip(t, MinimalValidGTFS()), CreateTempZip(t, MinimalValidGTFS())}
+	count := 0
+	for result := range New(WithParallelWorkers(1)).ValidateBatchWithContext(ctx, paths) {
+		count++
+		if result.Err == nil {
+			t.Errorf("Expected a cancellation error for %s", result.Path)
+		}
+	}
+	if count != len(paths) {
+		t.Errorf("Expected %d results, got %d", len(paths), count)
+	}
+}
+
+func TestValidateBatch_Empty(t *testing.T) {
+	for range New().ValidateBatch(nil) {
+		t.Error("Expected no results for an empty batch")
+	}
+}
+
+func TestFindFeeds(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"b.zip", "a.ZIP", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte("x"), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	feedDir := filepath.Join(root, "city")
+	otherDir := filepath.Join(root, "reports")
+	for _, dir := range []string{feedDir, otherDir} {
+		if err := os.Mkdir(dir, 0o750); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(feedDir, "stops.txt"), []byte("stop_id\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write stops.txt: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		pattern  string
+		expected []string
+	}{
+		{"directory of feeds", root, []string{"a.ZIP", "b.zip", "city"}},
+		{"feed directory", feedDir, []string{"city"}},
+		{"glob", filepath.Join(root, "*.zip"), []string{"b.zip"}},
+		{"no match", filepath.Join(root, "*.gtfs"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feeds, err := FindFeeds(tt.pattern)
+			if err != nil {
+				t.Fatalf("FindFeeds failed: %v", err)
+			}
+			var names []string
+			for _, feed := range feeds {
+				names = append(names, filepath.Base(feed))
+			}
+			if !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, names)
+			}
+		})
+	}
+}
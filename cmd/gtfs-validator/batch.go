@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	batchOutputDir   string
+	batchFormat      string
+	batchCountryCode string
+	batchMode        string
+	batchWorkers     int
+	batchMaxNotices  int
+	batchTimeout     time.Duration
+	batchTranscode   bool
+	batchRecoverCSV  bool
+)
+
+func newBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [flags] <dir-or-glob>",
+		Short: "Validate every feed in a directory",
+		Long: `Validate many independent GTFS feeds (ZIP files or feed directories) found
+in a directory or matching a glob pattern.
+
+Feeds are validated concurrently and share the --workers budget, so a batch
+uses about as much CPU as validating a single feed. A report is written for
+each feed into --output-dir, and a table of errors, warnings, expiry date
+(feed_end_date) and duration per feed is printed once all feeds are done.`,
+		Example: `  gtfs-validator batch ./feeds
+  gtfs-validator batch "./feeds/*.zip" --output-dir reports
+  gtfs-validator batch ./feeds --format html --workers 8`,
+		Args: cobra.ExactArgs(1),
+		RunE: runBatch,
+	}
+
+	cmd.Flags().StringVarP(&batchOutputDir, "output-dir", "d", "", "Directory to write one report per feed into (default: no reports)")
+	cmd.Flags().StringVarP(&batchFormat, "format", "f", "json", "Per-feed report format: json, html")
+	cmd.Flags().StringVarP(&batchCountryCode, "country", "c", "US", "Country code for validation (e.g., US, GB, FR)")
+	cmd.Flags().StringVarP(&batchMode, "mode", "m", "default", "Validation mode: performance, default, comprehensive")
+	cmd.Flags().IntVarP(&batchWorkers, "workers", "w", 4, "Number of parallel workers shared by all feeds")
+	cmd.Flags().IntVar(&batchMaxNotices, "max-notices", 100, "Maximum notices per type (0 = no limit)")
+	cmd.Flags().DurationVarP(&batchTimeout, "timeout", "t", 30*time.Minute, "Validation timeout for the whole batch")
+	cmd.Flags().BoolVar(&batchTranscode, "transcode", false, "Transcode Windows-1252, ISO-8859-1 and UTF-16 files to UTF-8 before validation")
+	cmd.Flags().BoolVar(&batchRecoverCSV, "recover-csv", false, "Skip malformed CSV rows with a csv_parsing_failed notice and resume parsing at the next line")
+
+	return cmd
+}
+
+func runBatch(cmd *cobra.Command, args []string) error {
+	validFormats := []string{"json", "html"}
+	if !contains(validFormats, batchFormat) {
+		return fmt.Errorf("❌ invalid report format: '%s'. valid formats: %s", batchFormat, strings.Join(validFormats, ", "))
+	}
+	validModes := []string{"performance", "default", "comprehensive"}
+	if !contains(validModes, batchMode) {
+		return fmt.Errorf("❌ invalid validation mode: '%s'. valid modes: %s", batchMode, strings.Join(validModes, ", "))
+	}
+
+	paths, err := gtfsvalidator.FindFeeds(args[0])
+	if err != nil {
+		return fmt.Errorf("❌ input error: %v", err)
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("❌ input error: no feeds found in '%s'", args[0])
+	}
+
+	if batchOutputDir != "" {
+		if err := os.MkdirAll(batchOutputDir, 0o750); err != nil {
+			return fmt.Errorf("❌ Output Error: Failed to create output directory '%s': %v", batchOutputDir, err)
+		}
+	}
+
+	logOpts, err := loggingOptions()
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	go func() {
+		<-sigChan
+		fmt.Fprintf(os.Stderr, "\n⚠️  Cancelling validation...\n")
+		cancel()
+	}()
+
+	validator := gtfsvalidator.New(append(logOpts,
+		gtfsvalidator.WithCountryCode(batchCountryCode),
+		gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationMode(batchMode)),
+		gtfsvalidator.WithParallelWorkers(batchWorkers),
+		gtfsvalidator.WithMaxNoticesPerType(batchMaxNotices),
+		gtfsvalidator.WithTranscoding(batchTranscode),
+		gtfsvalidator.WithCSVRecovery(batchRecoverCSV),
+	)...)
+
+	fmt.Fprintf(os.Stderr, "🚀 Validating %d feeds with %d workers...\n\n", len(paths), batchWorkers)
+	startTime := time.Now()
+
+	results := make([]gtfsvalidator.BatchResult, len(paths))
+	done := 0
+	for result := range validator.ValidateBatchWithContext(ctx, paths) {
+		done++
+		results[result.Index] = result
+
+		status := "✅"
+		switch {
+		case result.Report == nil:
+			status = "❌"
+		case result.Report.HasErrors():
+			status = "💀"
+		case result.Report.HasWarnings():
+			status = "⚠️ "
+		}
+		fmt.Fprintf(os.Stderr, "%s [%d/%d] %s (%.2fs)\n", status, done, len(paths), result.Name, result.Duration.Seconds())
+
+		if batchOutputDir != "" && result.Report != nil {
+			if err := writeBatchReport(result); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to write report for %s: %v\n", result.Name, err)
+			}
+		}
+	}
+	fmt.Fprintf(os.Stderr, "\n✅ Batch completed in %.2fs\n\n", time.Since(startTime).Seconds())
+
+	outputBatchTable(os.Stdout, results)
+
+	failed := 0
+	for _, result := range results {
+		if result.Report == nil || result.Report.HasErrors() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("❌ Validation FAILED: %d of %d feeds have errors", failed, len(results))
+	}
+	return nil
+}
+
+// writeBatchReport writes the report of one feed into the output directory.
+func writeBatchReport(result gtfsvalidator.BatchResult) error {
+	path := filepath.Join(batchOutputDir, result.Name+"."+batchFormat)
+	file, err := os.Create(path) // #nosec G304 -- Path derived from user-provided output directory
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to close report file: %v\n", err)
+		}
+	}()
+
+	if batchFormat == "html" {
+		return outputHTML(file, result.Report, result.Path)
+	}
+	return json.NewEncoder(file).Encode(result.Report)
+}
+
+// outputBatchTable prints one line per feed, in the order the feeds were found.
+func outputBatchTable(output *os.File, results []gtfsvalidator.BatchResult) {
+	write := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(output, format, args...); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write console output: %v\n", err)
+		}
+	}
+
+	write("%-30s %8s %8s %-12s %10s\n", "FEED", "ERRORS", "WARNINGS", "EXPIRES", "DURATION")
+	for _, result := range results {
+		if result.Report == nil {
+			write("%-30s ❌ %v\n", result.Name, result.Err)
+			continue
+		}
+		expires := result.Report.Summary.FeedInfo.ServiceDateTo
+		if expires == "" {
+			expires = "-"
+		}
+		write("%-30s %8d %8d %-12s %9.2fs\n",
+			result.Name, result.Report.ErrorCount(), result.Report.WarningCount(), expires, result.Duration.Seconds())
+	}
+}
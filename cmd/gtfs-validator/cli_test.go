@@ -1,14 +1,26 @@
 package main
 
 import (
+	"archive/zip"
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
+
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	gtfshttp "github.com/theoremus-urban-solutions/gtfs-validator/http"
 )
 
 // Helper to run CLI command
@@ -165,6 +177,46 @@ func TestCLI_JSONOutput(t *testing.T) {
 	}
 }
 
+func TestCLI_MobilityDataOutput(t *testing.T) {
+	testDir := createTestGTFS(t, true)
+
+	stdout, stderr, _ := runCLI(t, "-i", testDir, "-f", "mobilitydata")
+
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("Failed to parse MobilityData output: %v\nOutput: %s\nStderr: %s", err, stdout, stderr)
+	}
+	summary, ok := report["summary"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected a summary object, got: %v", report["summary"])
+	}
+	if _, exists := summary["gtfsInput"]; !exists {
+		t.Errorf("Expected gtfsInput in summary, got: %v", summary)
+	}
+	if _, ok := report["notices"].([]interface{}); !ok {
+		t.Errorf("Expected a notices array, got: %v", report["notices"])
+	}
+}
+
+func TestCLI_Lang(t *testing.T) {
+	testDir := createTestGTFS(t, true)
+
+	stdout, stderr, _ := runCLI(t, "-i", testDir, "-f", "html", "--lang", "fr")
+	if !strings.Contains(stdout, `<html lang="fr">`) || !strings.Contains(stdout, "Rapport de validation GTFS") {
+		t.Errorf("Expected a French HTML report, got: %s\nStderr: %s", stdout, stderr)
+	}
+
+	stdout, _, _ = runCLI(t, "-i", testDir, "-f", "json", "--lang", "fr")
+	if !strings.Contains(stdout, `"locale":"fr"`) {
+		t.Errorf("Expected the report locale in JSON output, got: %s", stdout)
+	}
+
+	_, stderr, exitCode := runCLI(t, "-i", testDir, "--lang", "fr_FR!")
+	if exitCode == 0 || !strings.Contains(stderr, "invalid language") {
+		t.Errorf("Expected an invalid language error, got exit code %d: %s", exitCode, stderr)
+	}
+}
+
 func TestCLI_SummaryOutput(t *testing.T) {
 	testDir := createTestGTFS(t, true)
 
@@ -357,3 +409,713 @@ func TestCLI_Timeout(t *testing.T) {
 		t.Logf("STDERR: %s", stderr)
 	}
 }
+
+func TestCLI_LogFlags(t *testing.T) {
+	testDir := createTestGTFS(t, true)
+
+	stdout, stderr, _ := runCLI(t, "-i", testDir, "-f", "json", "--log-level", "debug", "--log-format", "json")
+
+	var entry map[string]interface{}
+	found := false
+	for _, line := range strings.Split(stderr, "\n") {
+		if json.Unmarshal([]byte(line), &entry) == nil && entry["message"] == "Loaded feed" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("Expected a JSON 'Loaded feed' log entry in stderr, got: %s", stderr)
+	}
+	if strings.Contains(stdout, "Validator started") {
+		t.Error("Expected logs on stderr only")
+	}
+
+	_, stderr, exitCode := runCLI(t, "-i", testDir, "--log-level", "verbose")
+	if exitCode == 0 || !strings.Contains(stderr, "invalid log level") {
+		t.Errorf("Expected an invalid log level error, got exit code %d: %s", exitCode, stderr)
+	}
+}
+
+func TestCLI_CompareReports(t *testing.T) {
+	dir := t.TempDir()
+	previous := `{"summary":{"date":"2025-01-01","feedInfo":{"feedPath":"old.zip"},"counts":{"errors":1,"warnings":0,"infos":0,"total":1}},` +
+		`"notices":[{"code":"duplicate_key","severity":"ERROR","totalNotices":1,"sampleNotices":[{"stopId":"S1"}]}]}`
+	current := `{"summary":{"date":"2025-01-02","feedInfo":{"feedPath":"new.zip"},"counts":{"errors":2,"warnings":0,"infos":0,"total":2}},` +
+		`"notices":[{"code":"foreign_key_violation","severity":"ERROR","totalNotices":2,"sampleNotices":[{"routeId":"R42"}]}]}`
+
+	previousPath := filepath.Join(dir, "previous.json")
+	currentPath := filepath.Join(dir, "current.json")
+	if err := os.WriteFile(previousPath, []byte(previous), 0600); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	if err := os.WriteFile(currentPath, []byte(current), 0600); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+
+	stdout, stderr, exitCode := runCLI(t, "compare-reports", previousPath, currentPath, "-f", "json")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+
+	var comparison map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &comparison); err != nil {
+		t.Fatalf("Failed to parse JSON comparison: %v\nOutput: %s", err, stdout)
+	}
+	for _, key := range []string{"newCodes", "resolvedCodes", "changedCodes", "severityDeltas", "newlyAffectedEntities"} {
+		if _, exists := comparison[key]; !exists {
+			t.Errorf("Expected %q field in comparison output", key)
+		}
+	}
+
+	_, _, exitCode = runCLI(t, "compare-reports", previousPath, currentPath, "--fail-on-regression")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code when a regression is detected")
+	}
+}
+
+func TestCLI_Render(t *testing.T) {
+	dir := t.TempDir()
+	reportPath := filepath.Join(dir, "report.json")
+	stored := `{"schemaVersion":"1.0","summary":{"date":"2025-01-01","feedInfo":{"feedPath":"feed.zip"},"counts":{"errors":1,"warnings":0,"infos":0,"total":1}},` +
+		`"notices":[{"code":"duplicate_key","severity":"ERROR","totalNotices":1,"sampleNotices":[{"filename":"stops.txt","stopId":"S1","csvRowNumber":3}]}]}`
+	if err := os.WriteFile(reportPath, []byte(stored), 0600); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+
+	stdout, stderr, exitCode := runCLI(t, "render", reportPath, "-f", "html")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	if !strings.Contains(stdout, "<html") || !strings.Contains(stdout, "duplicate_key") {
+		t.Errorf("Expected an HTML report with the stored notice, got: %s", stdout)
+	}
+
+	stdout, stderr, exitCode = runCLI(t, "render", reportPath, "-f", "sarif")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	if !strings.Contains(stdout, `"version": "2.1.0"`) && !strings.Contains(stdout, `"version":"2.1.0"`) {
+		t.Errorf("Expected a SARIF log, got: %s", stdout)
+	}
+
+	stdout, stderr, exitCode = runCLI(t, "render", reportPath, "-f", "mobilitydata")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	if !strings.Contains(stdout, `"oldCsvRowNumber": 3`) {
+		t.Errorf("Expected canonical context keys in the MobilityData report, got: %s", stdout)
+	}
+
+	stdout, stderr, exitCode = runCLI(t, "render", reportPath, "-f", "html", "--lang", "fr")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	if !strings.Contains(stdout, "Rapport de validation GTFS") {
+		t.Errorf("Expected a French HTML report, got: %s", stdout)
+	}
+
+	future := filepath.Join(dir, "future.json")
+	if err := os.WriteFile(future, []byte(`{"schemaVersion":"9.0","summary":{}}`), 0600); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	_, stderr, exitCode = runCLI(t, "render", future)
+	if exitCode == 0 || !strings.Contains(stderr, "unsupported report schema version") {
+		t.Errorf("Expected an unsupported version error, got exit code %d: %s", exitCode, stderr)
+	}
+}
+
+func TestCLI_Schema(t *testing.T) {
+	stdout, stderr, exitCode := runCLI(t, "schema")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &schema); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	if id, _ := schema["$id"].(string); !strings.HasSuffix(id, "/report-1.1.schema.json") {
+		t.Errorf("Expected a versioned $id, got %v", schema["$id"])
+	}
+	defs, _ := schema["$defs"].(map[string]interface{})
+	if _, exists := defs["context.duplicate_key"]; !exists {
+		t.Error("Expected a context definition for duplicate_key")
+	}
+}
+
+func TestCLI_PreviousFeedDiff(t *testing.T) {
+	previousDir := createTestGTFS(t, true)
+	currentDir := createTestGTFS(t, true)
+
+	stops := "stop_id,stop_name,stop_lat,stop_lon\nstop_1,First Stop,40.7589,-73.9851\nstop_2,Second Stop,40.7614,-73.9776\nstop_3,Closed Stop,40.7700,-73.9700\n"
+	if err := os.WriteFile(filepath.Join(previousDir, "stops.txt"), []byte(stops), 0600); err != nil {
+		t.Fatalf("Failed to write stops.txt: %v", err)
+	}
+
+	stdout, stderr, _ := runCLI(t, "-i", currentDir, "--previous", previousDir, "-f", "json")
+	if !strings.Contains(stderr, "Previous feed") {
+		t.Errorf("Expected previous feed in startup message, got: %s", stderr)
+	}
+	if !strings.Contains(stdout, "stop_removed") {
+		t.Errorf("Expected stop_removed notice in output, got: %s", stdout)
+	}
+
+	_, stderr, exitCode := runCLI(t, "-i", currentDir, "--previous", "/nonexistent/feed")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for missing previous feed")
+	}
+	if !strings.Contains(stderr, "previous feed path does not exist") {
+		t.Errorf("Expected previous feed error, got: %s", stderr)
+	}
+}
+
+func TestCLI_Rules(t *testing.T) {
+	stdout, stderr, exitCode := runCLI(t, "rules", "--format", "json", "--severity", "error", "--category", "core")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+
+	var rules []map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &rules); err != nil {
+		t.Fatalf("Failed to parse JSON rules: %v\nOutput: %s", err, stdout)
+	}
+	if len(rules) == 0 {
+		t.Fatal("Expected at least one core error rule")
+	}
+	for _, rule := range rules {
+		if rule["severity"] != "ERROR" || rule["category"] != "core" {
+			t.Errorf("Filter not applied: %v", rule)
+		}
+	}
+
+	stdout, _, exitCode = runCLI(t, "rules", "--format", "markdown")
+	if exitCode != 0 || !strings.Contains(stdout, "| `duplicate_key` |") {
+		t.Errorf("Expected markdown table with duplicate_key, got exit %d: %s", exitCode, stdout)
+	}
+
+	_, _, exitCode = runCLI(t, "rules", "--format", "xml")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for invalid format")
+	}
+}
+
+func TestCLI_Explain(t *testing.T) {
+	stdout, stderr, exitCode := runCLI(t, "explain", "duplicate_key")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	for _, expected := range []string{"duplicate_key", "Severity:   ERROR", "core.DuplicateKeyValidator", "How to fix"} {
+		if !strings.Contains(stdout, expected) {
+			t.Errorf("Expected %q in explain output, got: %s", expected, stdout)
+		}
+	}
+
+	_, stderr, exitCode = runCLI(t, "explain", "not_a_real_code")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for unknown code")
+	}
+	if !strings.Contains(stderr, "unknown notice code") {
+		t.Errorf("Expected unknown code error, got: %s", stderr)
+	}
+}
+
+func TestCLI_FilterRoute(t *testing.T) {
+	testDir := createTestGTFS(t, true)
+	routes := "route_id,agency_id,route_short_name,route_long_name,route_type\nroute_1,test_agency,1,Main Street Line,3\nroute_2,test_agency,2,Second Line,999\n"
+	if err := os.WriteFile(filepath.Join(testDir, "routes.txt"), []byte(routes), 0600); err != nil {
+		t.Fatalf("Failed to write routes.txt: %v", err)
+	}
+
+	stdout, stderr, exitCode := runCLI(t, "-i", testDir, "-f", "json", "--filter-route", "route_2")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for route with errors")
+	}
+	if !strings.Contains(stderr, "referencing route 'route_2'") {
+		t.Errorf("Expected filter note in stderr, got: %s", stderr)
+	}
+	if !strings.Contains(stdout, "invalid_route_type") {
+		t.Errorf("Expected invalid_route_type notice in output, got: %s", stdout)
+	}
+
+	stdout, _, _ = runCLI(t, "-i", testDir, "-f", "json", "--filter-route", "route_1")
+	if strings.Contains(stdout, "invalid_route_type") {
+		t.Errorf("Did not expect route_2 notices when filtering route_1, got: %s", stdout)
+	}
+
+	stdout, _, _ = runCLI(t, "-i", testDir, "-f", "json", "--filter-agency", "test_agency")
+	if !strings.Contains(stdout, "invalid_route_type") {
+		t.Errorf("Expected agency filter to include route notices, got: %s", stdout)
+	}
+}
+
+// Helper to zip a test feed directory
+func zipTestGTFS(t *testing.T, testDir string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	entries, err := os.ReadDir(testDir)
+	if err != nil {
+		t.Fatalf("Failed to read test feed: %v", err)
+	}
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(testDir, entry.Name())) // #nosec G304 -- Test code with controlled paths
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", entry.Name(), err)
+		}
+		w, err := writer.Create(entry.Name())
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatalf("Failed to write zip entry: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close zip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestCLI_ValidateURL(t *testing.T) {
+	zipData := zipTestGTFS(t, createTestGTFS(t, true))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gtfs.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write(zipData)
+	}))
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	stdout, stderr, _ := runCLI(t, "-i", server.URL+"/gtfs.zip", "-f", "json", "--cache-dir", cacheDir)
+	if !strings.Contains(stderr, "📥 Downloaded") {
+		t.Errorf("Expected download message in stderr, got: %s", stderr)
+	}
+
+	var report struct {
+		Summary struct {
+			FeedInfo struct {
+				FeedPath string `json:"feedPath"`
+				Download struct {
+					FinalURL string `json:"finalUrl"`
+					ETag     string `json:"etag"`
+				} `json:"download"`
+			} `json:"feedInfo"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, stdout)
+	}
+	if report.Summary.FeedInfo.FeedPath != server.URL+"/gtfs.zip" || report.Summary.FeedInfo.Download.ETag != `"v1"` {
+		t.Errorf("Expected download info in feedInfo, got %+v", report.Summary.FeedInfo)
+	}
+
+	_, stderr, _ = runCLI(t, "-i", server.URL+"/gtfs.zip", "--cache-dir", cacheDir)
+	if !strings.Contains(stderr, "not modified") {
+		t.Errorf("Expected cached copy to be used, got: %s", stderr)
+	}
+
+	_, stderr, exitCode := runCLI(t, "-i", server.URL+"/missing.zip")
+	if exitCode == 0 || !strings.Contains(stderr, "failed to download feed") {
+		t.Errorf("Expected download error, got exit code %d and stderr: %s", exitCode, stderr)
+	}
+}
+
+func TestCLI_Multi(t *testing.T) {
+	metroDir := createTestGTFS(t, true)
+	busDir := createTestGTFS(t, true)
+
+	stdout, stderr, exitCode := runCLI(t, "multi", metroDir, busDir)
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for colliding IDs")
+	}
+	if !strings.Contains(stdout, "cross_feed_id_collision") || !strings.Contains(stdout, "stop_id 'stop_1'") {
+		t.Errorf("Expected ID collisions in output, got: %s", stdout)
+	}
+	if !strings.Contains(stdout, "cross_feed_nearby_stops") {
+		t.Errorf("Expected nearby stops in output, got: %s", stdout)
+	}
+	if !strings.Contains(stderr, "Validation FAILED") {
+		t.Errorf("Expected failure message in stderr, got: %s", stderr)
+	}
+
+	stdout, _, _ = runCLI(t, "multi", metroDir, busDir, "-f", "json", "--stop-distance", "0")
+	var report struct {
+		Feeds     []map[string]interface{} `json:"feeds"`
+		CrossFeed struct {
+			Notices []struct {
+				Code string `json:"code"`
+			} `json:"notices"`
+		} `json:"crossFeed"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, stdout)
+	}
+	if len(report.Feeds) != 2 {
+		t.Errorf("Expected 2 feed reports, got %d", len(report.Feeds))
+	}
+	for _, group := range report.CrossFeed.Notices {
+		if group.Code == "cross_feed_nearby_stops" {
+			t.Error("Did not expect nearby stops with --stop-distance 0")
+		}
+	}
+
+	_, stderr, exitCode = runCLI(t, "multi", metroDir)
+	if exitCode == 0 || !strings.Contains(stderr, "requires at least 2 arg") {
+		t.Errorf("Expected an error for a single feed, got exit code %d: %s", exitCode, stderr)
+	}
+}
+
+func TestCLI_Batch(t *testing.T) {
+	feedsDir := t.TempDir()
+	for name, valid := range map[string]bool{"good": true, "bad": false} {
+		source := createTestGTFS(t, valid)
+		target := filepath.Join(feedsDir, name)
+		if err := os.Mkdir(target, 0o750); err != nil {
+			t.Fatalf("Failed to create feed directory: %v", err)
+		}
+		entries, err := os.ReadDir(source)
+		if err != nil {
+			t.Fatalf("Failed to read test feed: %v", err)
+		}
+		for _, entry := range entries {
+			data, err := os.ReadFile(filepath.Join(source, entry.Name())) // #nosec G304 -- Test code with controlled paths
+			if err != nil {
+				t.Fatalf("Failed to read %s: %v", entry.Name(), err)
+			}
+			if err := os.WriteFile(filepath.Join(target, entry.Name()), data, 0o600); err != nil {
+				t.Fatalf("Failed to write %s: %v", entry.Name(), err)
+			}
+		}
+	}
+	feedInfo := "feed_publisher_name,feed_publisher_url,feed_lang,feed_start_date,feed_end_date\nTest,https://example.com,en,20250101,20251231\n"
+	if err := os.WriteFile(filepath.Join(feedsDir, "good", "feed_info.txt"), []byte(feedInfo), 0o600); err != nil {
+		t.Fatalf("Failed to write feed_info.txt: %v", err)
+	}
+	reportsDir := filepath.Join(t.TempDir(), "reports")
+
+	stdout, stderr, exitCode := runCLI(t, "batch", feedsDir, "--output-dir", reportsDir)
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for a feed with errors")
+	}
+	if !strings.Contains(stdout, "FEED") || !strings.Contains(stdout, "EXPIRES") {
+		t.Errorf("Expected a summary table header, got: %s", stdout)
+	}
+	if !strings.Contains(stdout, "good") || !strings.Contains(stdout, "bad") {
+		t.Errorf("Expected both feeds in the summary table, got: %s", stdout)
+	}
+	if !strings.Contains(stdout, "20251231") {
+		t.Errorf("Expected the feed_end_date of the valid feed, got: %s", stdout)
+	}
+	if !strings.Contains(stderr, "[2/2]") {
+		t.Errorf("Expected progress for each feed in stderr, got: %s", stderr)
+	}
+	for _, name := range []string{"good.json", "bad.json"} {
+		data, err := os.ReadFile(filepath.Join(reportsDir, name)) // #nosec G304 -- Test code with controlled paths
+		if err != nil {
+			t.Errorf("Expected report %s: %v", name, err)
+			continue
+		}
+		var report map[string]interface{}
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Errorf("Report %s is not valid JSON: %v", name, err)
+		}
+	}
+
+	_, stderr, exitCode = runCLI(t, "batch", t.TempDir())
+	if exitCode == 0 || !strings.Contains(stderr, "no feeds found") {
+		t.Errorf("Expected an error for a directory without feeds, got exit code %d: %s", exitCode, stderr)
+	}
+
+	_, stderr, exitCode = runCLI(t, "batch", feedsDir, "--format", "csv")
+	if exitCode == 0 || !strings.Contains(stderr, "invalid report format") {
+		t.Errorf("Expected an error for an invalid format, got exit code %d: %s", exitCode, stderr)
+	}
+}
+
+func TestCLI_Watch(t *testing.T) {
+	feedDir := createTestGTFS(t, true)
+
+	cliPath := filepath.Join(t.TempDir(), "gtfs-validator-test")
+	build := exec.Command("go", "build", "-o", cliPath, ".") // #nosec G204 -- Test code with controlled paths
+	if err := build.Run(); err != nil {
+		t.Fatalf("Failed to build CLI: %v", err)
+	}
+
+	cmd := exec.Command(cliPath, "watch", feedDir, "--interval", "50ms", "--debounce", "100ms") // #nosec G204 -- Test code with controlled paths
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("Failed to open stdout: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("Failed to start CLI: %v", err)
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	// waitFor returns the output up to the first line containing text
+	waitFor := func(text string) string {
+		t.Helper()
+		var output strings.Builder
+		timeout := time.After(30 * time.Second)
+		for {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					t.Fatalf("CLI exited before printing %q: %s", text, output.String())
+				}
+				output.WriteString(line + "\n")
+				if strings.Contains(line, text) {
+					return output.String()
+				}
+			case <-timeout:
+				t.Fatalf("Timed out waiting for %q: %s", text, output.String())
+			}
+		}
+	}
+
+	waitFor("Validated")
+
+	stops := "stop_id,stop_name,stop_lat,stop_lon\nstop_1,First Stop,95.0,-73.9851\nstop_2,Second Stop,40.7614,-73.9776\n"
+	if err := os.WriteFile(filepath.Join(feedDir, "stops.txt"), []byte(stops), 0o600); err != nil {
+		t.Fatalf("Failed to update stops.txt: %v", err)
+	}
+
+	output := waitFor("Changed: stops.txt")
+	output += waitFor("New Notice Codes")
+	if !strings.Contains(output, "Re-ran") {
+		t.Errorf("Expected the number of re-run validators, got: %s", output)
+	}
+
+	if err := cmd.Process.Signal(syscall.SIGINT); err != nil {
+		t.Fatalf("Failed to interrupt CLI: %v", err)
+	}
+	// Drain the output so the CLI can exit
+	for range lines {
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Errorf("Expected watch to exit cleanly on interrupt, got: %v", err)
+	}
+}
+
+func TestCLI_Serve(t *testing.T) {
+	dataDir := t.TempDir()
+	feedDir := createTestGTFS(t, true)
+	if err := os.Rename(feedDir, filepath.Join(dataDir, "feed")); err != nil {
+		t.Fatalf("Failed to move test feed: %v", err)
+	}
+
+	jobs, err := newJobServer(jobServerConfig{
+		Workers:         1,
+		QueueSize:       4,
+		MaxUploadSize:   1024 * 1024,
+		JobTimeout:      time.Minute,
+		JobTTL:          time.Hour,
+		DataDir:         dataDir,
+		CountryCode:     "US",
+		Mode:            "default",
+		MaxNotices:      100,
+		ParallelWorkers: 2,
+		QueryLimits:     gtfshttp.QueryLimits{Modes: []gtfsvalidator.ValidationMode{gtfsvalidator.ValidationModePerformance}, MaxNotices: 50},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create job server: %v", err)
+	}
+	server := httptest.NewServer(jobs.handler())
+	defer server.Close()
+	defer jobs.shutdown(context.Background())
+
+	// submit posts a request to /jobs and decodes the response
+	submit := func(t *testing.T, query, contentType string, body []byte) (int, jobResponse) {
+		t.Helper()
+		resp, err := http.Post(server.URL+"/jobs"+query, contentType, bytes.NewReader(body)) // #nosec G107 -- Test server URL
+		if err != nil {
+			t.Fatalf("Failed to submit job: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var job jobResponse
+		_ = json.NewDecoder(resp.Body).Decode(&job)
+		return resp.StatusCode, job
+	}
+
+	// waitForJob polls a job until it has finished
+	waitForJob := func(t *testing.T, id string) jobResponse {
+		t.Helper()
+		deadline := time.Now().Add(30 * time.Second)
+		for time.Now().Before(deadline) {
+			resp, err := http.Get(server.URL + "/jobs/" + id)
+			if err != nil {
+				t.Fatalf("Failed to get job status: %v", err)
+			}
+			var job jobResponse
+			err = json.NewDecoder(resp.Body).Decode(&job)
+			_ = resp.Body.Close()
+			if err != nil {
+				t.Fatalf("Failed to decode job status: %v", err)
+			}
+			if job.FinishedAt != nil {
+				return job
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		t.Fatalf("Timed out waiting for job %s", id)
+		return jobResponse{}
+	}
+
+	t.Run("multipart upload", func(t *testing.T) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", "feed.zip")
+		if err != nil {
+			t.Fatalf("Failed to create form file: %v", err)
+		}
+		if _, err := part.Write(zipTestGTFS(t, filepath.Join(dataDir, "feed"))); err != nil {
+			t.Fatalf("Failed to write form file: %v", err)
+		}
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Failed to close multipart writer: %v", err)
+		}
+
+		status, job := submit(t, "?mode=performance", writer.FormDataContentType(), body.Bytes())
+		if status != http.StatusAccepted || job.ID == "" || job.Feed != "feed.zip" {
+			t.Fatalf("Expected 202 with a job ID, got %d %+v", status, job)
+		}
+
+		finished := waitForJob(t, job.ID)
+		if finished.Status != jobSucceeded || finished.Counts == nil || finished.Progress.PercentComplete != 100 {
+			t.Fatalf("Expected a succeeded job with counts, got %+v", finished)
+		}
+
+		// The event stream replays progress and notices and ends with the job
+		resp, err := http.Get(server.URL + "/jobs/" + job.ID + "/events")
+		if err != nil {
+			t.Fatalf("Failed to open event stream: %v", err)
+		}
+		events, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatalf("Failed to read event stream: %v", err)
+		}
+		if resp.Header.Get("Content-Type") != "text/event-stream" {
+			t.Errorf("Expected text/event-stream, got %s", resp.Header.Get("Content-Type"))
+		}
+		for _, event := range []string{"event: progress", "event: done"} {
+			if !strings.Contains(string(events), event) {
+				t.Errorf("Expected %q in event stream, got: %s", event, events)
+			}
+		}
+
+		resp, err = http.Get(server.URL + "/jobs/" + job.ID + "/report")
+		if err != nil {
+			t.Fatalf("Failed to get report: %v", err)
+		}
+		var report map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&report)
+		_ = resp.Body.Close()
+		if err != nil || report["summary"] == nil {
+			t.Errorf("Expected a JSON report, got %v (%v)", report, err)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/jobs/"+job.ID+"/report", nil)
+		req.Header.Set("Accept", "text/html")
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to get HTML report: %v", err)
+		}
+		html, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if !strings.Contains(resp.Header.Get("Content-Type"), "text/html") || !strings.Contains(string(html), "<html") {
+			t.Errorf("Expected an HTML report, got %s", resp.Header.Get("Content-Type"))
+		}
+
+		req, _ = http.NewRequest(http.MethodDelete, server.URL+"/jobs/"+job.ID, nil)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to delete job: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Errorf("Expected 204 when deleting a finished job, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("local path", func(t *testing.T) {
+		status, job := submit(t, "", "application/json", []byte(`{"path": "feed"}`))
+		if status != http.StatusAccepted {
+			t.Fatalf("Expected 202, got %d", status)
+		}
+		if finished := waitForJob(t, job.ID); finished.Status != jobSucceeded {
+			t.Errorf("Expected a succeeded job, got %+v", finished)
+		}
+
+		if status, _ := submit(t, "", "application/json", []byte(`{"path": "../feed"}`)); status != http.StatusForbidden {
+			t.Errorf("Expected 403 for a path outside the data directory, got %d", status)
+		}
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		if status, _ := submit(t, "?mode=fast", "application/zip", []byte("PK")); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an invalid mode, got %d", status)
+		}
+		if status, _ := submit(t, "?mode=comprehensive", "application/zip", []byte("PK")); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a mode outside the query modes, got %d", status)
+		}
+		if status, _ := submit(t, "?maxNotices=0", "application/zip", []byte("PK")); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for maxNotices=0, got %d", status)
+		}
+		if status, _ := submit(t, "", "application/zip", make([]byte, 2*1024*1024)); status != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected 413 for an oversized upload, got %d", status)
+		}
+		resp, err := http.Get(server.URL + "/jobs/unknown/report")
+		if err != nil {
+			t.Fatalf("Failed to get report: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for an unknown job, got %d", resp.StatusCode)
+		}
+	})
+}
+
+func TestValidationJob_KeepsLastEvents(t *testing.T) {
+	job := &validationJob{changed: make(chan struct{})}
+	for i := 0; i < maxJobEvents+10; i++ {
+		job.record("progress", i)
+	}
+	if len(job.events) != maxJobEvents {
+		t.Fatalf("Expected %d kept events, got %d", maxJobEvents, len(job.events))
+	}
+
+	from, events, _, _ := job.eventsSince(0)
+	if from != 10 || len(events) != maxJobEvents {
+		t.Fatalf("Expected %d events from ID 10, got %d from %d", maxJobEvents, len(events), from)
+	}
+	if string(events[0].data) != "10" || string(events[len(events)-1].data) != strconv.Itoa(maxJobEvents+9) {
+		t.Errorf("Expected the oldest kept and newest events, got %s and %s", events[0].data, events[len(events)-1].data)
+	}
+
+	from, events, _, _ = job.eventsSince(maxJobEvents + 5)
+	if from != maxJobEvents+5 || len(events) != 5 || string(events[0].data) != strconv.Itoa(maxJobEvents+5) {
+		t.Errorf("Expected the 5 events from ID %d, got %d from %d", maxJobEvents+5, len(events), from)
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	compareFormat           string
+	compareOutputFile       string
+	compareFailOnRegression bool
+)
+
+func newCompareReportsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare-reports [flags] <previous.json> <current.json>",
+		Short: "Compare two JSON validation reports",
+		Long: `Compare two validation reports produced with --format json.
+
+Shows notice codes that appeared or were resolved, count changes per code
+and per severity, and entities that are newly affected by notices.`,
+		Example: `  gtfs-validator compare-reports nightly-old.json nightly-new.json
+  gtfs-validator compare-reports old.json new.json --format html -o diff.html
+  gtfs-validator compare-reports old.json new.json --fail-on-regression`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCompareReports,
+	}
+
+	cmd.Flags().StringVarP(&compareFormat, "format", "f", "console", "Output format: console, json, html")
+	cmd.Flags().StringVarP(&compareOutputFile, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().BoolVar(&compareFailOnRegression, "fail-on-regression", false, "Exit with code 1 if new errors were introduced")
+
+	return cmd
+}
+
+func runCompareReports(cmd *cobra.Command, args []string) error {
+	validFormats := []string{"console", "json", "html"}
+	if !contains(validFormats, compareFormat) {
+		return fmt.Errorf("❌ invalid output format: '%s'. valid formats: %s", compareFormat, strings.Join(validFormats, ", "))
+	}
+
+	previous, err := loadReportFile(args[0])
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+	current, err := loadReportFile(args[1])
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	comparison := gtfsvalidator.CompareReports(previous, current)
+
+	output := os.Stdout
+	if compareOutputFile != "" {
+		file, err := os.Create(compareOutputFile) // #nosec G304 -- User-provided output file path
+		if err != nil {
+			return fmt.Errorf("❌ Output Error: Failed to create output file '%s': %v", compareOutputFile, err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to close output file: %v\n", err)
+			}
+		}()
+		output = file
+	}
+
+	switch compareFormat {
+	case "json":
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(comparison); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode comparison: %v", err)
+		}
+	case "html":
+		formatter, err := gtfsvalidator.NewHTMLFormatter()
+		if err != nil {
+			return fmt.Errorf("❌ HTML Error: Failed to create HTML formatter: %v", err)
+		}
+		if err := formatter.GenerateComparisonHTML(comparison, output); err != nil {
+			return fmt.Errorf("❌ HTML Error: Failed to generate comparison report: %v", err)
+		}
+	default:
+		outputComparisonConsole(output, comparison)
+	}
+
+	if compareFailOnRegression && comparison.HasRegressions() {
+		return fmt.Errorf("❌ regression detected: %s errors", gtfsvalidator.SignedDelta(comparison.SeverityDeltas.Errors))
+	}
+
+	return nil
+}
+
+// loadReportFile reads a JSON validation report from disk.
+func loadReportFile(path string) (*gtfsvalidator.ValidationReport, error) {
+	file, err := os.Open(path) // #nosec G304 -- User-provided report path
+	if err != nil {
+		return nil, fmt.Errorf("input error: cannot open report '%s': %v", path, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to close report file: %v\n", err)
+		}
+	}()
+
+	report, err := gtfsvalidator.ParseReport(file)
+	if err != nil {
+		return nil, fmt.Errorf("input error: '%s' is not a valid JSON validation report: %v", path, err)
+	}
+	return report, nil
+}
+
+func outputComparisonConsole(output *os.File, comparison *gtfsvalidator.ReportComparison) {
+	write := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(output, format, args...); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write comparison output: %v\n", err)
+		}
+	}
+
+	write("GTFS Validation Comparison\n")
+	write("==========================\n\n")
+	write("Previous: %s (%s)\n", comparison.Previous.FeedPath, comparison.Previous.Date)
+	write("Current:  %s (%s)\n\n", comparison.Current.FeedPath, comparison.Current.Date)
+
+	write("Severity Changes:\n")
+	write("  Errors:   %d -> %d (%s)\n", comparison.Previous.Counts.Errors, comparison.Current.Counts.Errors, gtfsvalidator.SignedDelta(comparison.SeverityDeltas.Errors))
+	write("  Warnings: %d -> %d (%s)\n", comparison.Previous.Counts.Warnings, comparison.Current.Counts.Warnings, gtfsvalidator.SignedDelta(comparison.SeverityDeltas.Warnings))
+	write("  Infos:    %d -> %d (%s)\n", comparison.Previous.Counts.Infos, comparison.Current.Counts.Infos, gtfsvalidator.SignedDelta(comparison.SeverityDeltas.Infos))
+	write("  Total:    %d -> %d (%s)\n", comparison.Previous.Counts.Total, comparison.Current.Counts.Total, gtfsvalidator.SignedDelta(comparison.SeverityDeltas.Total))
+
+	outputComparisonChanges(write, comparison)
+
+	if !comparison.HasChanges() {
+		write("\n✅ No differences between the two reports\n")
+	}
+}
+
+// outputComparisonChanges writes the new, resolved and changed notice codes and the newly affected entities.
+func outputComparisonChanges(write func(format string, args ...interface{}), comparison *gtfsvalidator.ReportComparison) {
+	if len(comparison.NewCodes) > 0 {
+		write("\nNew Notice Codes:\n")
+		for _, change := range comparison.NewCodes {
+			write("  + %s: %s (%d instances)\n", change.Severity, change.Code, change.CurrentCount)
+		}
+	}
+
+	if len(comparison.ResolvedCodes) > 0 {
+		write("\nResolved Notice Codes:\n")
+		for _, change := range comparison.ResolvedCodes {
+			write("  - %s: %s (%d instances)\n", change.Severity, change.Code, change.PreviousCount)
+		}
+	}
+
+	if len(comparison.ChangedCodes) > 0 {
+		write("\nChanged Counts:\n")
+		for _, change := range comparison.ChangedCodes {
+			write("  ~ %s: %s %d -> %d (%s)\n", change.Severity, change.Code, change.PreviousCount, change.CurrentCount, gtfsvalidator.SignedDelta(change.Delta))
+		}
+	}
+
+	if len(comparison.NewlyAffectedEntities) > 0 {
+		write("\nNewly Affected Entities:\n")
+		for _, entity := range comparison.NewlyAffectedEntities {
+			write("  %s %s (%s: %s)\n", entity.Type, entity.ID, entity.Severity, entity.Code)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	"github.com/theoremus-urban-solutions/gtfs-validator/fetch"
+)
+
+// configPath is the --config flag
+var configPath string
+
+// applyConfigFile loads the --config file, or a .gtfs-validator.yaml/.json found next
+// to the input, and returns its options. Flags set on the command line take
+// precedence over the file, which takes precedence over the flag defaults.
+func applyConfigFile(cmd *cobra.Command) ([]gtfsvalidator.Option, error) {
+	path := configPath
+	if path == "" && !fetch.IsURL(inputPath) {
+		dir := inputPath
+		if info, err := os.Stat(inputPath); err == nil && !info.IsDir() {
+			dir = filepath.Dir(inputPath)
+		}
+		path = gtfsvalidator.FindConfigFile(dir)
+	}
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := gtfsvalidator.ReadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "⚙️  Using config file: %s\n", path)
+
+	flags := cmd.Flags()
+	if file.Mode != "" && !flags.Changed("mode") {
+		mode = file.Mode
+	}
+	if file.Country != "" && !flags.Changed("country") {
+		countryCode = file.Country
+	}
+	if file.Workers != nil && !flags.Changed("workers") {
+		workers = *file.Workers
+	}
+	if file.MaxNotices != nil && !flags.Changed("max-notices") {
+		maxNotices = *file.MaxNotices
+	}
+	if fileTimeout, _ := file.TimeoutDuration(); fileTimeout > 0 && !flags.Changed("timeout") {
+		timeout = fileTimeout
+	}
+	if file.Locale != "" && !flags.Changed("lang") {
+		lang = file.Locale
+	}
+
+	return file.Options()
+}
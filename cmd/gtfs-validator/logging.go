@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+)
+
+var (
+	logLevel  string
+	logFormat string
+)
+
+// addLogFlags registers the --log-level and --log-format flags on a command and its subcommands
+func addLogFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&logLevel, "log-level", "warn", "Log level: debug, info, warn, error")
+	cmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "Log format: text, json")
+}
+
+// loggingOptions returns the options that send the validator logs and
+// lifecycle events to stderr at the --log-level in the --log-format
+func loggingOptions() ([]gtfsvalidator.Option, error) {
+	level, err := logging.ParseLevel(logLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	var logger logging.Logger
+	switch logFormat {
+	case "text":
+		logger = logging.NewLoggerWithFormatter(os.Stderr, &logging.TextFormatter{})
+	case "json":
+		logger = logging.NewLoggerWithFormatter(os.Stderr, &logging.JSONFormatter{})
+	default:
+		return nil, fmt.Errorf("invalid log format %q: expected text or json", logFormat)
+	}
+	logger.SetLevel(level)
+
+	return []gtfsvalidator.Option{
+		gtfsvalidator.WithLogger(logger),
+		gtfsvalidator.WithObserver(gtfsvalidator.NewLoggingObserver(logger)),
+	}, nil
+}
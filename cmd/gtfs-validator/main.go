@@ -13,6 +13,8 @@ import (
 
 	"github.com/spf13/cobra"
 	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	"github.com/theoremus-urban-solutions/gtfs-validator/fetch"
+	"golang.org/x/text/language"
 )
 
 // Version information - this will be set during build
@@ -30,6 +32,16 @@ var (
 	maxNotices   int
 	timeout      time.Duration
 	showProgress bool
+	previousPath string
+	filterRoute  string
+	filterAgency string
+	lang         string
+
+	ignoreSubfolders bool
+	transcode        bool
+	recoverCSV       bool
+	cacheDir         string
+	maxDownloadSize  int64
 )
 
 func main() {
@@ -45,16 +57,19 @@ Features memory optimization with streaming CSV processing for large feeds,
 structured logging, and comprehensive validation with 294+ validation rules.`,
 		Example: `  gtfs-validator -i feed.zip
   gtfs-validator -i ./gtfs-feed -f json -o report.json
+  gtfs-validator -i https://example.com/gtfs.zip --cache-dir ~/.cache/gtfs
   gtfs-validator -i feed.zip -f html -o report.html
   gtfs-validator -i feed.zip -m performance
-  gtfs-validator -i feed.zip --progress`,
+  gtfs-validator -i feed.zip --progress
+  gtfs-validator -i new-feed.zip --previous old-feed.zip
+  gtfs-validator -i feed.zip --filter-route R10`,
 		Version: version,
 		RunE:    runValidation,
 	}
 
 	// Add flags
-	rootCmd.Flags().StringVarP(&inputPath, "input", "i", "", "Path to GTFS feed (ZIP file or directory) [required]")
-	rootCmd.Flags().StringVarP(&outputFormat, "format", "f", "console", "Output format: console, json, summary, html")
+	rootCmd.Flags().StringVarP(&inputPath, "input", "i", "", "Path or http(s) URL of GTFS feed (ZIP file or directory) [required]")
+	rootCmd.Flags().StringVarP(&outputFormat, "format", "f", "console", "Output format: console, json, summary, html, mobilitydata")
 	rootCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file path (default: stdout)")
 	rootCmd.Flags().StringVarP(&countryCode, "country", "c", "US", "Country code for validation (e.g., US, GB, FR)")
 	rootCmd.Flags().Int64Var(&maxMemory, "memory", 0, "Maximum memory usage in MB (0 = no limit)")
@@ -63,6 +78,18 @@ structured logging, and comprehensive validation with 294+ validation rules.`,
 	rootCmd.Flags().IntVar(&maxNotices, "max-notices", 100, "Maximum notices per type (0 = no limit)")
 	rootCmd.Flags().DurationVarP(&timeout, "timeout", "t", 5*time.Minute, "Validation timeout")
 	rootCmd.Flags().BoolVarP(&showProgress, "progress", "p", false, "Show progress bar")
+	rootCmd.Flags().StringVar(&previousPath, "previous", "", "Previous feed version to diff against (ZIP file or directory)")
+	rootCmd.Flags().StringVar(&filterRoute, "filter-route", "", "Only report notices referencing this route_id (including its trips)")
+	rootCmd.Flags().StringVar(&filterAgency, "filter-agency", "", "Only report notices referencing this agency_id (including its routes and trips)")
+	rootCmd.Flags().StringVar(&lang, "lang", "", "Language of notice descriptions and HTML reports, e.g. fr (default: en)")
+	rootCmd.Flags().BoolVar(&ignoreSubfolders, "ignore-subfolders", false, "Do not validate GTFS files found in ZIP subfolders (they are still reported)")
+	rootCmd.Flags().BoolVar(&transcode, "transcode", false, "Transcode Windows-1252, ISO-8859-1 and UTF-16 files to UTF-8 before validation")
+	rootCmd.Flags().BoolVar(&recoverCSV, "recover-csv", false, "Skip malformed CSV rows with a csv_parsing_failed notice and resume parsing at the next line")
+	rootCmd.Flags().StringVar(&cacheDir, "cache-dir", "", "Cache downloaded feeds here and skip downloads when the ETag or Last-Modified header is unchanged")
+	rootCmd.Flags().Int64Var(&maxDownloadSize, "max-download-size", 0, "Maximum download size in MB for URL inputs (0 = archive size limit)")
+	rootCmd.Flags().StringVar(&configPath, "config", "", "Config file (default: .gtfs-validator.yaml, .yml or .json next to the input)")
+
+	addLogFlags(rootCmd)
 
 	// Mark input as required
 	if err := rootCmd.MarkFlagRequired("input"); err != nil {
@@ -73,6 +100,15 @@ structured logging, and comprehensive validation with 294+ validation rules.`,
 	// Add subcommands
 	rootCmd.AddCommand(newVersionCmd())
 	rootCmd.AddCommand(newValidateCmd())
+	rootCmd.AddCommand(newCompareReportsCmd())
+	rootCmd.AddCommand(newRenderCmd())
+	rootCmd.AddCommand(newMultiCmd())
+	rootCmd.AddCommand(newBatchCmd())
+	rootCmd.AddCommand(newWatchCmd())
+	rootCmd.AddCommand(newServeCmd())
+	rootCmd.AddCommand(newRulesCmd())
+	rootCmd.AddCommand(newExplainCmd())
+	rootCmd.AddCommand(newSchemaCmd())
 
 	// Execute
 	if err := rootCmd.Execute(); err != nil {
@@ -98,15 +134,18 @@ func newValidateCmd() *cobra.Command {
 		Short: "Validate a GTFS feed",
 		Long: `Validate a GTFS feed for compliance with the GTFS specification.
 
-The input can be either a ZIP file containing the GTFS feed or a directory
-with the GTFS files.
+The input can be either a ZIP file containing the GTFS feed, a directory
+with the GTFS files or an http(s) URL of a ZIP file.
 
 Uses memory-efficient streaming processing for large feeds and provides
 comprehensive validation with 294+ validation rules.`,
 		Example: `  gtfs-validator validate feed.zip
   gtfs-validator validate ./gtfs-directory --format json
+  gtfs-validator validate https://example.com/gtfs.zip --cache-dir ~/.cache/gtfs
   gtfs-validator validate feed.zip --format html --output report.html
-  gtfs-validator validate feed.zip --mode performance --progress`,
+  gtfs-validator validate feed.zip --mode performance --progress
+  gtfs-validator validate new-feed.zip --previous old-feed.zip
+  gtfs-validator validate feed.zip --filter-agency metro`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inputPath = args[0]
@@ -115,7 +154,7 @@ comprehensive validation with 294+ validation rules.`,
 	}
 
 	// Add the same flags as root command
-	cmd.Flags().StringVarP(&outputFormat, "format", "f", "console", "Output format: console, json, summary, html")
+	cmd.Flags().StringVarP(&outputFormat, "format", "f", "console", "Output format: console, json, summary, html, mobilitydata")
 	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file path (default: stdout)")
 	cmd.Flags().StringVarP(&countryCode, "country", "c", "US", "Country code for validation (e.g., US, GB, FR)")
 	cmd.Flags().Int64Var(&maxMemory, "memory", 0, "Maximum memory usage in MB (0 = no limit)")
@@ -124,15 +163,42 @@ comprehensive validation with 294+ validation rules.`,
 	cmd.Flags().IntVar(&maxNotices, "max-notices", 100, "Maximum notices per type (0 = no limit)")
 	cmd.Flags().DurationVarP(&timeout, "timeout", "t", 5*time.Minute, "Validation timeout")
 	cmd.Flags().BoolVarP(&showProgress, "progress", "p", false, "Show progress bar")
+	cmd.Flags().StringVar(&previousPath, "previous", "", "Previous feed version to diff against (ZIP file or directory)")
+	cmd.Flags().StringVar(&filterRoute, "filter-route", "", "Only report notices referencing this route_id (including its trips)")
+	cmd.Flags().StringVar(&filterAgency, "filter-agency", "", "Only report notices referencing this agency_id (including its routes and trips)")
+	cmd.Flags().StringVar(&lang, "lang", "", "Language of notice descriptions and HTML reports, e.g. fr (default: en)")
+	cmd.Flags().BoolVar(&ignoreSubfolders, "ignore-subfolders", false, "Do not validate GTFS files found in ZIP subfolders (they are still reported)")
+	cmd.Flags().BoolVar(&transcode, "transcode", false, "Transcode Windows-1252, ISO-8859-1 and UTF-16 files to UTF-8 before validation")
+	cmd.Flags().BoolVar(&recoverCSV, "recover-csv", false, "Skip malformed CSV rows with a csv_parsing_failed notice and resume parsing at the next line")
+	cmd.Flags().StringVar(&cacheDir, "cache-dir", "", "Cache downloaded feeds here and skip downloads when the ETag or Last-Modified header is unchanged")
+	cmd.Flags().Int64Var(&maxDownloadSize, "max-download-size", 0, "Maximum download size in MB for URL inputs (0 = archive size limit)")
+	cmd.Flags().StringVar(&configPath, "config", "", "Config file (default: .gtfs-validator.yaml, .yml or .json next to the input)")
 
 	return cmd
 }
 
 func runValidation(cmd *cobra.Command, args []string) error {
+	// Load the config file before validating the settings it may provide
+	configOpts, err := applyConfigFile(cmd)
+	if err != nil {
+		return fmt.Errorf("❌ config error: %v", err)
+	}
+
 	// Validate input
 	if err := validateInput(inputPath, mode, outputFormat); err != nil {
 		return fmt.Errorf("❌ %v", err)
 	}
+	if err := validateLang(lang); err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+	if previousPath != "" {
+		if fetch.IsURL(inputPath) {
+			return fmt.Errorf("❌ input error: --previous requires a local input feed")
+		}
+		if _, err := os.Stat(previousPath); os.IsNotExist(err) {
+			return fmt.Errorf("❌ input error: previous feed path does not exist: '%s'", previousPath)
+		}
+	}
 
 	// Create context with timeout and cancellation
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -147,12 +213,30 @@ func runValidation(cmd *cobra.Command, args []string) error {
 		cancel()
 	}()
 
-	// Configure validator options
-	opts := []gtfsvalidator.Option{
+	logOpts, err := loggingOptions()
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	// Configure validator options; flag values are applied after the config file
+	opts := append(configOpts, logOpts...)
+	opts = append(opts,
 		gtfsvalidator.WithCountryCode(countryCode),
-		gtfsvalidator.WithMaxMemory(maxMemory * 1024 * 1024), // Convert MB to bytes
+		gtfsvalidator.WithMaxMemory(maxMemory*1024*1024), // Convert MB to bytes
 		gtfsvalidator.WithParallelWorkers(workers),
 		gtfsvalidator.WithMaxNoticesPerType(maxNotices),
+		gtfsvalidator.WithIgnoreSubfolderFiles(ignoreSubfolders),
+		gtfsvalidator.WithTranscoding(transcode),
+		gtfsvalidator.WithCSVRecovery(recoverCSV),
+	)
+	if lang != "" {
+		opts = append(opts, gtfsvalidator.WithLocale(lang))
+	}
+	if fetch.IsURL(inputPath) {
+		fetchOptions := gtfsvalidator.DefaultFetchOptions()
+		fetchOptions.CacheDir = cacheDir
+		fetchOptions.MaxSize = maxDownloadSize * 1024 * 1024 // Convert MB to bytes
+		opts = append(opts, gtfsvalidator.WithFetchOptions(fetchOptions))
 	}
 
 	// Set validation mode
@@ -178,8 +262,15 @@ func runValidation(cmd *cobra.Command, args []string) error {
 
 	// Show startup message
 	fmt.Fprintf(os.Stderr, "🚀 Starting GTFS validation...\n")
-	fmt.Fprintf(os.Stderr, "   Feed: %s\n", filepath.Base(inputPath))
+	if fetch.IsURL(inputPath) {
+		fmt.Fprintf(os.Stderr, "   Feed: %s\n", inputPath)
+	} else {
+		fmt.Fprintf(os.Stderr, "   Feed: %s\n", filepath.Base(inputPath))
+	}
 	fmt.Fprintf(os.Stderr, "   Mode: %s\n", mode)
+	if previousPath != "" {
+		fmt.Fprintf(os.Stderr, "   Previous feed: %s\n", filepath.Base(previousPath))
+	}
 	if maxNotices > 0 {
 		fmt.Fprintf(os.Stderr, "   Notice limit: %d per type\n", maxNotices)
 	}
@@ -187,7 +278,15 @@ func runValidation(cmd *cobra.Command, args []string) error {
 
 	// Perform validation
 	startTime := time.Now()
-	report, err := validator.ValidateFileWithContext(ctx, inputPath)
+	var report *gtfsvalidator.ValidationReport
+	switch {
+	case previousPath != "":
+		report, err = validator.ValidateDiffWithContext(ctx, previousPath, inputPath)
+	case fetch.IsURL(inputPath):
+		report, err = validator.ValidateURLWithContext(ctx, inputPath)
+	default:
+		report, err = validator.ValidateFileWithContext(ctx, inputPath)
+	}
 	elapsed := time.Since(startTime)
 
 	if err != nil {
@@ -205,8 +304,25 @@ func runValidation(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if download := report.Summary.FeedInfo.Download; download != nil {
+		if download.NotModified {
+			fmt.Fprintf(os.Stderr, "📦 Feed not modified since last download, validated cached copy\n")
+		} else {
+			fmt.Fprintf(os.Stderr, "📥 Downloaded %d bytes from %s\n", download.ContentLength, download.FinalURL)
+		}
+	}
 	fmt.Fprintf(os.Stderr, "✅ Validation completed in %.2fs\n\n", elapsed.Seconds())
 
+	// Narrow the report to a single route or agency
+	if filterAgency != "" {
+		report = report.FilterByEntity(gtfsvalidator.EntityTypeAgency, filterAgency)
+		fmt.Fprintf(os.Stderr, "🔎 Showing %d notices referencing agency '%s'\n\n", report.Summary.Counts.Total, filterAgency)
+	}
+	if filterRoute != "" {
+		report = report.FilterByEntity(gtfsvalidator.EntityTypeRoute, filterRoute)
+		fmt.Fprintf(os.Stderr, "🔎 Showing %d notices referencing route '%s'\n\n", report.Summary.Counts.Total, filterRoute)
+	}
+
 	// Handle output
 	output := os.Stdout
 	var outputFileHandle *os.File
@@ -239,8 +355,12 @@ func runValidation(cmd *cobra.Command, args []string) error {
 		if err := outputHTML(output, report, inputPath); err != nil {
 			return fmt.Errorf("❌ HTML Error: Failed to generate HTML report: %v", err)
 		}
+	case "mobilitydata":
+		if err := report.WriteMobilityDataJSON(output); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode MobilityData report: %v", err)
+		}
 	default:
-		return fmt.Errorf("❌ Format Error: Unknown output format '%s'. Valid formats: console, json, summary, html", outputFormat)
+		return fmt.Errorf("❌ Format Error: Unknown output format '%s'. Valid formats: console, json, summary, html, mobilitydata", outputFormat)
 	}
 
 	// Final status and exit
@@ -262,9 +382,11 @@ func runValidation(cmd *cobra.Command, args []string) error {
 }
 
 func validateInput(inputPath, mode, format string) error {
-	// Check if input exists
-	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
-		return fmt.Errorf("input error: path does not exist: '%s'", inputPath)
+	// Check if input exists; URLs are checked when downloaded
+	if !fetch.IsURL(inputPath) {
+		if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+			return fmt.Errorf("input error: path does not exist: '%s'", inputPath)
+		}
 	}
 
 	// Validate mode
@@ -274,7 +396,7 @@ func validateInput(inputPath, mode, format string) error {
 	}
 
 	// Validate format
-	validFormats := []string{"console", "json", "summary", "html"}
+	validFormats := []string{"console", "json", "summary", "html", "mobilitydata"}
 	if !contains(validFormats, format) {
 		return fmt.Errorf("invalid output format: '%s'. valid formats: %s", format, strings.Join(validFormats, ", "))
 	}
@@ -282,6 +404,18 @@ func validateInput(inputPath, mode, format string) error {
 	return nil
 }
 
+// validateLang checks that --lang is a BCP 47 language tag; tags without a
+// bundled locale fall back to English.
+func validateLang(lang string) error {
+	if lang == "" {
+		return nil
+	}
+	if _, err := language.Parse(lang); err != nil {
+		return fmt.Errorf("invalid language: '%s'. supported languages: %s", lang, strings.Join(gtfsvalidator.SupportedLocales(), ", "))
+	}
+	return nil
+}
+
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -389,14 +523,14 @@ func outputConsole(output *os.File, report *gtfsvalidator.ValidationReport, inpu
 
 			if notice.Severity == "ERROR" && errorCount < 5 {
 				write("ERROR: %s (%d instances)\n", notice.Code, notice.TotalNotices)
-				if len(notice.SampleNotices) > 0 {
-					showNoticeContext(output, notice.SampleNotices[0])
+				if locations := notice.Locations(); len(locations) > 0 {
+					showNoticeLocation(output, locations[0])
 				}
 				errorCount++
 			} else if notice.Severity == "WARNING" && warningCount < 5 {
 				write("WARNING: %s (%d instances)\n", notice.Code, notice.TotalNotices)
-				if len(notice.SampleNotices) > 0 {
-					showNoticeContext(output, notice.SampleNotices[0])
+				if locations := notice.Locations(); len(locations) > 0 {
+					showNoticeLocation(output, locations[0])
 				}
 				warningCount++
 			}
@@ -408,25 +542,25 @@ func outputConsole(output *os.File, report *gtfsvalidator.ValidationReport, inpu
 	}
 }
 
-func showNoticeContext(output *os.File, context map[string]interface{}) {
+func showNoticeLocation(output *os.File, location gtfsvalidator.NoticeLocation) {
 	details := []string{}
 
-	if filename, ok := context["filename"].(string); ok {
-		details = append(details, fmt.Sprintf("file=%s", filename))
+	if location.File != "" {
+		details = append(details, fmt.Sprintf("file=%s", location.File))
 	}
-	if row, ok := context["csvRowNumber"].(float64); ok {
-		details = append(details, fmt.Sprintf("row=%d", int(row)))
+	if location.RowNumber > 0 {
+		details = append(details, fmt.Sprintf("row=%d", location.RowNumber))
 	}
-	if field, ok := context["fieldName"].(string); ok {
-		details = append(details, fmt.Sprintf("field=%s", field))
+	if location.FieldName != "" {
+		details = append(details, fmt.Sprintf("field=%s", location.FieldName))
 	}
-	if routeId, ok := context["routeId"].(string); ok {
-		details = append(details, fmt.Sprintf("route=%s", routeId))
+	for _, entity := range location.RelatedEntities {
+		details = append(details, fmt.Sprintf("%s=%s", entity.Type, entity.ID))
 	}
 
 	if len(details) > 0 {
 		if _, err := fmt.Fprintf(output, "       (%s)\n", strings.Join(details, ", ")); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Failed to write notice context: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write notice location: %v\n", err)
 		}
 	}
 }
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	multiFormat       string
+	multiOutputFile   string
+	multiCountryCode  string
+	multiMode         string
+	multiMaxNotices   int
+	multiTimeout      time.Duration
+	multiStopDistance float64
+)
+
+func newMultiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi [flags] <feed> <feed> [feed...]",
+		Short: "Validate several feeds meant to be merged",
+		Long: `Validate several GTFS feeds (ZIP files or directories) that are merged
+into one network, e.g. for a regional journey planner.
+
+Each feed is validated on its own, then the feeds are checked against each
+other: agency_id, stop_id, route_id and fare_id values used by more than one
+feed, stops of different feeds within a few meters that should be linked by
+transfers, and feeds of the same region declaring different timezones.`,
+		Example: `  gtfs-validator multi metro.zip bus.zip tram.zip
+  gtfs-validator multi metro.zip bus.zip --stop-distance 25
+  gtfs-validator multi ./feeds/*.zip --format json -o merged-report.json`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: runMulti,
+	}
+
+	cmd.Flags().StringVarP(&multiFormat, "format", "f", "console", "Output format: console, json")
+	cmd.Flags().StringVarP(&multiOutputFile, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().StringVarP(&multiCountryCode, "country", "c", "US", "Country code for validation (e.g., US, GB, FR)")
+	cmd.Flags().StringVarP(&multiMode, "mode", "m", "default", "Validation mode: performance, default, comprehensive")
+	cmd.Flags().IntVar(&multiMaxNotices, "max-notices", 100, "Maximum notices per type (0 = no limit)")
+	cmd.Flags().DurationVarP(&multiTimeout, "timeout", "t", 15*time.Minute, "Validation timeout for all feeds")
+	cmd.Flags().Float64Var(&multiStopDistance, "stop-distance", 10, "Report stops of different feeds closer than this many meters (0 = disabled)")
+
+	return cmd
+}
+
+func runMulti(cmd *cobra.Command, args []string) error {
+	validFormats := []string{"console", "json"}
+	if !contains(validFormats, multiFormat) {
+		return fmt.Errorf("❌ invalid output format: '%s'. valid formats: %s", multiFormat, strings.Join(validFormats, ", "))
+	}
+	for _, path := range args {
+		if err := validateInput(path, multiMode, "console"); err != nil {
+			return fmt.Errorf("❌ %v", err)
+		}
+	}
+
+	logOpts, err := loggingOptions()
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), multiTimeout)
+	defer cancel()
+
+	validator := gtfsvalidator.New(append(logOpts,
+		gtfsvalidator.WithCountryCode(multiCountryCode),
+		gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationMode(multiMode)),
+		gtfsvalidator.WithMaxNoticesPerType(multiMaxNotices),
+		gtfsvalidator.WithCrossFeedStopDistance(multiStopDistance),
+	)...)
+
+	fmt.Fprintf(os.Stderr, "🚀 Validating %d feeds...\n\n", len(args))
+	startTime := time.Now()
+	report, err := validator.ValidateFeedsWithContext(ctx, args)
+	if err != nil {
+		return fmt.Errorf("❌ Validation Error: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "✅ Validation completed in %.2fs\n\n", time.Since(startTime).Seconds())
+
+	output := os.Stdout
+	if multiOutputFile != "" {
+		file, err := os.Create(multiOutputFile) // #nosec G304 -- User-provided output file path
+		if err != nil {
+			return fmt.Errorf("❌ Output Error: Failed to create output file '%s': %v", multiOutputFile, err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to close output file: %v\n", err)
+			}
+		}()
+		output = file
+	}
+
+	switch multiFormat {
+	case "json":
+		if err := json.NewEncoder(output).Encode(report); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode report: %v", err)
+		}
+	default:
+		outputMultiConsole(output, report)
+	}
+
+	if report.HasErrors() {
+		return fmt.Errorf("❌ Validation FAILED: %d errors found", report.ErrorCount())
+	}
+	return nil
+}
+
+func outputMultiConsole(output *os.File, report *gtfsvalidator.MultiFeedReport) {
+	write := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(output, format, args...); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write console output: %v\n", err)
+		}
+	}
+
+	write("GTFS Multi-Feed Validation\n")
+	write("==========================\n\n")
+
+	write("Feeds:\n")
+	for _, feed := range report.Feeds {
+		if feed.Report == nil {
+			write("  ❌ %s (%s): %s\n", feed.Name, feed.Path, feed.Error)
+			continue
+		}
+		counts := feed.Report.Summary.Counts
+		write("  %s (%s): %d errors, %d warnings, %d infos\n", feed.Name, feed.Path, counts.Errors, counts.Warnings, counts.Infos)
+	}
+
+	write("\nCross-Feed Checks:\n")
+	if report.CrossFeed == nil || len(report.CrossFeed.Notices) == 0 {
+		write("  ✅ No conflicts between the feeds\n")
+		return
+	}
+	for _, group := range report.CrossFeed.Notices {
+		write("  %s: %s (%d instances)\n", group.Severity, group.Code, group.TotalNotices)
+		for i, sample := range group.SampleNotices {
+			if i >= 5 {
+				write("       ... and %d more\n", group.TotalNotices-i)
+				break
+			}
+			write("       %s\n", describeCrossFeedNotice(group.Code, sample))
+		}
+	}
+}
+
+// describeCrossFeedNotice summarizes a cross-feed notice on one line.
+func describeCrossFeedNotice(code string, context map[string]interface{}) string {
+	switch code {
+	case "cross_feed_id_collision":
+		return fmt.Sprintf("%s '%v' in %v (row %v) and %v (row %v)",
+			context["fieldName"], context["fieldValue"], context["feedName"], context["csvRowNumber"], context["otherFeedName"], context["otherCsvRowNumber"])
+	case "cross_feed_nearby_stops":
+		return fmt.Sprintf("%v/%v and %v/%v are %.1fm apart",
+			context["feedName"], context["stopId"], context["otherFeedName"], context["otherStopId"], context["distance"])
+	case "cross_feed_inconsistent_timezone":
+		return fmt.Sprintf("%v uses %v, %v uses %v",
+			context["feedName"], context["agencyTimezone"], context["otherFeedName"], context["otherTimezone"])
+	default:
+		return fmt.Sprintf("%v", context)
+	}
+}
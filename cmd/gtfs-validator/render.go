@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	renderFormat       string
+	renderOutputFile   string
+	renderFilterRoute  string
+	renderFilterAgency string
+	renderLang         string
+)
+
+func newRenderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "render [flags] <report.json>",
+		Short: "Render a stored JSON validation report",
+		Long: `Render a validation report produced with --format json in another format.
+
+The report is read back with its sample notices and locations, so it can be
+turned into an HTML, SARIF, console or summary report, or narrowed to a
+single route or agency, without validating the feed again.`,
+		Example: `  gtfs-validator render report.json --format html -o report.html
+  gtfs-validator render report.json --format sarif -o report.sarif
+  gtfs-validator render report.json --filter-route R10
+  gtfs-validator render report.json --format html --lang fr -o rapport.html`,
+		Args: cobra.ExactArgs(1),
+		RunE: runRender,
+	}
+
+	cmd.Flags().StringVarP(&renderFormat, "format", "f", "console", "Output format: console, json, summary, html, sarif, mobilitydata")
+	cmd.Flags().StringVarP(&renderOutputFile, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().StringVar(&renderFilterRoute, "filter-route", "", "Only render notices referencing this route_id (including its trips)")
+	cmd.Flags().StringVar(&renderFilterAgency, "filter-agency", "", "Only render notices referencing this agency_id (including its routes and trips)")
+	cmd.Flags().StringVar(&renderLang, "lang", "", "Language of notice descriptions and HTML reports, e.g. fr (default: the report's language)")
+
+	return cmd
+}
+
+func runRender(cmd *cobra.Command, args []string) error {
+	validFormats := []string{"console", "json", "summary", "html", "sarif", "mobilitydata"}
+	if !contains(validFormats, renderFormat) {
+		return fmt.Errorf("❌ invalid output format: '%s'. valid formats: %s", renderFormat, strings.Join(validFormats, ", "))
+	}
+	if err := validateLang(renderLang); err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	report, err := loadReportFile(args[0])
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	if renderFilterAgency != "" {
+		report = report.FilterByEntity(gtfsvalidator.EntityTypeAgency, renderFilterAgency)
+	}
+	if renderFilterRoute != "" {
+		report = report.FilterByEntity(gtfsvalidator.EntityTypeRoute, renderFilterRoute)
+	}
+	if renderLang != "" {
+		report = report.Localized(renderLang)
+	}
+
+	output := os.Stdout
+	if renderOutputFile != "" {
+		file, err := os.Create(renderOutputFile) // #nosec G304 -- User-provided output file path
+		if err != nil {
+			return fmt.Errorf("❌ Output Error: Failed to create output file '%s': %v", renderOutputFile, err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to close output file: %v\n", err)
+			}
+		}()
+		output = file
+	}
+
+	feedPath := report.Summary.FeedInfo.FeedPath
+	switch renderFormat {
+	case "json":
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode report: %v", err)
+		}
+	case "summary":
+		outputSummary(output, report, feedPath)
+	case "html":
+		if err := outputHTML(output, report, feedPath); err != nil {
+			return fmt.Errorf("❌ HTML Error: Failed to generate HTML report: %v", err)
+		}
+	case "sarif":
+		if err := report.WriteSARIF(output); err != nil {
+			return fmt.Errorf("❌ SARIF Error: Failed to write SARIF report: %v", err)
+		}
+	case "mobilitydata":
+		if err := report.WriteMobilityDataJSON(output); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode MobilityData report: %v", err)
+		}
+	default:
+		outputConsole(output, report, feedPath)
+	}
+
+	return nil
+}
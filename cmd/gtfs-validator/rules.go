@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	rulesFormat   string
+	rulesSeverity string
+	rulesCategory string
+	rulesMode     string
+	explainFormat string
+)
+
+func newRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rules [flags]",
+		Short: "List all validation rules",
+		Long: `List every notice code the validator can emit, with its severity,
+category, emitting validators and the validation modes it runs in.
+
+The markdown output can be used to generate rule documentation.`,
+		Example: `  gtfs-validator rules
+  gtfs-validator rules --format json
+  gtfs-validator rules --format markdown > RULES.md
+  gtfs-validator rules --severity error --mode performance`,
+		Args: cobra.NoArgs,
+		RunE: runRules,
+	}
+
+	cmd.Flags().StringVarP(&rulesFormat, "format", "f", "console", "Output format: console, json, markdown")
+	cmd.Flags().StringVar(&rulesSeverity, "severity", "", "Only show rules with this severity: error, warning, info")
+	cmd.Flags().StringVar(&rulesCategory, "category", "", "Only show rules in this category (e.g. core, entity, business)")
+	cmd.Flags().StringVarP(&rulesMode, "mode", "m", "", "Only show rules run in this mode: performance, default, comprehensive")
+
+	return cmd
+}
+
+func newExplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain [flags] <code>",
+		Short: "Explain a validation rule",
+		Long: `Show everything known about a notice code: severity, category,
+emitting validators, validation modes, description and how to fix it.`,
+		Example: `  gtfs-validator explain duplicate_key
+  gtfs-validator explain route_color_contrast --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExplain,
+	}
+
+	cmd.Flags().StringVarP(&explainFormat, "format", "f", "console", "Output format: console, json")
+
+	return cmd
+}
+
+func runRules(cmd *cobra.Command, args []string) error {
+	validFormats := []string{"console", "json", "markdown"}
+	if !contains(validFormats, rulesFormat) {
+		return fmt.Errorf("❌ invalid output format: '%s'. valid formats: %s", rulesFormat, strings.Join(validFormats, ", "))
+	}
+	if rulesSeverity != "" && !contains([]string{"ERROR", "WARNING", "INFO"}, strings.ToUpper(rulesSeverity)) {
+		return fmt.Errorf("❌ invalid severity: '%s'. valid severities: error, warning, info", rulesSeverity)
+	}
+	if rulesMode != "" && !contains([]string{"performance", "default", "comprehensive"}, rulesMode) {
+		return fmt.Errorf("❌ invalid validation mode: '%s'. valid modes: performance, default, comprehensive", rulesMode)
+	}
+	if rulesCategory != "" && !contains(gtfsvalidator.RuleCategories(), rulesCategory) {
+		return fmt.Errorf("❌ invalid category: '%s'. valid categories: %s", rulesCategory, strings.Join(gtfsvalidator.RuleCategories(), ", "))
+	}
+
+	rules := filterRules(gtfsvalidator.RuleCatalogue())
+
+	switch rulesFormat {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(rules); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode rules: %v", err)
+		}
+	case "markdown":
+		outputRulesMarkdown(os.Stdout, rules)
+	default:
+		outputRulesConsole(os.Stdout, rules)
+	}
+	return nil
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	if !contains([]string{"console", "json"}, explainFormat) {
+		return fmt.Errorf("❌ invalid output format: '%s'. valid formats: console, json", explainFormat)
+	}
+
+	rule, err := gtfsvalidator.ExplainRule(args[0])
+	if err != nil {
+		return fmt.Errorf("❌ %v. Run 'gtfs-validator rules' to list all codes", err)
+	}
+
+	if explainFormat == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(rule); err != nil {
+			return fmt.Errorf("❌ JSON Error: Failed to encode rule: %v", err)
+		}
+		return nil
+	}
+
+	outputRuleExplanation(os.Stdout, rule)
+	return nil
+}
+
+// filterRules applies the --severity, --category and --mode filters.
+func filterRules(rules []gtfsvalidator.RuleInfo) []gtfsvalidator.RuleInfo {
+	filtered := make([]gtfsvalidator.RuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		if rulesSeverity != "" && !strings.EqualFold(rule.Severity, rulesSeverity) {
+			continue
+		}
+		if rulesCategory != "" && rule.Category != rulesCategory {
+			continue
+		}
+		if rulesMode != "" && !containsMode(rule.Modes, gtfsvalidator.ValidationMode(rulesMode)) {
+			continue
+		}
+		filtered = append(filtered, rule)
+	}
+	return filtered
+}
+
+func containsMode(modes []gtfsvalidator.ValidationMode, mode gtfsvalidator.ValidationMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// formatModes renders the modes of a rule for display.
+func formatModes(rule gtfsvalidator.RuleInfo) string {
+	if len(rule.Modes) == 0 {
+		if rule.Category == "diff" {
+			return "diff (--previous)"
+		}
+		if rule.Category == "crossfeed" {
+			return "multi-feed (multi)"
+		}
+		return "-"
+	}
+	modes := make([]string, len(rule.Modes))
+	for i, mode := range rule.Modes {
+		modes[i] = string(mode)
+	}
+	return strings.Join(modes, ", ")
+}
+
+func formatSeverity(rule gtfsvalidator.RuleInfo) string {
+	if rule.VariableSeverity {
+		return rule.Severity + " (variable)"
+	}
+	return rule.Severity
+}
+
+func outputRulesConsole(output *os.File, rules []gtfsvalidator.RuleInfo) {
+	fmt.Fprintf(output, "%-45s %-20s %-14s %s\n", "CODE", "SEVERITY", "CATEGORY", "MODES")
+	for _, rule := range rules {
+		fmt.Fprintf(output, "%-45s %-20s %-14s %s\n", rule.Code, formatSeverity(rule), rule.Category, formatModes(rule))
+	}
+	fmt.Fprintf(output, "\n%d rules\n", len(rules))
+}
+
+func outputRulesMarkdown(output *os.File, rules []gtfsvalidator.RuleInfo) {
+	fmt.Fprintf(output, "# GTFS Validator Rule Catalogue\n\n")
+	fmt.Fprintf(output, "Generated with `gtfs-validator rules --format markdown`. %d rules.\n\n", len(rules))
+	fmt.Fprintf(output, "| Code | Severity | Category | Validators | Modes | Description |\n")
+	fmt.Fprintf(output, "|------|----------|----------|------------|-------|-------------|\n")
+	for _, rule := range rules {
+		fmt.Fprintf(output, "| `%s` | %s | %s | %s | %s | %s |\n",
+			rule.Code,
+			formatSeverity(rule),
+			rule.Category,
+			strings.Join(rule.Validators, ", "),
+			formatModes(rule),
+			strings.ReplaceAll(rule.Description, "|", "\\|"),
+		)
+	}
+}
+
+func outputRuleExplanation(output *os.File, rule gtfsvalidator.RuleInfo) {
+	fmt.Fprintf(output, "%s\n", rule.Code)
+	fmt.Fprintf(output, "%s\n\n", strings.Repeat("=", len(rule.Code)))
+	fmt.Fprintf(output, "Severity:   %s\n", formatSeverity(rule))
+	fmt.Fprintf(output, "Category:   %s\n", rule.Category)
+	fmt.Fprintf(output, "Modes:      %s\n", formatModes(rule))
+	if len(rule.Validators) > 0 {
+		fmt.Fprintf(output, "Validators: %s\n", strings.Join(rule.Validators, ", "))
+	}
+	fmt.Fprintf(output, "\n%s\n", rule.Description)
+
+	if rule.Impact != "" {
+		fmt.Fprintf(output, "\nImpact: %s\n", rule.Impact)
+	}
+	if len(rule.AffectedFiles) > 0 {
+		fmt.Fprintf(output, "Files:  %s\n", strings.Join(rule.AffectedFiles, ", "))
+	}
+	if len(rule.AffectedFields) > 0 {
+		fmt.Fprintf(output, "Fields: %s\n", strings.Join(rule.AffectedFields, ", "))
+	}
+	if rule.ExampleFix != "" {
+		fmt.Fprintf(output, "\nHow to fix: %s\n", rule.ExampleFix)
+	}
+	if rule.GTFSReference != "" {
+		fmt.Fprintf(output, "Reference:  %s\n", rule.GTFSReference)
+	}
+}
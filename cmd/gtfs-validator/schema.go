@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var schemaOutputFile string
+
+func newSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [flags]",
+		Short: "Print the JSON Schema of the report format",
+		Long: `Print the JSON Schema (draft 2020-12) of the reports written with
+--format json, including a typed definition of the sample notice context of
+every notice code. The schema version matches the report's schemaVersion.`,
+		Example: `  gtfs-validator schema
+  gtfs-validator schema -o report.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: runSchema,
+	}
+
+	cmd.Flags().StringVarP(&schemaOutputFile, "output", "o", "", "Output file path (default: stdout)")
+
+	return cmd
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	schema, err := gtfsvalidator.ReportJSONSchema()
+	if err != nil {
+		return fmt.Errorf("❌ Schema Error: Failed to generate report schema: %v", err)
+	}
+	schema = append(schema, '\n')
+
+	if schemaOutputFile != "" {
+		if err := os.WriteFile(schemaOutputFile, schema, 0600); err != nil {
+			return fmt.Errorf("❌ Output Error: Failed to write output file '%s': %v", schemaOutputFile, err)
+		}
+		return nil
+	}
+
+	if _, err := os.Stdout.Write(schema); err != nil {
+		return fmt.Errorf("❌ Output Error: Failed to write schema: %v", err)
+	}
+	return nil
+}
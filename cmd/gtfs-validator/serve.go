@@ -0,0 +1,900 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	gtfshttp "github.com/theoremus-urban-solutions/gtfs-validator/http"
+)
+
+var (
+	serveAddr            string
+	serveJobWorkers      int
+	serveQueueSize       int
+	serveMaxUploadSize   int64
+	serveJobTimeout      time.Duration
+	serveJobTTL          time.Duration
+	serveShutdownTimeout time.Duration
+	serveDataDir         string
+	serveCountryCode     string
+	serveMode            string
+	serveMaxNotices      int
+	serveWorkers         int
+	serveQueryModes      []string
+	serveQueryMaxNotices int
+)
+
+func newServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve [flags]",
+		Short: "Run an HTTP server that validates feeds as background jobs",
+		Long: `Run an HTTP server that accepts GTFS feeds and validates them as
+background jobs on a bounded worker pool.
+
+Endpoints:
+  POST   /jobs               Upload a feed (multipart "file" field or a ZIP
+                             request body) or submit {"path": "..."} relative
+                             to --data-dir; returns 202 with the job ID
+  GET    /jobs               List jobs
+  GET    /jobs/{id}          Job status and progress
+  GET    /jobs/{id}/events   Progress and notices as Server-Sent Events
+  GET    /jobs/{id}/report   Final report (?format=json|html|sarif or Accept header)
+  DELETE /jobs/{id}          Cancel a job or delete a finished one
+  GET    /health             Health check
+
+The mode, country, date, maxNotices, disable, severity and lang query
+parameters override the flag defaults per job. The mode parameter may only
+select --query-modes and maxNotices is clamped to --query-max-notices. A full queue is answered with 503. On SIGINT or SIGTERM
+the server stops accepting jobs, waits up to --shutdown-timeout for running
+jobs and then cancels them.`,
+		Example: `  gtfs-validator serve
+  gtfs-validator serve --addr :9000 --jobs 4 --queue 32 --max-upload-size 200
+  gtfs-validator serve --data-dir /srv/feeds
+
+  curl -F file=@feed.zip "http://localhost:8080/jobs?mode=performance"
+  curl -N http://localhost:8080/jobs/<id>/events
+  curl "http://localhost:8080/jobs/<id>/report?format=html" -o report.html`,
+		Args: cobra.NoArgs,
+		RunE: runServe,
+	}
+
+	cmd.Flags().StringVar(&serveAddr, "addr", ":8080", "Address to listen on")
+	cmd.Flags().IntVar(&serveJobWorkers, "jobs", 2, "Number of feeds validated at the same time")
+	cmd.Flags().IntVar(&serveQueueSize, "queue", 16, "Number of jobs that can wait for a free worker")
+	cmd.Flags().Int64Var(&serveMaxUploadSize, "max-upload-size", 512, "Maximum upload size in MB")
+	cmd.Flags().DurationVar(&serveJobTimeout, "job-timeout", 10*time.Minute, "Validation timeout per job")
+	cmd.Flags().DurationVar(&serveJobTTL, "job-ttl", time.Hour, "How long finished jobs and their reports are kept")
+	cmd.Flags().DurationVar(&serveShutdownTimeout, "shutdown-timeout", 30*time.Second, "How long to wait for running jobs on shutdown")
+	cmd.Flags().StringVar(&serveDataDir, "data-dir", "", "Directory local feed paths are resolved in (default: local paths are rejected)")
+	cmd.Flags().StringVarP(&serveCountryCode, "country", "c", "US", "Default country code for validation (e.g., US, GB, FR)")
+	cmd.Flags().StringVarP(&serveMode, "mode", "m", "default", "Default validation mode: performance, default, comprehensive")
+	cmd.Flags().IntVar(&serveMaxNotices, "max-notices", 100, "Default maximum notices per type (0 = no limit)")
+	cmd.Flags().IntVarP(&serveWorkers, "workers", "w", 4, "Number of parallel workers per job")
+	cmd.Flags().StringSliceVar(&serveQueryModes, "query-modes", []string{"performance", "default"}, "Validation modes the mode query parameter may select")
+	cmd.Flags().IntVar(&serveQueryMaxNotices, "query-max-notices", 1000, "Largest maxNotices query parameter; larger values are clamped")
+
+	return cmd
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	validModes := []string{"performance", "default", "comprehensive"}
+	if !contains(validModes, serveMode) {
+		return fmt.Errorf("❌ invalid validation mode: '%s'. valid modes: %s", serveMode, strings.Join(validModes, ", "))
+	}
+	queryModes := make([]gtfsvalidator.ValidationMode, 0, len(serveQueryModes))
+	for _, mode := range serveQueryModes {
+		if !contains(validModes, mode) {
+			return fmt.Errorf("❌ invalid query mode: '%s'. valid modes: %s", mode, strings.Join(validModes, ", "))
+		}
+		queryModes = append(queryModes, gtfsvalidator.ValidationMode(mode))
+	}
+	if serveJobWorkers < 1 || serveQueueSize < 0 || serveMaxUploadSize < 1 {
+		return fmt.Errorf("❌ --jobs and --max-upload-size must be positive and --queue cannot be negative")
+	}
+	if serveDataDir != "" {
+		if info, err := os.Stat(serveDataDir); err != nil || !info.IsDir() {
+			return fmt.Errorf("❌ input error: data directory does not exist: '%s'", serveDataDir)
+		}
+	}
+
+	logOpts, err := loggingOptions()
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	jobs, err := newJobServer(jobServerConfig{
+		Workers:         serveJobWorkers,
+		QueueSize:       serveQueueSize,
+		MaxUploadSize:   serveMaxUploadSize * 1024 * 1024, // Convert MB to bytes
+		JobTimeout:      serveJobTimeout,
+		JobTTL:          serveJobTTL,
+		DataDir:         serveDataDir,
+		CountryCode:     serveCountryCode,
+		Mode:            serveMode,
+		MaxNotices:      serveMaxNotices,
+		ParallelWorkers: serveWorkers,
+		QueryLimits:     gtfshttp.QueryLimits{Modes: queryModes, MaxNotices: serveQueryMaxNotices},
+		Options:         logOpts,
+	})
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	server := &http.Server{
+		Addr:              serveAddr,
+		Handler:           jobs.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		// No ReadTimeout or WriteTimeout: uploads are bounded by --max-upload-size
+		// and event streams stay open until their job finishes.
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+	fmt.Fprintf(os.Stderr, "🚀 Serving GTFS validation on %s (%d jobs at a time, queue of %d)\n", serveAddr, serveJobWorkers, serveQueueSize)
+
+	select {
+	case err := <-serveErr:
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		jobs.shutdown(shutdownCtx)
+		return fmt.Errorf("❌ Server Error: %v", err)
+	case <-ctx.Done():
+	}
+
+	fmt.Fprintf(os.Stderr, "\n⚠️  Shutting down, waiting up to %v for running jobs...\n", serveShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	jobs.shutdown(shutdownCtx)
+
+	// Event streams end with their jobs, so only short requests remain
+	httpCtx, httpCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer httpCancel()
+	if err := server.Shutdown(httpCtx); err != nil {
+		return fmt.Errorf("❌ Server Error: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "👋 Server stopped\n")
+	return nil
+}
+
+// jobServerConfig configures a jobServer.
+type jobServerConfig struct {
+	Workers         int
+	QueueSize       int
+	MaxUploadSize   int64
+	JobTimeout      time.Duration
+	JobTTL          time.Duration
+	DataDir         string
+	CountryCode     string
+	Mode            string
+	MaxNotices      int
+	ParallelWorkers int
+	QueryLimits     gtfshttp.QueryLimits   // Restricts the options jobs may select in the query string
+	Options         []gtfsvalidator.Option // Applied to every job before its own options
+}
+
+// jobStatus is the state of a validation job.
+type jobStatus string
+
+const (
+	jobQueued    jobStatus = "queued"
+	jobRunning   jobStatus = "running"
+	jobSucceeded jobStatus = "succeeded"
+	jobFailed    jobStatus = "failed"
+	jobCancelled jobStatus = "cancelled"
+)
+
+// jobEvent is a Server-Sent Event recorded for a job.
+type jobEvent struct {
+	name string
+	data []byte
+}
+
+// jobProgress is the JSON form of gtfsvalidator.ProgressInfo.
+type jobProgress struct {
+	CurrentValidator    string  `json:"currentValidator,omitempty"`
+	CompletedValidators int     `json:"completedValidators"`
+	TotalValidators     int     `json:"totalValidators"`
+	PercentComplete     float64 `json:"percentComplete"`
+	ElapsedSeconds      float64 `json:"elapsedSeconds"`
+}
+
+// jobResponse describes a job in API responses.
+type jobResponse struct {
+	ID         string                      `json:"id"`
+	Status     jobStatus                   `json:"status"`
+	Feed       string                      `json:"feed"`
+	CreatedAt  time.Time                   `json:"createdAt"`
+	StartedAt  *time.Time                  `json:"startedAt,omitempty"`
+	FinishedAt *time.Time                  `json:"finishedAt,omitempty"`
+	Progress   jobProgress                 `json:"progress"`
+	Counts     *gtfsvalidator.NoticeCounts `json:"counts,omitempty"`
+	Error      string                      `json:"error,omitempty"`
+	Links      map[string]string           `json:"links"`
+}
+
+// maxJobEvents is the number of events kept per job for late event streams.
+const maxJobEvents = 1000
+
+// validationJob is a feed queued for validation. Its last maxJobEvents events
+// are kept so that event streams opened late replay them.
+type validationJob struct {
+	id        string
+	feed      string
+	path      string
+	upload    string // temporary file removed when the job ends
+	opts      []gtfsvalidator.Option
+	createdAt time.Time
+
+	mu         sync.Mutex
+	status     jobStatus
+	startedAt  time.Time
+	finishedAt time.Time
+	progress   jobProgress
+	report     *gtfsvalidator.ValidationReport
+	err        string
+	events     []jobEvent    // ring buffer of the last maxJobEvents events
+	recorded   int           // number of events recorded, the ID of the next event
+	changed    chan struct{} // closed and replaced whenever an event is recorded
+	cancel     context.CancelFunc
+	cancelled  bool
+}
+
+// jobServer queues validation jobs onto a bounded worker pool and serves their
+// status, events and reports over HTTP.
+type jobServer struct {
+	config    jobServerConfig
+	uploadDir string
+	queue     chan *validationJob
+	ctx       context.Context // cancelled when running jobs must stop
+	cancel    context.CancelFunc
+	workers   sync.WaitGroup
+	janitor   chan struct{}
+
+	mu      sync.Mutex
+	jobs    map[string]*validationJob
+	closing bool
+}
+
+// newJobServer creates a jobServer and starts its workers.
+func newJobServer(config jobServerConfig) (*jobServer, error) {
+	uploadDir, err := os.MkdirTemp("", "gtfs-validator-serve-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &jobServer{
+		config:    config,
+		uploadDir: uploadDir,
+		queue:     make(chan *validationJob, config.QueueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		janitor:   make(chan struct{}),
+		jobs:      make(map[string]*validationJob),
+	}
+	for i := 0; i < config.Workers; i++ {
+		s.workers.Add(1)
+		go s.work()
+	}
+	go s.expireJobs()
+	return s, nil
+}
+
+// shutdown stops accepting jobs, cancels queued jobs and waits for running jobs
+// until ctx is done, after which they are cancelled.
+func (s *jobServer) shutdown(ctx context.Context) {
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		return
+	}
+	s.closing = true
+	close(s.queue)
+	close(s.janitor)
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.cancel()
+		<-done
+	}
+	s.cancel()
+
+	if err := os.RemoveAll(s.uploadDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to remove upload directory: %v\n", err)
+	}
+}
+
+// handler returns the HTTP API.
+func (s *jobServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /jobs", s.handleSubmit)
+	mux.HandleFunc("GET /jobs", s.handleList)
+	mux.HandleFunc("GET /jobs/{id}", s.handleStatus)
+	mux.HandleFunc("DELETE /jobs/{id}", s.handleDelete)
+	mux.HandleFunc("GET /jobs/{id}/events", s.handleEvents)
+	mux.HandleFunc("GET /jobs/{id}/report", s.handleReport)
+	mux.HandleFunc("GET /health", s.handleHealth)
+	return mux
+}
+
+func (s *jobServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	opts, err := s.jobOptions(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job := &validationJob{
+		id:        newJobID(),
+		opts:      opts,
+		createdAt: time.Now(),
+		status:    jobQueued,
+		changed:   make(chan struct{}),
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, s.config.MaxUploadSize)
+	if status, err := s.readFeed(r, job); err != nil {
+		s.removeUpload(job)
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		s.removeUpload(job)
+		writeJSONError(w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+	select {
+	case s.queue <- job:
+		s.jobs[job.id] = job
+		s.mu.Unlock()
+	default:
+		s.mu.Unlock()
+		s.removeUpload(job)
+		w.Header().Set("Retry-After", "30")
+		writeJSONError(w, http.StatusServiceUnavailable, "job queue is full")
+		return
+	}
+
+	w.Header().Set("Location", "/jobs/"+job.id)
+	writeJSON(w, http.StatusAccepted, job.response())
+}
+
+// readFeed stores an uploaded feed in the upload directory or resolves a local
+// path, returning the HTTP status to answer with on error.
+func (s *jobServer) readFeed(r *http.Request, job *validationJob) (int, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "application/zip"
+	}
+
+	switch {
+	case mediaType == "multipart/form-data":
+		reader, err := r.MultipartReader()
+		if err != nil {
+			return http.StatusBadRequest, fmt.Errorf("invalid multipart body: %v", err)
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return http.StatusBadRequest, errors.New(`missing "file" or "path" field`)
+			}
+			if err != nil {
+				return uploadErrorStatus(err), fmt.Errorf("failed to read upload: %v", err)
+			}
+			switch part.FormName() {
+			case "file":
+				job.feed = filepath.Base(part.FileName())
+				return s.saveUpload(part, job)
+			case "path":
+				value, err := io.ReadAll(io.LimitReader(part, 4096))
+				if err != nil {
+					return uploadErrorStatus(err), fmt.Errorf("failed to read path: %v", err)
+				}
+				return s.resolveLocalPath(string(value), job)
+			}
+		}
+	case mediaType == "application/json":
+		var request struct {
+			Path string `json:"path"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			return uploadErrorStatus(err), fmt.Errorf("invalid JSON body: %v", err)
+		}
+		return s.resolveLocalPath(request.Path, job)
+	default:
+		job.feed = "upload.zip"
+		return s.saveUpload(r.Body, job)
+	}
+}
+
+// saveUpload copies an uploaded ZIP into the upload directory.
+func (s *jobServer) saveUpload(body io.Reader, job *validationJob) (int, error) {
+	if job.feed == "" || job.feed == "." || job.feed == string(filepath.Separator) {
+		job.feed = "upload.zip"
+	}
+	file, err := os.CreateTemp(s.uploadDir, job.id+"-*.zip")
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to store upload: %v", err)
+	}
+	job.upload = file.Name()
+	job.path = file.Name()
+
+	written, err := io.Copy(file, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return uploadErrorStatus(err), fmt.Errorf("failed to read upload: %v", err)
+	}
+	if written == 0 {
+		return http.StatusBadRequest, errors.New("empty upload")
+	}
+	return 0, nil
+}
+
+// resolveLocalPath resolves a feed path inside the data directory.
+func (s *jobServer) resolveLocalPath(path string, job *validationJob) (int, error) {
+	if s.config.DataDir == "" {
+		return http.StatusForbidden, errors.New("local paths are disabled; start the server with --data-dir")
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return http.StatusBadRequest, errors.New("empty path")
+	}
+	if filepath.IsAbs(path) || !filepath.IsLocal(path) {
+		return http.StatusForbidden, fmt.Errorf("path must be relative to the data directory: %s", path)
+	}
+	resolved := filepath.Join(s.config.DataDir, path)
+	if _, err := os.Stat(resolved); err != nil {
+		return http.StatusNotFound, fmt.Errorf("feed not found: %s", path)
+	}
+	job.feed = path
+	job.path = resolved
+	return 0, nil
+}
+
+// jobOptions applies the flag defaults and then the query parameters mapped by
+// gtfshttp.OptionsFromQuery within the configured query limits.
+func (s *jobServer) jobOptions(r *http.Request) ([]gtfsvalidator.Option, error) {
+	queryOptions, err := gtfshttp.OptionsFromQuery(r.URL.Query(), s.config.QueryLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append(append([]gtfsvalidator.Option(nil), s.config.Options...),
+		gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationMode(s.config.Mode)),
+		gtfsvalidator.WithCountryCode(s.config.CountryCode),
+		gtfsvalidator.WithMaxNoticesPerType(s.config.MaxNotices),
+		gtfsvalidator.WithParallelWorkers(s.config.ParallelWorkers),
+	)
+	return append(opts, queryOptions...), nil
+}
+
+func (s *jobServer) handleList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	responses := make([]jobResponse, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		responses = append(responses, job.response())
+	}
+	s.mu.Unlock()
+
+	sort.Slice(responses, func(i, j int) bool { return responses[i].CreatedAt.Before(responses[j].CreatedAt) })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"jobs": responses})
+}
+
+func (s *jobServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	job := s.lookup(w, r)
+	if job == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, job.response())
+}
+
+func (s *jobServer) handleDelete(w http.ResponseWriter, r *http.Request) {
+	job := s.lookup(w, r)
+	if job == nil {
+		return
+	}
+
+	job.mu.Lock()
+	finished := !job.finishedAt.IsZero()
+	if !finished {
+		job.cancelled = true
+		if job.cancel != nil {
+			job.cancel()
+		}
+	}
+	job.mu.Unlock()
+
+	if finished {
+		s.mu.Lock()
+		delete(s.jobs, job.id)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, job.response())
+}
+
+// handleEvents streams a job's progress and notices as Server-Sent Events,
+// replaying the kept events recorded before the stream was opened.
+func (s *jobServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	job := s.lookup(w, r)
+	if job == nil {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(15 * time.Second)
+	defer keepAlive.Stop()
+
+	next := 0
+	for {
+		from, events, changed, done := job.eventsSince(next)
+		next = from
+		for _, event := range events {
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", next, event.name, event.data); err != nil {
+				return
+			}
+			next++
+		}
+		flusher.Flush()
+		if done {
+			return
+		}
+
+		select {
+		case <-changed:
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// handleReport writes the final report as JSON, HTML or SARIF, chosen by the
+// format query parameter or the Accept header.
+func (s *jobServer) handleReport(w http.ResponseWriter, r *http.Request) {
+	job := s.lookup(w, r)
+	if job == nil {
+		return
+	}
+
+	job.mu.Lock()
+	report, status, jobErr := job.report, job.status, job.err
+	job.mu.Unlock()
+
+	switch {
+	case status == jobQueued || status == jobRunning:
+		writeJSONError(w, http.StatusConflict, fmt.Sprintf("job is %s", status))
+		return
+	case report == nil:
+		writeJSONError(w, http.StatusUnprocessableEntity, fmt.Sprintf("job %s: %s", status, jobErr))
+		return
+	}
+
+	format, err := gtfshttp.NegotiateFormat(r)
+	if err != nil {
+		writeJSONError(w, http.StatusNotAcceptable, err.Error())
+		return
+	}
+
+	switch format {
+	case gtfshttp.FormatHTML:
+		formatter, err := gtfsvalidator.NewHTMLFormatter()
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, fmt.Sprintf("failed to create HTML formatter: %v", err))
+			return
+		}
+		html, err := formatter.GenerateHTMLString(report)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, fmt.Sprintf("failed to generate HTML report: %v", err))
+			return
+		}
+		w.Header().Set("Content-Type", format.ContentType())
+		if _, err := io.WriteString(w, html); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write HTML report: %v\n", err)
+		}
+	case gtfshttp.FormatSARIF:
+		w.Header().Set("Content-Type", format.ContentType())
+		if err := report.WriteSARIF(w); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write SARIF report: %v\n", err)
+		}
+	default:
+		writeJSON(w, http.StatusOK, report)
+	}
+}
+
+func (s *jobServer) handleHealth(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	closing := s.closing
+	s.mu.Unlock()
+
+	status, code := "healthy", http.StatusOK
+	if closing {
+		status, code = "shutting down", http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, map[string]interface{}{
+		"status":  status,
+		"version": version,
+		"queued":  len(s.queue),
+	})
+}
+
+// lookup returns the job named in the URL, answering 404 if there is none.
+func (s *jobServer) lookup(w http.ResponseWriter, r *http.Request) *validationJob {
+	s.mu.Lock()
+	job := s.jobs[r.PathValue("id")]
+	s.mu.Unlock()
+	if job == nil {
+		writeJSONError(w, http.StatusNotFound, "job not found")
+	}
+	return job
+}
+
+// work validates queued jobs until the queue is closed.
+func (s *jobServer) work() {
+	defer s.workers.Done()
+	for job := range s.queue {
+		s.run(job)
+	}
+}
+
+// run validates a job, streaming its notices and progress as events.
+func (s *jobServer) run(job *validationJob) {
+	defer s.removeUpload(job)
+
+	s.mu.Lock()
+	closing := s.closing
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.config.JobTimeout)
+	defer cancel()
+
+	job.mu.Lock()
+	if job.cancelled || closing {
+		job.mu.Unlock()
+		job.finish(nil, jobCancelled, "cancelled before it started")
+		return
+	}
+	job.status = jobRunning
+	job.startedAt = time.Now()
+	job.cancel = cancel
+	job.mu.Unlock()
+	job.record("status", job.response())
+
+	opts := append(append([]gtfsvalidator.Option(nil), job.opts...),
+		gtfsvalidator.WithProgressCallback(job.setProgress))
+	validator := gtfsvalidator.New(opts...)
+	report, err := validator.ValidateFileStreamWithContext(ctx, job.path, func(group gtfsvalidator.NoticeGroup) {
+		job.record("notice", group)
+	})
+
+	job.mu.Lock()
+	cancelled := job.cancelled
+	job.mu.Unlock()
+
+	switch {
+	case err == nil:
+		job.finish(report, jobSucceeded, "")
+	case cancelled || errors.Is(err, context.Canceled):
+		job.finish(nil, jobCancelled, "cancelled")
+	case errors.Is(err, context.DeadlineExceeded):
+		job.finish(nil, jobFailed, fmt.Sprintf("validation timed out after %v", s.config.JobTimeout))
+	default:
+		job.finish(nil, jobFailed, err.Error())
+	}
+}
+
+// removeUpload deletes the uploaded copy of a job's feed.
+func (s *jobServer) removeUpload(job *validationJob) {
+	if job.upload == "" {
+		return
+	}
+	if err := os.Remove(job.upload); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to remove upload: %v\n", err)
+	}
+}
+
+// expireJobs forgets finished jobs after the job TTL.
+func (s *jobServer) expireJobs() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.janitor:
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for id, job := range s.jobs {
+				job.mu.Lock()
+				expired := !job.finishedAt.IsZero() && now.Sub(job.finishedAt) > s.config.JobTTL
+				job.mu.Unlock()
+				if expired {
+					delete(s.jobs, id)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// setProgress records a progress update.
+func (j *validationJob) setProgress(info gtfsvalidator.ProgressInfo) {
+	progress := jobProgress{
+		CurrentValidator:    info.CurrentValidator,
+		CompletedValidators: info.CompletedValidators,
+		TotalValidators:     info.TotalValidators,
+		PercentComplete:     info.PercentComplete,
+		ElapsedSeconds:      info.ElapsedTime.Seconds(),
+	}
+	j.mu.Lock()
+	j.progress = progress
+	j.mu.Unlock()
+	j.record("progress", progress)
+}
+
+// record appends an event and wakes up the event streams.
+func (j *validationJob) record(name string, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to encode %s event: %v\n", name, err)
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	event := jobEvent{name: name, data: data}
+	if len(j.events) < maxJobEvents {
+		j.events = append(j.events, event)
+	} else {
+		j.events[j.recorded%maxJobEvents] = event
+	}
+	j.recorded++
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// finish records the outcome of a job and sends the final "done" event.
+func (j *validationJob) finish(report *gtfsvalidator.ValidationReport, status jobStatus, message string) {
+	j.mu.Lock()
+	j.report = report
+	j.status = status
+	j.err = message
+	j.finishedAt = time.Now()
+	if status == jobSucceeded {
+		j.progress.PercentComplete = 100
+		j.progress.CurrentValidator = ""
+	}
+	j.mu.Unlock()
+	j.record("done", j.response())
+}
+
+// eventsSince returns the ID of the first kept event from ID next on, the
+// events from there, a channel closed when more are recorded and whether the
+// job has finished. Events dropped from the ring buffer are skipped.
+func (j *validationJob) eventsSince(next int) (int, []jobEvent, <-chan struct{}, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if oldest := j.recorded - len(j.events); next < oldest {
+		next = oldest
+	}
+	var events []jobEvent
+	for id := next; id < j.recorded; id++ {
+		events = append(events, j.events[id%maxJobEvents])
+	}
+	return next, events, j.changed, !j.finishedAt.IsZero()
+}
+
+// response describes the job for API responses.
+func (j *validationJob) response() jobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	response := jobResponse{
+		ID:        j.id,
+		Status:    j.status,
+		Feed:      j.feed,
+		CreatedAt: j.createdAt,
+		Progress:  j.progress,
+		Error:     j.err,
+		Links: map[string]string{
+			"self":   "/jobs/" + j.id,
+			"events": "/jobs/" + j.id + "/events",
+			"report": "/jobs/" + j.id + "/report",
+		},
+	}
+	if !j.startedAt.IsZero() {
+		startedAt := j.startedAt
+		response.StartedAt = &startedAt
+	}
+	if !j.finishedAt.IsZero() {
+		finishedAt := j.finishedAt
+		response.FinishedAt = &finishedAt
+	}
+	if j.report != nil {
+		counts := j.report.Summary.Counts
+		response.Counts = &counts
+	}
+	return response
+}
+
+// uploadErrorStatus answers oversized uploads with 413 and other read errors with 400.
+func uploadErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+// newJobID returns a random job ID.
+func newJobID() string {
+	var id [12]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(id[:])
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to encode response: %v\n", err)
+	}
+}
+
+// writeJSONError writes a JSON error response.
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+var (
+	watchCountryCode string
+	watchMode        string
+	watchMaxNotices  int
+	watchInterval    time.Duration
+	watchDebounce    time.Duration
+	watchTranscode   bool
+	watchRecoverCSV  bool
+)
+
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [flags] <dir>",
+		Short: "Revalidate a feed directory whenever it changes",
+		Long: `Validate a GTFS feed directory, then poll it for changes and revalidate
+after the files have stopped changing for the debounce duration.
+
+After the first run only the notices that changed are printed: new and
+resolved notice codes, changed counts and newly affected entities. Only the
+validators that read a changed, added or removed file are re-run; the other
+validators' notices are reused. Press Ctrl+C to stop.`,
+		Example: `  gtfs-validator watch ./my-feed
+  gtfs-validator watch ./my-feed --interval 2s --debounce 1s -m comprehensive`,
+		Args: cobra.ExactArgs(1),
+		RunE: runWatch,
+	}
+
+	cmd.Flags().StringVarP(&watchCountryCode, "country", "c", "US", "Country code for validation (e.g., US, GB, FR)")
+	cmd.Flags().StringVarP(&watchMode, "mode", "m", "default", "Validation mode: performance, default, comprehensive")
+	cmd.Flags().IntVar(&watchMaxNotices, "max-notices", 100, "Maximum notices per type (0 = no limit)")
+	cmd.Flags().DurationVar(&watchInterval, "interval", time.Second, "How often to poll the directory for changes")
+	cmd.Flags().DurationVar(&watchDebounce, "debounce", 500*time.Millisecond, "How long the files must stay unchanged before revalidating")
+	cmd.Flags().BoolVar(&watchTranscode, "transcode", false, "Transcode Windows-1252, ISO-8859-1 and UTF-16 files to UTF-8 before validation")
+	cmd.Flags().BoolVar(&watchRecoverCSV, "recover-csv", false, "Skip malformed CSV rows with a csv_parsing_failed notice and resume parsing at the next line")
+
+	return cmd
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	validModes := []string{"performance", "default", "comprehensive"}
+	if !contains(validModes, watchMode) {
+		return fmt.Errorf("❌ invalid validation mode: '%s'. valid modes: %s", watchMode, strings.Join(validModes, ", "))
+	}
+	if watchInterval <= 0 {
+		return fmt.Errorf("❌ invalid interval: %v. must be positive", watchInterval)
+	}
+
+	logOpts, err := loggingOptions()
+	if err != nil {
+		return fmt.Errorf("❌ %v", err)
+	}
+
+	watcher, err := gtfsvalidator.NewWatcher(args[0], append(logOpts,
+		gtfsvalidator.WithCountryCode(watchCountryCode),
+		gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationMode(watchMode)),
+		gtfsvalidator.WithMaxNoticesPerType(watchMaxNotices),
+		gtfsvalidator.WithTranscoding(watchTranscode),
+		gtfsvalidator.WithCSVRecovery(watchRecoverCSV),
+	)...)
+	if err != nil {
+		return fmt.Errorf("❌ input error: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	fmt.Fprintf(os.Stderr, "👀 Watching %s for changes (Ctrl+C to stop)...\n\n", args[0])
+	err = watcher.Watch(ctx, watchInterval, watchDebounce, func(result *gtfsvalidator.WatchResult, err error) {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Validation Error: %v\n\n", err)
+			return
+		}
+		outputWatchResult(os.Stdout, result)
+	})
+	if err != nil && err != context.Canceled {
+		return fmt.Errorf("❌ %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "\n👋 Stopped watching\n")
+	return nil
+}
+
+// outputWatchResult prints the counts of the first run and the notice changes of later runs.
+func outputWatchResult(output *os.File, result *gtfsvalidator.WatchResult) {
+	write := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(output, format, args...); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to write console output: %v\n", err)
+		}
+	}
+
+	counts := result.Report.Summary.Counts
+	timestamp := time.Now().Format("15:04:05")
+
+	if result.Comparison == nil {
+		write("[%s] Validated %d validators in %.2fs: %d errors, %d warnings, %d infos\n\n",
+			timestamp, result.ValidatorsTotal, result.Duration.Seconds(), counts.Errors, counts.Warnings, counts.Infos)
+		return
+	}
+
+	write("[%s] Changed: %s\n", timestamp, strings.Join(result.ChangedFiles, ", "))
+	write("Re-ran %d of %d validators in %.2fs: %d errors (%s), %d warnings (%s), %d infos (%s)\n",
+		result.ValidatorsRun, result.ValidatorsTotal, result.Duration.Seconds(),
+		counts.Errors, gtfsvalidator.SignedDelta(result.Comparison.SeverityDeltas.Errors),
+		counts.Warnings, gtfsvalidator.SignedDelta(result.Comparison.SeverityDeltas.Warnings),
+		counts.Infos, gtfsvalidator.SignedDelta(result.Comparison.SeverityDeltas.Infos))
+
+	if result.Comparison.HasChanges() {
+		outputComparisonChanges(write, result.Comparison)
+	} else {
+		write("✅ No notice changes\n")
+	}
+	write("\n")
+}
@@ -0,0 +1,247 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileNames lists the configuration file names FindConfigFile looks for, in order.
+var ConfigFileNames = []string{".gtfs-validator.yaml", ".gtfs-validator.yml", ".gtfs-validator.json"}
+
+// ConfigFile is the content of a .gtfs-validator.yaml or .gtfs-validator.json
+// configuration file. Settings left out keep their defaults.
+type ConfigFile struct {
+	// Mode is the validation mode: performance, default or comprehensive.
+	Mode string `json:"mode,omitempty" yaml:"mode,omitempty"`
+
+	// Country is the 2-letter country code used for phone number validation.
+	Country string `json:"country,omitempty" yaml:"country,omitempty"`
+
+	// CurrentDate is the date to validate against, as YYYY-MM-DD or YYYYMMDD.
+	CurrentDate string `json:"currentDate,omitempty" yaml:"currentDate,omitempty"`
+
+	// Workers is the number of parallel workers.
+	Workers *int `json:"workers,omitempty" yaml:"workers,omitempty"`
+
+	// MaxNotices is the maximum number of notices per type (0 = no limit).
+	MaxNotices *int `json:"maxNotices,omitempty" yaml:"maxNotices,omitempty"`
+
+	// Timeout is the validation timeout used by the CLI, e.g. "10m".
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Locale is the language of notice descriptions and HTML reports, e.g. "fr".
+	Locale string `json:"locale,omitempty" yaml:"locale,omitempty"`
+
+	// SeverityOverrides maps notice codes to ERROR, WARNING or INFO.
+	SeverityOverrides map[string]string `json:"severityOverrides,omitempty" yaml:"severityOverrides,omitempty"`
+
+	// DisabledRules lists notice codes that are never reported.
+	DisabledRules []string `json:"disabledRules,omitempty" yaml:"disabledRules,omitempty"`
+
+	// Thresholds configures the diff and multi-feed checks.
+	Thresholds ConfigThresholds `json:"thresholds,omitempty" yaml:"thresholds,omitempty"`
+}
+
+// ConfigThresholds contains the validator thresholds of a configuration file.
+type ConfigThresholds struct {
+	// TripCountChangePercent is the change in trips per route per date reported by ValidateDiff.
+	TripCountChangePercent *float64 `json:"tripCountChangePercent,omitempty" yaml:"tripCountChangePercent,omitempty"`
+
+	// StopMovedMeters is the distance a stop can move before ValidateDiff reports it.
+	StopMovedMeters *float64 `json:"stopMovedMeters,omitempty" yaml:"stopMovedMeters,omitempty"`
+
+	// ShapeChangedMeters is the maximum deviation of a shape before ValidateDiff reports it.
+	ShapeChangedMeters *float64 `json:"shapeChangedMeters,omitempty" yaml:"shapeChangedMeters,omitempty"`
+
+	// CrossFeedStopDistanceMeters is the distance within which ValidateFeeds reports stops of different feeds.
+	CrossFeedStopDistanceMeters *float64 `json:"crossFeedStopDistanceMeters,omitempty" yaml:"crossFeedStopDistanceMeters,omitempty"`
+}
+
+// LoadConfigFile reads a YAML or JSON configuration file and returns the options it sets.
+func LoadConfigFile(path string) ([]Option, error) {
+	file, err := ReadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return file.Options()
+}
+
+// ReadConfigFile reads and checks a configuration file. Files ending in .json are
+// parsed as JSON, all others as YAML. Unknown keys are rejected to catch typos.
+func ReadConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- User-provided config file path
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file ConfigFile
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	if _, err := file.Options(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// FindConfigFile returns the first of ConfigFileNames present in dir, or "" if there is none.
+func FindConfigFile(dir string) string {
+	for _, name := range ConfigFileNames {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// Options returns the options set by the configuration file, or an error
+// describing every invalid setting.
+func (f *ConfigFile) Options() ([]Option, error) {
+	var opts []Option
+	var errs []error
+
+	if f.Mode != "" {
+		switch mode := ValidationMode(f.Mode); mode {
+		case ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive:
+			opts = append(opts, WithValidationMode(mode))
+		default:
+			errs = append(errs, fmt.Errorf("unknown mode %q: expected performance, default or comprehensive", f.Mode))
+		}
+	}
+
+	if f.Country != "" {
+		if len(f.Country) != 2 {
+			errs = append(errs, fmt.Errorf("country must be a 2-letter ISO code, got %q", f.Country))
+		} else {
+			opts = append(opts, WithCountryCode(strings.ToUpper(f.Country)))
+		}
+	}
+
+	if f.CurrentDate != "" {
+		date, err := parseConfigDate(f.CurrentDate)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			opts = append(opts, WithCurrentDate(date))
+		}
+	}
+
+	if f.Workers != nil {
+		if *f.Workers < 0 || *f.Workers > 100 {
+			errs = append(errs, fmt.Errorf("workers must be between 0 and 100, got %d", *f.Workers))
+		} else {
+			opts = append(opts, WithParallelWorkers(*f.Workers))
+		}
+	}
+
+	if f.MaxNotices != nil {
+		if *f.MaxNotices < 0 || *f.MaxNotices > 10000 {
+			errs = append(errs, fmt.Errorf("maxNotices must be between 0 and 10000, got %d", *f.MaxNotices))
+		} else {
+			opts = append(opts, WithMaxNoticesPerType(*f.MaxNotices))
+		}
+	}
+
+	if _, err := f.TimeoutDuration(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if f.Locale != "" {
+		if _, err := language.Parse(f.Locale); err != nil {
+			errs = append(errs, fmt.Errorf("locale must be a language tag such as fr, got %q", f.Locale))
+		} else {
+			opts = append(opts, WithLocale(f.Locale))
+		}
+	}
+
+	for code, severity := range f.SeverityOverrides {
+		if _, known := notice.LookupRule(code); !known {
+			errs = append(errs, fmt.Errorf("unknown notice code %q in severityOverrides", code))
+			continue
+		}
+		if _, err := notice.ParseSeverity(severity); err != nil {
+			errs = append(errs, fmt.Errorf("severityOverrides.%s: %w", code, err))
+			continue
+		}
+		opts = append(opts, WithSeverityOverride(code, strings.ToUpper(severity)))
+	}
+
+	for _, code := range f.DisabledRules {
+		if _, known := notice.LookupRule(code); !known {
+			errs = append(errs, fmt.Errorf("unknown notice code %q in disabledRules", code))
+			continue
+		}
+		opts = append(opts, WithDisabledRules(code))
+	}
+
+	thresholds := []struct {
+		name  string
+		value *float64
+		set   func(c *Config, value float64)
+	}{
+		{"tripCountChangePercent", f.Thresholds.TripCountChangePercent, func(c *Config, value float64) { c.DiffThresholds.TripCountChangePercent = value }},
+		{"stopMovedMeters", f.Thresholds.StopMovedMeters, func(c *Config, value float64) { c.DiffThresholds.StopMovedMeters = value }},
+		{"shapeChangedMeters", f.Thresholds.ShapeChangedMeters, func(c *Config, value float64) { c.DiffThresholds.ShapeChangedMeters = value }},
+		{"crossFeedStopDistanceMeters", f.Thresholds.CrossFeedStopDistanceMeters, func(c *Config, value float64) { c.CrossFeedStopDistanceMeters = value }},
+	}
+	for _, threshold := range thresholds {
+		if threshold.value == nil {
+			continue
+		}
+		if *threshold.value < 0 {
+			errs = append(errs, fmt.Errorf("thresholds.%s cannot be negative: %v", threshold.name, *threshold.value))
+			continue
+		}
+		value, set := *threshold.value, threshold.set
+		opts = append(opts, func(c *Config) { set(c, value) })
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return opts, nil
+}
+
+// TimeoutDuration parses Timeout, returning 0 when it is not set.
+func (f *ConfigFile) TimeoutDuration() (time.Duration, error) {
+	if f.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(f.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be a positive duration such as 10m, got %q", f.Timeout)
+	}
+	return timeout, nil
+}
+
+// parseConfigDate parses a YYYY-MM-DD or YYYYMMDD date.
+func parseConfigDate(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "20060102"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("currentDate must be YYYY-MM-DD or YYYYMMDD, got %q", value)
+}
@@ -0,0 +1,175 @@
+package gtfsvalidator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes a configuration file into dir
+func writeConfigFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	yamlConfig := `mode: comprehensive
+country: gb
+currentDate: 2025-03-01
+workers: 2
+maxNotices: 0
+timeout: 10m
+locale: fr
+severityOverrides:
+  all_caps_headsign: ERROR
+disabledRules:
+  - block_too_many_trips
+thresholds:
+  stopMovedMeters: 250
+  crossFeedStopDistanceMeters: 5
+`
+	jsonConfig := `{
+  "mode": "comprehensive",
+  "country": "gb",
+  "currentDate": "20250301",
+  "workers": 2,
+  "maxNotices": 0,
+  "timeout": "10m",
+  "locale": "fr",
+  "severityOverrides": {"all_caps_headsign": "error"},
+  "disabledRules": ["block_too_many_trips"],
+  "thresholds": {"stopMovedMeters": 250, "crossFeedStopDistanceMeters": 5}
+}`
+
+	for name, content := range map[string]string{".gtfs-validator.yaml": yamlConfig, ".gtfs-validator.json": jsonConfig} {
+		t.Run(name, func(t *testing.T) {
+			path := writeConfigFile(t, t.TempDir(), name, content)
+			opts, err := LoadConfigFile(path)
+			if err != nil {
+				t.Fatalf("LoadConfigFile failed: %v", err)
+			}
+
+			config := New(opts...).(*validatorImpl).config
+			if config.ValidationMode != ValidationModeComprehensive || config.CountryCode != "GB" {
+				t.Errorf("Expected comprehensive mode for GB, got %s for %s", config.ValidationMode, config.CountryCode)
+			}
+			if !config.CurrentDate.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("Expected current date 2025-03-01, got %v", config.CurrentDate)
+			}
+			if config.ParallelWorkers != 2 || config.MaxNoticesPerType != 0 {
+				t.Errorf("Expected 2 workers and no notice limit, got %d and %d", config.ParallelWorkers, config.MaxNoticesPerType)
+			}
+			if config.SeverityOverrides["all_caps_headsign"] != "ERROR" {
+				t.Errorf("Expected all_caps_headsign override to ERROR, got %v", config.SeverityOverrides)
+			}
+			if len(config.DisabledRules) != 1 || config.DisabledRules[0] != "block_too_many_trips" {
+				t.Errorf("Expected block_too_many_trips to be disabled, got %v", config.DisabledRules)
+			}
+			if config.DiffThresholds.StopMovedMeters != 250 || config.CrossFeedStopDistanceMeters != 5 {
+				t.Errorf("Expected thresholds to be applied, got %+v and %v", config.DiffThresholds, config.CrossFeedStopDistanceMeters)
+			}
+			if config.Locale != "fr" {
+				t.Errorf("Expected locale fr, got %q", config.Locale)
+			}
+			if config.DiffThresholds.ShapeChangedMeters != DefaultDiffThresholds().ShapeChangedMeters {
+				t.Errorf("Expected unset thresholds to keep their defaults, got %+v", config.DiffThresholds)
+			}
+		})
+	}
+}
+
+func TestReadConfigFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected []string
+	}{
+		{"unknown YAML key", "config.yaml", "mdoe: default\n", []string{"mdoe"}},
+		{"unknown JSON key", "config.json", `{"mdoe": "default"}`, []string{"mdoe"}},
+		{
+			name: "invalid settings",
+			file: "config.yaml",
+			content: `mode: fast
+country: GBR
+currentDate: 01/03/2025
+timeout: soon
+locale: "fr_FR!"
+severityOverrides:
+  no_such_code: ERROR
+  all_caps_headsign: FATAL
+disabledRules: [another_unknown_code]
+thresholds:
+  stopMovedMeters: -1
+`,
+			expected: []string{"fast", "GBR", "01/03/2025", "soon", "fr_FR!", "no_such_code", "FATAL", "another_unknown_code", "stopMovedMeters"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfigFile(t, t.TempDir(), tt.file, tt.content)
+			_, err := ReadConfigFile(path)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to mention %q, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFindConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	if path := FindConfigFile(dir); path != "" {
+		t.Errorf("Expected no config file, got %s", path)
+	}
+
+	writeConfigFile(t, dir, ".gtfs-validator.json", "{}")
+	yamlPath := writeConfigFile(t, dir, ".gtfs-validator.yaml", "")
+	if path := FindConfigFile(dir); path != yamlPath {
+		t.Errorf("Expected YAML config to take precedence, got %s", path)
+	}
+
+	// An empty file is a valid configuration that sets nothing
+	opts, err := LoadConfigFile(yamlPath)
+	if err != nil || len(opts) != 0 {
+		t.Errorf("Expected no options from an empty file, got %d options and %v", len(opts), err)
+	}
+}
+
+func TestLoadConfigFile_RuleOverrides(t *testing.T) {
+	dir := writeFeedDir(t, "feed", MinimalValidGTFS())
+	baseline, err := New().ValidateFile(dir)
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	if len(baseline.Notices) == 0 {
+		t.Skip("Minimal feed produces no notices to override")
+	}
+	disabled := baseline.Notices[0].Code
+
+	path := writeConfigFile(t, t.TempDir(), "config.yaml", "disabledRules: ["+disabled+"]\n")
+	opts, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatalf("LoadConfigFile failed: %v", err)
+	}
+	report, err := New(opts...).ValidateFile(dir)
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	for _, n := range report.Notices {
+		if n.Code == disabled {
+			t.Fatalf("Expected %s to be disabled", disabled)
+		}
+	}
+}
@@ -1,9 +1,14 @@
 package gtfsvalidator
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 )
 
 // TestConfigValidation tests configuration validation and sanitization
@@ -284,3 +289,24 @@ func TestConfigValidationFunctions(t *testing.T) {
 		}
 	})
 }
+
+// TestWithLogger tests that the configured logger reaches the validation pipeline
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logging.NewLoggerWithWriter(&buf)
+
+	validator := New(WithLogger(logger), WithParallelWorkers(-1))
+	if !strings.Contains(buf.String(), "Invalid configuration") {
+		t.Errorf("Expected the sanitized configuration to be logged, got: %s", buf.String())
+	}
+
+	loader, err := validator.(*validatorImpl).config.loadFeed("", func() (*parser.FeedLoader, error) {
+		return parser.LoadFromFS(fstest.MapFS{"agency.txt": {Data: []byte("agency_id\nA1")}})
+	})
+	if err != nil {
+		t.Fatalf("Failed to load feed: %v", err)
+	}
+	if loader.Logger() != logger {
+		t.Error("Expected the loader to use the configured logger")
+	}
+}
@@ -0,0 +1,148 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/report"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator/diff"
+)
+
+// ValidateDiff validates the current feed and compares it with the previous feed version.
+func (v *validatorImpl) ValidateDiff(previousPath, currentPath string) (*ValidationReport, error) {
+	return v.ValidateDiffWithContext(context.Background(), previousPath, currentPath)
+}
+
+// ValidateDiffWithContext runs the standard validation on the current feed and adds
+// diff notices (removed or renamed IDs, moved stops, changed trip counts, removed
+// service dates and changed shapes) comparing it with the previous feed version.
+func (v *validatorImpl) ValidateDiffWithContext(ctx context.Context, previousPath, currentPath string) (*ValidationReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	startTime := time.Now()
+
+	previousLoader, err := v.config.openFeedLoader(previousPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load previous feed: %w", err)
+	}
+	defer func() {
+		if err := previousLoader.Close(); err != nil {
+			previousLoader.Logger().Warn("Failed to close loader", logging.String("feed", previousPath), logging.ErrorField("error", err))
+		}
+	}()
+
+	currentLoader, err := v.config.openFeedLoader(currentPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load current feed: %w", err)
+	}
+	defer func() {
+		if err := currentLoader.Close(); err != nil {
+			currentLoader.Logger().Warn("Failed to close loader", logging.String("feed", currentPath), logging.ErrorField("error", err))
+		}
+	}()
+
+	internalValidator := newInternalValidator(v.createInternalConfig(), v.createValidationConfig())
+	if v.config.ProgressCallback != nil {
+		internalValidator.progressCallback = v.config.ProgressCallback
+	}
+
+	internalReport, err := internalValidator.ValidateDiffWithContext(ctx, previousLoader, currentLoader, currentPath)
+	return v.finishReport(internalValidator, internalReport, startTime, err)
+}
+
+// openFeedLoader opens a feed loader for a ZIP file or directory path.
+func (c Config) openFeedLoader(path string) (*parser.FeedLoader, error) {
+	return c.loadFeed(path, func() (*parser.FeedLoader, error) {
+		if strings.HasSuffix(strings.ToLower(path), ".zip") {
+			return parser.LoadFromZipWithOptions(path, c.archiveOptions())
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot access path: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("path must be a ZIP file or directory")
+		}
+		return parser.LoadFromDirectory(path)
+	})
+}
+
+// ValidateDiffWithContext validates the current feed and runs diff validators against the previous feed.
+func (v *internalValidator) ValidateDiffWithContext(ctx context.Context, previous, current *parser.FeedLoader, feedPath string) (*report.ValidationReport, error) {
+	startTime := time.Now()
+
+	if v.config.EnableCaching {
+		current.EnableCaching()
+		v.config.observeCache(current)
+	}
+	if v.config.TranscodeToUTF8 {
+		previous.EnableTranscoding()
+		current.EnableTranscoding()
+	}
+	if v.config.RecoverMalformedCSV {
+		previous.EnableCSVRecovery()
+		current.EnableCSVRecovery()
+	}
+	v.feedLoader = current
+
+	feedInfo, err := v.validateWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feedInfo.FeedPath = feedPath
+
+	if err := v.runDiffValidators(ctx, previous, current); err != nil {
+		return nil, err
+	}
+
+	return v.generateReport(feedInfo, startTime), nil
+}
+
+// runDiffValidators runs all diff validators sequentially with panic recovery.
+func (v *internalValidator) runDiffValidators(ctx context.Context, previous, current *parser.FeedLoader) error {
+	validatorConfig := validator.Config{
+		CountryCode:     v.config.CountryCode,
+		CurrentDate:     v.config.CurrentDate,
+		MaxMemory:       v.config.MaxMemory,
+		ParallelWorkers: v.config.ParallelWorkers,
+	}
+
+	for _, diffValidator := range v.diffValidators() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		v.runValidator(diffValidator, v.noticeContainer, func(container *notice.NoticeContainer) {
+			diffValidator.ValidateDiff(previous, current, container, validatorConfig)
+		})
+	}
+	return nil
+}
+
+// diffValidators returns the diff validators configured with the current thresholds.
+func (v *internalValidator) diffValidators() []validator.DiffValidator {
+	thresholds := v.config.DiffThresholds
+	tripCounts := diff.NewTripCounts()
+	return []validator.DiffValidator{
+		diff.NewEntityIDDiffValidator(),
+		diff.NewStopMovedDiffValidator(thresholds.StopMovedMeters),
+		diff.NewTripCountDiffValidator(thresholds.TripCountChangePercent, tripCounts),
+		diff.NewServiceDatesDiffValidator(tripCounts),
+		diff.NewShapeDiffValidator(thresholds.ShapeChangedMeters),
+	}
+}
@@ -0,0 +1,84 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateDiff(t *testing.T) {
+	previousFiles := MinimalValidGTFS()
+	previousFiles["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,40.7589,-73.9851
+stop_2,Second Stop,40.7614,-73.9776
+stop_3,Closed Stop,40.7700,-73.9700`
+
+	currentFiles := MinimalValidGTFS()
+	currentFiles["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,40.7589,-73.9851
+stop_2,Second Stop,40.7714,-73.9776`
+
+	previousPath := CreateTempZip(t, previousFiles)
+	currentPath := CreateTempZip(t, currentFiles)
+
+	report, err := New().ValidateDiff(previousPath, currentPath)
+	if err != nil {
+		t.Fatalf("ValidateDiff failed: %v", err)
+	}
+
+	codes := make(map[string]int)
+	for _, group := range report.Notices {
+		codes[group.Code] = group.TotalNotices
+	}
+	if codes["stop_removed"] != 1 {
+		t.Errorf("expected 1 stop_removed notice, got %d", codes["stop_removed"])
+	}
+	if codes["stop_moved"] != 1 {
+		t.Errorf("expected 1 stop_moved notice, got %d", codes["stop_moved"])
+	}
+	if _, exists := codes["route_removed"]; exists {
+		t.Error("did not expect route_removed notice")
+	}
+	if report.Summary.FeedInfo.StopCount != 2 {
+		t.Errorf("expected feed info for the current feed, got %d stops", report.Summary.FeedInfo.StopCount)
+	}
+}
+
+func TestValidateDiff_CustomThresholds(t *testing.T) {
+	previousPath := CreateTempZip(t, MinimalValidGTFS())
+	currentFiles := MinimalValidGTFS()
+	currentFiles["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,40.7589,-73.9851
+stop_2,Second Stop,40.7714,-73.9776`
+	currentPath := CreateTempZip(t, currentFiles)
+
+	thresholds := DefaultDiffThresholds()
+	thresholds.StopMovedMeters = 5000
+	report, err := New(WithDiffThresholds(thresholds)).ValidateDiff(previousPath, currentPath)
+	if err != nil {
+		t.Fatalf("ValidateDiff failed: %v", err)
+	}
+
+	for _, group := range report.Notices {
+		if group.Code == "stop_moved" {
+			t.Errorf("expected no stop_moved notice with a 5000m threshold, got %d", group.TotalNotices)
+		}
+	}
+}
+
+func TestValidateDiff_Errors(t *testing.T) {
+	validPath := CreateTempZip(t, MinimalValidGTFS())
+	validator := New()
+
+	if _, err := validator.ValidateDiff("/nonexistent/previous.zip", validPath); err == nil {
+		t.Error("expected error for missing previous feed")
+	}
+	if _, err := validator.ValidateDiff(validPath, "/nonexistent/current"); err == nil {
+		t.Error("expected error for missing current feed")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := validator.ValidateDiffWithContext(ctx, validPath, validPath); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+}
@@ -135,6 +135,11 @@ The library is designed for easy integration with web services:
 		json.NewEncoder(w).Encode(report)
 	}
 
+ValidateReader keeps archives up to 8 MiB in memory and copies larger ones
+to a temporary file.
+Feeds already held in memory or exposed through an fs.FS can be validated
+with ValidateBytes and ValidateFS.
+
 Notice Types:
 
 Notices are categorized by severity:
@@ -0,0 +1,591 @@
+package gtfsvalidator
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+)
+
+// Entity types used in notice locations and entity queries.
+const (
+	EntityTypeAgency = "agency"
+	EntityTypeRoute  = "route"
+	EntityTypeTrip   = "trip"
+	EntityTypeStop   = "stop"
+)
+
+// maxSamplesPerGroup matches the sample limit of the report generator.
+const maxSamplesPerGroup = 5
+
+// EntityNotice is a single notice that references an entity.
+type EntityNotice struct {
+	// Code is the notice type code.
+	Code string `json:"code"`
+
+	// Severity is the notice severity (ERROR, WARNING, INFO).
+	Severity string `json:"severity"`
+
+	// Location points at the offending record.
+	Location NoticeLocation `json:"location"`
+
+	// Context contains the notice context.
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// EntitySummary counts the notices referencing a single entity.
+type EntitySummary struct {
+	// Type is the entity type (e.g., "route", "agency").
+	Type string `json:"type"`
+
+	// ID is the entity identifier.
+	ID string `json:"id"`
+
+	// Name is a human-readable name for the entity, when known.
+	Name string `json:"name,omitempty"`
+
+	// Counts contains notice counts by severity.
+	Counts NoticeCounts `json:"counts"`
+
+	// Codes lists the distinct notice codes referencing the entity.
+	Codes []string `json:"codes"`
+}
+
+// EntityRelations records the route of each trip and the agency of each route
+// referenced by a report's sample notices, and the names of those entities, so
+// that route and agency roll-ups survive a JSON round trip. Added in report
+// schema version 1.1.
+type EntityRelations struct {
+	// TripRoutes maps trip IDs to route IDs.
+	TripRoutes map[string]string `json:"tripRoutes,omitempty"`
+
+	// RouteAgencies maps route IDs to agency IDs.
+	RouteAgencies map[string]string `json:"routeAgencies,omitempty"`
+
+	// Names maps entity types, then entity IDs, to display names.
+	Names map[string]map[string]string `json:"names,omitempty"`
+}
+
+// entityIndex maps entities to the notices that reference them.
+//
+// Notices on a trip also count for its route, and notices on a route (or its
+// trips) also count for the route's agency.
+type entityIndex struct {
+	notices       []EntityNotice
+	byEntity      map[EntityRef][]int
+	tripRoutes    map[string]string
+	routeAgencies map[string]string
+	routeTrips    map[string][]string // reverse of tripRoutes
+	agencyRoutes  map[string][]string // reverse of routeAgencies
+	names         map[EntityRef]string
+}
+
+// newEntityIndex creates an empty index.
+func newEntityIndex() *entityIndex {
+	return &entityIndex{
+		byEntity:      make(map[EntityRef][]int),
+		tripRoutes:    make(map[string]string),
+		routeAgencies: make(map[string]string),
+		routeTrips:    make(map[string][]string),
+		agencyRoutes:  make(map[string][]string),
+		names:         make(map[EntityRef]string),
+	}
+}
+
+// newEntityIndexFromGroups builds an index from the sample notices and entity
+// relations of a report. It is used for reports decoded from JSON, which only
+// carry samples; relations may be nil for reports written before version 1.1.
+func newEntityIndexFromGroups(groups []NoticeGroup, relations *EntityRelations) *entityIndex {
+	index := newEntityIndex()
+	if relations != nil {
+		for tripID, routeID := range relations.TripRoutes {
+			index.setTripRoute(tripID, routeID)
+		}
+		for routeID, agencyID := range relations.RouteAgencies {
+			index.setRouteAgency(routeID, agencyID)
+		}
+		for entityType, names := range relations.Names {
+			for id, name := range names {
+				index.names[EntityRef{Type: entityType, ID: id}] = name
+			}
+		}
+	}
+	for _, group := range groups {
+		locations := group.Locations()
+		for i, sample := range group.SampleNotices {
+			index.add(EntityNotice{
+				Code:     group.Code,
+				Severity: group.Severity,
+				Location: locations[i],
+				Context:  sample,
+			})
+		}
+	}
+	return index
+}
+
+// add indexes a notice under every entity it references.
+func (idx *entityIndex) add(n EntityNotice) {
+	position := len(idx.notices)
+	idx.notices = append(idx.notices, n)
+	for _, entity := range n.Location.RelatedEntities {
+		idx.byEntity[entity] = append(idx.byEntity[entity], position)
+	}
+}
+
+// addNotices indexes validation notices.
+func (idx *entityIndex) addNotices(notices []notice.Notice) {
+	for _, n := range notices {
+		idx.add(EntityNotice{
+			Code:     n.Code(),
+			Severity: n.Severity().String(),
+			Location: n.Location(),
+			Context:  n.Context(),
+		})
+	}
+}
+
+// setTripRoute records that a trip belongs to a route.
+func (idx *entityIndex) setTripRoute(tripID, routeID string) {
+	if _, exists := idx.tripRoutes[tripID]; exists {
+		return
+	}
+	idx.tripRoutes[tripID] = routeID
+	idx.routeTrips[routeID] = append(idx.routeTrips[routeID], tripID)
+}
+
+// setRouteAgency records that a route is operated by an agency.
+func (idx *entityIndex) setRouteAgency(routeID, agencyID string) {
+	if _, exists := idx.routeAgencies[routeID]; exists {
+		return
+	}
+	idx.routeAgencies[routeID] = agencyID
+	idx.agencyRoutes[agencyID] = append(idx.agencyRoutes[agencyID], routeID)
+}
+
+// relations returns the relations and names of the entities referenced by the
+// sample notices of groups, or nil when there are none.
+func (idx *entityIndex) relations(groups []NoticeGroup) *EntityRelations {
+	relations := &EntityRelations{
+		TripRoutes:    make(map[string]string),
+		RouteAgencies: make(map[string]string),
+		Names:         make(map[string]map[string]string),
+	}
+	addName := func(entityType, id string) {
+		if name, ok := idx.names[EntityRef{Type: entityType, ID: id}]; ok {
+			if relations.Names[entityType] == nil {
+				relations.Names[entityType] = make(map[string]string)
+			}
+			relations.Names[entityType][id] = name
+		}
+	}
+	addRoute := func(routeID string) {
+		addName(EntityTypeRoute, routeID)
+		if agencyID, ok := idx.routeAgencies[routeID]; ok {
+			relations.RouteAgencies[routeID] = agencyID
+			addName(EntityTypeAgency, agencyID)
+		}
+	}
+
+	for _, group := range groups {
+		for _, location := range group.Locations() {
+			for _, entity := range location.RelatedEntities {
+				switch entity.Type {
+				case EntityTypeTrip:
+					if routeID, ok := idx.tripRoutes[entity.ID]; ok {
+						relations.TripRoutes[entity.ID] = routeID
+						addRoute(routeID)
+					}
+				case EntityTypeRoute:
+					addRoute(entity.ID)
+				default:
+					addName(entity.Type, entity.ID)
+				}
+			}
+		}
+	}
+
+	if len(relations.TripRoutes) == 0 && len(relations.RouteAgencies) == 0 && len(relations.Names) == 0 {
+		return nil
+	}
+	return relations
+}
+
+// references reports whether any indexed notice references an entity of the given types.
+func (idx *entityIndex) references(entityTypes ...string) bool {
+	for entity := range idx.byEntity {
+		for _, entityType := range entityTypes {
+			if entity.Type == entityType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// positions returns the notice positions for an entity, including roll-ups.
+func (idx *entityIndex) positions(entityType, id string) []int {
+	seen := make(map[int]bool)
+	collect := func(ref EntityRef) {
+		for _, position := range idx.byEntity[ref] {
+			seen[position] = true
+		}
+	}
+
+	collect(EntityRef{Type: entityType, ID: id})
+
+	switch entityType {
+	case EntityTypeRoute:
+		for _, tripID := range idx.routeTrips[id] {
+			collect(EntityRef{Type: EntityTypeTrip, ID: tripID})
+		}
+	case EntityTypeAgency:
+		for _, routeID := range idx.agencyRoutes[id] {
+			collect(EntityRef{Type: EntityTypeRoute, ID: routeID})
+			for _, tripID := range idx.routeTrips[routeID] {
+				collect(EntityRef{Type: EntityTypeTrip, ID: tripID})
+			}
+		}
+	}
+
+	positions := make([]int, 0, len(seen))
+	for position := range seen {
+		positions = append(positions, position)
+	}
+	sort.Ints(positions)
+	return positions
+}
+
+// forEntity returns the notices referencing an entity, in the order they were raised.
+func (idx *entityIndex) forEntity(entityType, id string) []EntityNotice {
+	positions := idx.positions(entityType, id)
+	result := make([]EntityNotice, len(positions))
+	for i, position := range positions {
+		result[i] = idx.notices[position]
+	}
+	return result
+}
+
+// entityIDs returns the IDs of every entity of a type that has notices, including roll-ups.
+func (idx *entityIndex) entityIDs(entityType string) []string {
+	ids := make(map[string]bool)
+	for entity := range idx.byEntity {
+		switch {
+		case entity.Type == entityType:
+			ids[entity.ID] = true
+		case entityType == EntityTypeRoute && entity.Type == EntityTypeTrip:
+			if routeID, ok := idx.tripRoutes[entity.ID]; ok {
+				ids[routeID] = true
+			}
+		case entityType == EntityTypeAgency && entity.Type == EntityTypeRoute:
+			if agencyID, ok := idx.routeAgencies[entity.ID]; ok {
+				ids[agencyID] = true
+			}
+		case entityType == EntityTypeAgency && entity.Type == EntityTypeTrip:
+			if agencyID, ok := idx.routeAgencies[idx.tripRoutes[entity.ID]]; ok {
+				ids[agencyID] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(ids))
+	for id := range ids {
+		result = append(result, id)
+	}
+	return result
+}
+
+// breakdown summarizes notices per entity of a type, most errors first.
+func (idx *entityIndex) breakdown(entityType string) []EntitySummary {
+	ids := idx.entityIDs(entityType)
+	summaries := make([]EntitySummary, 0, len(ids))
+	for _, id := range ids {
+		summary := EntitySummary{
+			Type:  entityType,
+			ID:    id,
+			Name:  idx.names[EntityRef{Type: entityType, ID: id}],
+			Codes: []string{},
+		}
+		codes := make(map[string]bool)
+		for _, position := range idx.positions(entityType, id) {
+			n := idx.notices[position]
+			switch n.Severity {
+			case "ERROR":
+				summary.Counts.Errors++
+			case "WARNING":
+				summary.Counts.Warnings++
+			case "INFO":
+				summary.Counts.Infos++
+			}
+			summary.Counts.Total++
+			if !codes[n.Code] {
+				codes[n.Code] = true
+				summary.Codes = append(summary.Codes, n.Code)
+			}
+		}
+		sort.Strings(summary.Codes)
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i].Counts, summaries[j].Counts
+		if a.Errors != b.Errors {
+			return a.Errors > b.Errors
+		}
+		if a.Warnings != b.Warnings {
+			return a.Warnings > b.Warnings
+		}
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return summaries[i].ID < summaries[j].ID
+	})
+	return summaries
+}
+
+// subset returns an index with the given notices and the same entity relations.
+func (idx *entityIndex) subset(notices []EntityNotice) *entityIndex {
+	result := &entityIndex{
+		byEntity:      make(map[EntityRef][]int),
+		tripRoutes:    idx.tripRoutes,
+		routeAgencies: idx.routeAgencies,
+		routeTrips:    idx.routeTrips,
+		agencyRoutes:  idx.agencyRoutes,
+		names:         idx.names,
+	}
+	for _, n := range notices {
+		result.add(n)
+	}
+	return result
+}
+
+// loadEntityRelations reads trip→route and route→agency relations and entity
+// names from the feed, so notices can be rolled up to routes and agencies.
+// Call it after the notices are added: only the relations needed to roll up
+// the indexed notices are read, and routes and trips come from the parsed
+// feed cache when it is enabled.
+func (idx *entityIndex) loadEntityRelations(loader *parser.FeedLoader) {
+	needTrips := idx.references(EntityTypeTrip)
+	needRoutes := needTrips || idx.references(EntityTypeRoute)
+	if !needRoutes && !idx.references(EntityTypeAgency) {
+		return
+	}
+
+	var agencyIDs []string
+	readFeedRows(loader, "agency.txt", func(row *parser.CSVRow) {
+		agencyID := strings.TrimSpace(row.Values["agency_id"])
+		agencyIDs = append(agencyIDs, agencyID)
+		if name := strings.TrimSpace(row.Values["agency_name"]); name != "" {
+			idx.names[EntityRef{Type: EntityTypeAgency, ID: agencyID}] = name
+		}
+	})
+	if !needRoutes {
+		return
+	}
+
+	// agency_id may be omitted in routes.txt when the feed has a single agency
+	defaultAgency := ""
+	if len(agencyIDs) == 1 {
+		defaultAgency = agencyIDs[0]
+	}
+
+	addRoute := func(routeID, agencyID, shortName, longName string) {
+		routeID = strings.TrimSpace(routeID)
+		if routeID == "" {
+			return
+		}
+		agencyID = strings.TrimSpace(agencyID)
+		if agencyID == "" {
+			agencyID = defaultAgency
+		}
+		idx.setRouteAgency(routeID, agencyID)
+
+		name := strings.TrimSpace(shortName)
+		if longName = strings.TrimSpace(longName); longName != "" {
+			if name != "" {
+				name += " - "
+			}
+			name += longName
+		}
+		if name != "" {
+			idx.names[EntityRef{Type: EntityTypeRoute, ID: routeID}] = name
+		}
+	}
+	addTrip := func(tripID, routeID string) {
+		tripID = strings.TrimSpace(tripID)
+		routeID = strings.TrimSpace(routeID)
+		if tripID != "" && routeID != "" {
+			idx.setTripRoute(tripID, routeID)
+		}
+	}
+
+	if cache := loader.GetCache(); cache != nil {
+		if routes, err := cache.GetRoutes(); err == nil {
+			for _, route := range routes {
+				addRoute(route.RouteID, route.AgencyID, route.RouteShortName, route.RouteLongName)
+			}
+		}
+		if !needTrips {
+			return
+		}
+		if trips, err := cache.GetTrips(); err == nil {
+			for _, trip := range trips {
+				addTrip(trip.TripID, trip.RouteID)
+			}
+		}
+		return
+	}
+
+	readFeedRows(loader, "routes.txt", func(row *parser.CSVRow) {
+		addRoute(row.Values["route_id"], row.Values["agency_id"], row.Values["route_short_name"], row.Values["route_long_name"])
+	})
+	if !needTrips {
+		return
+	}
+	readFeedRows(loader, "trips.txt", func(row *parser.CSVRow) {
+		addTrip(row.Values["trip_id"], row.Values["route_id"])
+	})
+}
+
+// readFeedRows calls fn for every row of a feed file, skipping malformed rows.
+func readFeedRows(loader *parser.FeedLoader, filename string, fn func(row *parser.CSVRow)) {
+	if !loader.HasFile(filename) {
+		return
+	}
+	reader, err := loader.GetFile(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
+		}
+	}()
+
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
+	if err != nil {
+		return
+	}
+
+	for {
+		row, err := csvFile.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		fn(row)
+	}
+}
+
+// entities returns the report's entity index, building one from the sample
+// notices when the report was not produced by this process (e.g. decoded JSON).
+// The caller must hold r.mu.
+func (r *ValidationReport) entities() *entityIndex {
+	if r.entityIndex != nil {
+		return r.entityIndex
+	}
+	return newEntityIndexFromGroups(r.Notices, r.Entities)
+}
+
+// NoticesForEntity returns all notices referencing an entity. Route queries
+// include notices on the route's trips, and agency queries include notices on
+// the agency's routes and trips.
+func (r *ValidationReport) NoticesForEntity(entityType, id string) []EntityNotice {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.entities().forEntity(entityType, id)
+}
+
+// NoticesForRoute returns all notices referencing a route or its trips.
+func (r *ValidationReport) NoticesForRoute(routeID string) []EntityNotice {
+	return r.NoticesForEntity(EntityTypeRoute, routeID)
+}
+
+// NoticesForStop returns all notices referencing a stop.
+func (r *ValidationReport) NoticesForStop(stopID string) []EntityNotice {
+	return r.NoticesForEntity(EntityTypeStop, stopID)
+}
+
+// NoticesForTrip returns all notices referencing a trip.
+func (r *ValidationReport) NoticesForTrip(tripID string) []EntityNotice {
+	return r.NoticesForEntity(EntityTypeTrip, tripID)
+}
+
+// NoticesForAgency returns all notices referencing an agency, its routes or their trips.
+func (r *ValidationReport) NoticesForAgency(agencyID string) []EntityNotice {
+	return r.NoticesForEntity(EntityTypeAgency, agencyID)
+}
+
+// EntityBreakdown summarizes notices per entity of the given type (e.g. "route"),
+// ordered by error count, then warning count.
+func (r *ValidationReport) EntityBreakdown(entityType string) []EntitySummary {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.entities().breakdown(entityType)
+}
+
+// FilterByEntity returns a new report containing only the notices that
+// reference the given entity, with counts recomputed. Reports read with
+// ParseReport only carry sample notices, rolled up with their Entities.
+func (r *ValidationReport) FilterByEntity(entityType, id string) *ValidationReport {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	index := r.entities()
+	notices := index.forEntity(entityType, id)
+
+	groups := make(map[string]*NoticeGroup)
+	var order []string
+	counts := NoticeCounts{}
+	for _, n := range notices {
+		group, exists := groups[n.Code]
+		if !exists {
+			enhanced := LocalizedNoticeDescription(n.Code, r.Summary.Locale)
+			group = &NoticeGroup{
+				Code:           n.Code,
+				Severity:       n.Severity,
+				Description:    enhanced.Description,
+				GTFSReference:  enhanced.GTFSReference,
+				AffectedFiles:  enhanced.AffectedFiles,
+				AffectedFields: enhanced.AffectedFields,
+				ExampleFix:     enhanced.ExampleFix,
+				Impact:         enhanced.Impact,
+			}
+			groups[n.Code] = group
+			order = append(order, n.Code)
+		}
+		group.TotalNotices++
+		if len(group.SampleNotices) < maxSamplesPerGroup {
+			group.SampleNotices = append(group.SampleNotices, n.Context)
+			group.SampleLocations = append(group.SampleLocations, n.Location)
+		}
+
+		switch n.Severity {
+		case "ERROR":
+			counts.Errors++
+		case "WARNING":
+			counts.Warnings++
+		case "INFO":
+			counts.Infos++
+		}
+		counts.Total++
+	}
+
+	filtered := &ValidationReport{
+		SchemaVersion: r.SchemaVersion,
+		Summary:       r.Summary,
+		Notices:       make([]NoticeGroup, 0, len(order)),
+		entityIndex:   index.subset(notices),
+	}
+	filtered.Summary.Counts = counts
+	for _, code := range order {
+		filtered.Notices = append(filtered.Notices, *groups[code])
+	}
+	filtered.Entities = index.relations(filtered.Notices)
+	return filtered
+}
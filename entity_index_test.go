@@ -0,0 +1,209 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// entityTestFeed has two routes of one agency; route_2 has an invalid route
+// type and trip_2 (on route_2) has decreasing stop times.
+func entityTestFeed() map[string]string {
+	files := MinimalValidGTFS()
+	files["routes.txt"] = `route_id,agency_id,route_short_name,route_long_name,route_type
+route_1,test_agency,1,Main Street Line,3
+route_2,test_agency,2,Second Line,999`
+	files["trips.txt"] = `route_id,service_id,trip_id,trip_headsign
+route_1,service_1,trip_1,Downtown
+route_2,service_1,trip_2,Uptown`
+	files["stop_times.txt"] = `trip_id,arrival_time,departure_time,stop_id,stop_sequence
+trip_1,08:00:00,08:00:00,stop_1,1
+trip_1,08:15:00,08:15:00,stop_2,2
+trip_2,08:00:00,08:00:00,stop_1,1
+trip_2,07:15:00,07:15:00,stop_2,2`
+	return files
+}
+
+func noticeCodes(notices []EntityNotice) map[string]int {
+	codes := make(map[string]int)
+	for _, n := range notices {
+		codes[n.Code]++
+	}
+	return codes
+}
+
+func TestValidationReport_EntityQueries(t *testing.T) {
+	report, err := New().ValidateFile(CreateTempZip(t, entityTestFeed()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		notices    []EntityNotice
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name:     "route includes notices on its trips",
+			notices:  report.NoticesForRoute("route_2"),
+			expected: []string{"invalid_route_type", "stop_time_decreasing_time"},
+		},
+		{
+			name:       "other route excludes route_2 notices",
+			notices:    report.NoticesForRoute("route_1"),
+			unexpected: []string{"invalid_route_type", "stop_time_decreasing_time"},
+		},
+		{
+			name:       "trip only has its own notices",
+			notices:    report.NoticesForTrip("trip_2"),
+			expected:   []string{"stop_time_decreasing_time"},
+			unexpected: []string{"invalid_route_type"},
+		},
+		{
+			name:     "agency includes notices on its routes and trips",
+			notices:  report.NoticesForAgency("test_agency"),
+			expected: []string{"invalid_route_type", "stop_time_decreasing_time"},
+		},
+		{
+			name:    "unknown stop has no notices",
+			notices: report.NoticesForStop("no_such_stop"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codes := noticeCodes(tt.notices)
+			for _, code := range tt.expected {
+				if codes[code] == 0 {
+					t.Errorf("expected %s notice, got %v", code, codes)
+				}
+			}
+			for _, code := range tt.unexpected {
+				if codes[code] != 0 {
+					t.Errorf("did not expect %s notice, got %v", code, codes)
+				}
+			}
+			if len(tt.expected) == 0 && len(tt.unexpected) == 0 && len(tt.notices) != 0 {
+				t.Errorf("expected no notices, got %v", codes)
+			}
+		})
+	}
+}
+
+func TestValidationReport_EntityBreakdown(t *testing.T) {
+	// Entity relations are read from the feed, or from the parsed feed cache when enabled
+	for _, caching := range []bool{false, true} {
+		report, err := New(WithCaching(caching)).ValidateFile(CreateTempZip(t, entityTestFeed()))
+		if err != nil {
+			t.Fatalf("ValidateFile failed: %v", err)
+		}
+
+		routes := report.EntityBreakdown(EntityTypeRoute)
+		if len(routes) != 2 {
+			t.Fatalf("caching=%v: expected 2 routes in breakdown, got %d", caching, len(routes))
+		}
+		if routes[0].ID != "route_2" {
+			t.Errorf("caching=%v: expected route with most errors first, got %s", caching, routes[0].ID)
+		}
+		if routes[0].Name != "2 - Second Line" {
+			t.Errorf("caching=%v: expected route name from routes.txt, got %q", caching, routes[0].Name)
+		}
+		if routes[0].Counts.Errors < 2 {
+			t.Errorf("caching=%v: expected at least 2 errors for route_2, got %d", caching, routes[0].Counts.Errors)
+		}
+
+		agencies := report.EntityBreakdown(EntityTypeAgency)
+		if len(agencies) != 1 || agencies[0].ID != "test_agency" || agencies[0].Name != "Test Transit Agency" {
+			t.Errorf("caching=%v: unexpected agency breakdown: %+v", caching, agencies)
+		}
+	}
+}
+
+func TestValidationReport_FilterByEntity(t *testing.T) {
+	report, err := New().ValidateFile(CreateTempZip(t, entityTestFeed()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+
+	filtered := report.FilterByEntity(EntityTypeRoute, "route_1")
+	if filtered.HasErrors() {
+		t.Errorf("expected no errors for route_1, got %d", filtered.ErrorCount())
+	}
+	if filtered.Summary.FeedInfo.RouteCount != report.Summary.FeedInfo.RouteCount {
+		t.Error("expected feed info to be preserved")
+	}
+
+	filtered = report.FilterByEntity(EntityTypeAgency, "test_agency").FilterByEntity(EntityTypeRoute, "route_2")
+	total := 0
+	for _, group := range filtered.Notices {
+		total += group.TotalNotices
+		if len(group.SampleLocations) != len(group.SampleNotices) {
+			t.Errorf("%s: expected a location per sample", group.Code)
+		}
+	}
+	if total != filtered.Summary.Counts.Total || total == 0 {
+		t.Errorf("expected recomputed counts to match groups, got %d groups total vs %d", total, filtered.Summary.Counts.Total)
+	}
+	if filtered.ErrorCount() < 2 {
+		t.Errorf("expected route_2 errors after chained filters, got %d", filtered.ErrorCount())
+	}
+}
+
+func TestValidationReport_EntityQueriesFromJSON(t *testing.T) {
+	data := []byte(`{
+		"summary": {"counts": {"errors": 1, "warnings": 1, "total": 2}},
+		"notices": [
+			{"code": "foreign_key_violation", "severity": "ERROR", "totalNotices": 1,
+			 "sampleNotices": [{"filename": "trips.txt", "csvRowNumber": 3, "fieldName": "route_id", "fieldValue": "R1"}]},
+			{"code": "stop_too_far_from_shape", "severity": "WARNING", "totalNotices": 1,
+			 "sampleNotices": [{"stopId": "S1", "tripId": "T9"}]}
+		]
+	}`)
+
+	var report ValidationReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("failed to decode report: %v", err)
+	}
+
+	if notices := report.NoticesForRoute("R1"); len(notices) != 1 || notices[0].Location.File != "trips.txt" {
+		t.Errorf("expected route R1 notice from samples, got %+v", notices)
+	}
+	if notices := report.NoticesForStop("S1"); len(notices) != 1 || notices[0].Severity != "WARNING" {
+		t.Errorf("expected stop S1 notice from samples, got %+v", notices)
+	}
+}
+
+func TestValidationReport_EntityRollupsAfterParseReport(t *testing.T) {
+	report, err := New().ValidateFile(CreateTempZip(t, entityTestFeed()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	if report.Entities == nil || report.Entities.TripRoutes["trip_2"] != "route_2" || report.Entities.RouteAgencies["route_2"] != "test_agency" {
+		t.Fatalf("Expected the relations of the sampled entities, got %+v", report.Entities)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("failed to encode report: %v", err)
+	}
+	parsed, err := ParseReport(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseReport failed: %v", err)
+	}
+
+	if codes := noticeCodes(parsed.NoticesForRoute("route_2")); codes["stop_time_decreasing_time"] == 0 {
+		t.Errorf("Expected the trip notice to roll up to its route, got %v", codes)
+	}
+	if codes := noticeCodes(parsed.NoticesForAgency("test_agency")); codes["stop_time_decreasing_time"] == 0 || codes["invalid_route_type"] == 0 {
+		t.Errorf("Expected route and trip notices to roll up to the agency, got %v", codes)
+	}
+	for _, summary := range parsed.EntityBreakdown(EntityTypeRoute) {
+		if summary.ID == "route_2" && summary.Name != "2 - Second Line" {
+			t.Errorf("Expected the stored route name, got %q", summary.Name)
+		}
+	}
+	if filtered := parsed.FilterByEntity(EntityTypeRoute, "route_2"); filtered.Entities == nil || filtered.Entities.TripRoutes["trip_2"] != "route_2" {
+		t.Errorf("Expected the filtered report to keep the relations of its notices, got %+v", filtered.Entities)
+	}
+}
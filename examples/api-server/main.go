@@ -2,7 +2,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -10,123 +9,48 @@ import (
 	"time"
 
 	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+	gtfshttp "github.com/theoremus-urban-solutions/gtfs-validator/http"
 )
 
-// ValidationRequest represents an API validation request
-type ValidationRequest struct {
-	Mode              string `json:"mode,omitempty"`
-	CountryCode       string `json:"countryCode,omitempty"`
-	MaxNoticesPerType int    `json:"maxNoticesPerType,omitempty"`
-}
-
-// ValidationResponse represents the API response
-type ValidationResponse struct {
-	Success bool                            `json:"success"`
-	Report  *gtfsvalidator.ValidationReport `json:"report,omitempty"`
-	Error   string                          `json:"error,omitempty"`
-}
-
 func main() {
-	http.HandleFunc("/validate", validateHandler)
-	http.HandleFunc("/health", healthHandler)
+	options := gtfshttp.DefaultOptions()
+	options.ValidatorOptions = []gtfsvalidator.Option{
+		gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationModeDefault),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/validate", gtfshttp.NewHandler(options))
+	mux.Handle("/feeds", gtfshttp.Middleware(options)(http.HandlerFunc(storeFeedHandler)))
+	mux.HandleFunc("/health", healthHandler)
 
 	port := ":8080"
 	fmt.Printf("GTFS Validator API Server starting on %s\n", port)
 	fmt.Println("Endpoints:")
 	fmt.Println("  POST /validate - Upload and validate a GTFS file")
-	fmt.Println("  GET  /health  - Health check endpoint")
+	fmt.Println("  POST /feeds    - Accept a GTFS file only if it has no errors")
+	fmt.Println("  GET  /health   - Health check endpoint")
 
-	log.Fatal(http.ListenAndServe(port, nil)) // #nosec G114 -- Example server, timeouts not critical
-}
-
-func validateHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
+	server := &http.Server{
+		Addr:              port,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
 	}
+	log.Fatal(server.ListenAndServe())
+}
 
-	// Parse multipart form (max 100MB)
-	err := r.ParseMultipartForm(100 << 20)
-	if err != nil {
-		sendErrorResponse(w, "Failed to parse form", http.StatusBadRequest)
-		return
-	}
-
-	// Get the file
-	file, header, err := r.FormFile("file")
-	if err != nil {
-		sendErrorResponse(w, "Missing 'file' field", http.StatusBadRequest)
-		return
-	}
-	defer func() {
-		if closeErr := file.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
-		}
-	}()
-
-	// Parse optional parameters
-	var req ValidationRequest
-	if configJSON := r.FormValue("config"); configJSON != "" {
-		if err := json.Unmarshal([]byte(configJSON), &req); err != nil {
-			sendErrorResponse(w, "Invalid config JSON", http.StatusBadRequest)
-			return
-		}
-	}
-
-	// Log request
-	log.Printf("Validating file: %s (size: %d bytes)", header.Filename, header.Size)
-
-	// Create validator with configuration
-	opts := []gtfsvalidator.Option{}
-
-	// Set validation mode
-	switch req.Mode {
-	case "performance":
-		opts = append(opts, gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationModePerformance))
-	case "comprehensive":
-		opts = append(opts, gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationModeComprehensive))
-	default:
-		opts = append(opts, gtfsvalidator.WithValidationMode(gtfsvalidator.ValidationModeDefault))
-	}
-
-	// Set country code
-	if req.CountryCode != "" {
-		opts = append(opts, gtfsvalidator.WithCountryCode(req.CountryCode))
-	}
-
-	// Set notice limit
-	if req.MaxNoticesPerType > 0 {
-		opts = append(opts, gtfsvalidator.WithMaxNoticesPerType(req.MaxNoticesPerType))
-	}
-
-	validator := gtfsvalidator.New(opts...)
-
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
-	defer cancel()
-
-	// Validate the file
-	startTime := time.Now()
-	report, err := validator.ValidateReaderWithContext(ctx, file)
-	elapsed := time.Since(startTime)
+// storeFeedHandler is only reached by feeds without errors
+func storeFeedHandler(w http.ResponseWriter, r *http.Request) {
+	report, _ := gtfshttp.ReportFromContext(r.Context())
+	log.Printf("Accepted feed with %d warnings", report.WarningCount())
 
-	if err != nil {
-		if err == context.DeadlineExceeded {
-			sendErrorResponse(w, "Validation timeout exceeded", http.StatusRequestTimeout)
-		} else {
-			sendErrorResponse(w, fmt.Sprintf("Validation failed: %v", err), http.StatusInternalServerError)
-		}
-		return
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"accepted": true,
+		"warnings": report.WarningCount(),
+	}); err != nil {
+		log.Printf("Failed to encode response: %v", err)
 	}
-
-	// Log results
-	log.Printf("Validation completed in %.2fs - Errors: %d, Warnings: %d",
-		elapsed.Seconds(),
-		report.ErrorCount(),
-		report.WarningCount())
-
-	// Send response
-	sendSuccessResponse(w, report)
 }
 
 func healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -140,33 +64,15 @@ func healthHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func sendErrorResponse(w http.ResponseWriter, message string, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	if err := json.NewEncoder(w).Encode(ValidationResponse{
-		Success: false,
-		Error:   message,
-	}); err != nil {
-		// Can't set headers again after WriteHeader, so just log
-		log.Printf("Failed to encode error response: %v", err)
-	}
-}
-
-func sendSuccessResponse(w http.ResponseWriter, report *gtfsvalidator.ValidationReport) {
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(ValidationResponse{
-		Success: true,
-		Report:  report,
-	}); err != nil {
-		http.Error(w, "Failed to encode success response", http.StatusInternalServerError)
-	}
-}
-
 // Example usage with curl:
 // curl -X POST -F "file=@transit-feed.zip" http://localhost:8080/validate
 //
-// With options:
+// With options and an HTML report:
 // curl -X POST \
 //   -F "file=@transit-feed.zip" \
-//   -F 'config={"mode":"performance","countryCode":"UK","maxNoticesPerType":50}' \
-//   http://localhost:8080/validate
+//   -H "Accept: text/html" \
+//   "http://localhost:8080/validate?mode=performance&country=GB&maxNotices=50" -o report.html
+//
+// As a raw ZIP body with a SARIF report:
+// curl -X POST --data-binary @transit-feed.zip -H "Content-Type: application/zip" \
+//   "http://localhost:8080/validate?format=sarif"
@@ -0,0 +1,285 @@
+// Package fetch downloads GTFS feeds over HTTP(S) with size, time and redirect
+// limits, and caches them on disk so unchanged feeds are not downloaded again
+package fetch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrTooLarge is returned when a download exceeds Options.MaxSize
+var ErrTooLarge = errors.New("download exceeds maximum size")
+
+// ErrTooManyRedirects is returned when a download is redirected more than Options.MaxRedirects times
+var ErrTooManyRedirects = errors.New("too many redirects")
+
+// StatusError is returned when the server answers with a status other than 200 or 304
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected HTTP status fetching %s: %s", e.URL, e.Status)
+}
+
+// Options configures how feeds are downloaded
+type Options struct {
+	// Timeout bounds the whole download including the body (0 = no timeout)
+	Timeout time.Duration
+	// MaxSize is the maximum body size in bytes (0 = no limit)
+	MaxSize int64
+	// MaxRedirects is the number of redirects followed (0 = redirects are not followed)
+	MaxRedirects int
+	// CacheDir stores downloaded feeds and their ETag and Last-Modified headers.
+	// Requests for a cached URL are conditional, and a 304 Not Modified answer
+	// reuses the cached copy. Empty disables caching.
+	CacheDir string
+	// UserAgent is sent with each request; empty uses "gtfs-validator"
+	UserAgent string
+}
+
+// DefaultOptions returns a 5 minute timeout, a 1 GiB size limit and up to 10 redirects, without caching
+func DefaultOptions() Options {
+	return Options{
+		Timeout:      5 * time.Minute,
+		MaxSize:      1 << 30, // 1 GiB
+		MaxRedirects: 10,
+	}
+}
+
+// Metadata describes a download
+type Metadata struct {
+	// URL is the requested URL
+	URL string `json:"url"`
+	// FinalURL is the URL after following redirects
+	FinalURL string `json:"finalUrl"`
+	// StatusCode is the HTTP status of the response (200 or 304)
+	StatusCode int `json:"statusCode"`
+	// ContentLength is the size of the feed in bytes
+	ContentLength int64 `json:"contentLength"`
+	// FetchedAt is when the response was received
+	FetchedAt time.Time `json:"fetchedAt"`
+	// ETag, LastModified and CacheControl are the HTTP caching headers of the feed
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	CacheControl string `json:"cacheControl,omitempty"`
+	// NotModified is true when the server answered 304 and the cached copy was used
+	NotModified bool `json:"notModified"`
+}
+
+// Result is a downloaded feed and its metadata
+type Result struct {
+	Data     []byte
+	Metadata Metadata
+}
+
+// Fetcher downloads feeds with the configured options. It is safe for concurrent use.
+type Fetcher struct {
+	options Options
+	client  *http.Client
+}
+
+// New creates a fetcher with the given options
+func New(options Options) *Fetcher {
+	client := &http.Client{
+		Timeout: options.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > options.MaxRedirects {
+				return fmt.Errorf("%w: stopped after %d", ErrTooManyRedirects, options.MaxRedirects)
+			}
+			return nil
+		},
+	}
+	return &Fetcher{options: options, client: client}
+}
+
+// IsURL reports whether path is an http or https URL
+func IsURL(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// Fetch downloads the feed at rawURL. When a cache directory is configured and
+// the URL was fetched before, the request carries If-None-Match and
+// If-Modified-Since headers and a 304 answer returns the cached copy.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Result, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported URL scheme %q: only http and https are supported", parsed.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	userAgent := f.options.UserAgent
+	if userAgent == "" {
+		userAgent = "gtfs-validator"
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	cached := f.loadCache(rawURL)
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	metadata := Metadata{
+		URL:          rawURL,
+		FinalURL:     resp.Request.URL.String(),
+		StatusCode:   resp.StatusCode,
+		FetchedAt:    time.Now().UTC(),
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		CacheControl: resp.Header.Get("Cache-Control"),
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		data, err := os.ReadFile(f.cachePath(rawURL, ".zip"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cached feed: %w", err)
+		}
+		// A 304 may omit the validators; keep the cached ones
+		if metadata.ETag == "" {
+			metadata.ETag = cached.ETag
+		}
+		if metadata.LastModified == "" {
+			metadata.LastModified = cached.LastModified
+		}
+		metadata.ContentLength = int64(len(data))
+		metadata.NotModified = true
+		return &Result{Data: data, Metadata: metadata}, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, &StatusError{URL: rawURL, StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	data, err := f.readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	metadata.ContentLength = int64(len(data))
+
+	if err := f.storeCache(rawURL, data, metadata); err != nil {
+		return nil, err
+	}
+	return &Result{Data: data, Metadata: metadata}, nil
+}
+
+// readBody reads the response body, failing as soon as it exceeds MaxSize
+func (f *Fetcher) readBody(resp *http.Response) ([]byte, error) {
+	maxSize := f.options.MaxSize
+	if maxSize > 0 && resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes > %d", ErrTooLarge, resp.ContentLength, maxSize)
+	}
+
+	body := io.Reader(resp.Body)
+	if maxSize > 0 {
+		body = io.LimitReader(resp.Body, maxSize+1)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, maxSize)
+	}
+	return data, nil
+}
+
+// cachePath returns the cache file for a URL with the given extension
+func (f *Fetcher) cachePath(rawURL, ext string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(f.options.CacheDir, hex.EncodeToString(sum[:])+ext)
+}
+
+// loadCache returns the cached metadata of a URL, or nil if it is not cached
+func (f *Fetcher) loadCache(rawURL string) *Metadata {
+	if f.options.CacheDir == "" {
+		return nil
+	}
+	content, err := os.ReadFile(f.cachePath(rawURL, ".json"))
+	if err != nil {
+		return nil
+	}
+	var metadata Metadata
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil
+	}
+	if metadata.ETag == "" && metadata.LastModified == "" {
+		return nil
+	}
+	if _, err := os.Stat(f.cachePath(rawURL, ".zip")); err != nil {
+		return nil
+	}
+	return &metadata
+}
+
+// storeCache saves a feed and its metadata when the server sent a validator to revalidate it with
+func (f *Fetcher) storeCache(rawURL string, data []byte, metadata Metadata) error {
+	if f.options.CacheDir == "" || (metadata.ETag == "" && metadata.LastModified == "") {
+		return nil
+	}
+	if err := os.MkdirAll(f.options.CacheDir, 0o750); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	content, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cache metadata: %w", err)
+	}
+	// Write the feed before its metadata so a partial write is never revalidated
+	if err := writeFileAtomic(f.cachePath(rawURL, ".zip"), data); err != nil {
+		return fmt.Errorf("failed to write cached feed: %w", err)
+	}
+	if err := writeFileAtomic(f.cachePath(rawURL, ".json"), content); err != nil {
+		return fmt.Errorf("failed to write cache metadata: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,217 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	body := strings.Repeat("x", 100)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feed.zip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/feed.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		path             string
+		options          Options
+		expectedErr      error
+		expectedStatus   int
+		expectedFinalURL string
+	}{
+		{
+			name:             "plain download",
+			path:             "/feed.zip",
+			options:          DefaultOptions(),
+			expectedFinalURL: "/feed.zip",
+		},
+		{
+			name:             "redirect followed",
+			path:             "/redirect",
+			options:          DefaultOptions(),
+			expectedFinalURL: "/feed.zip",
+		},
+		{
+			name:        "redirects not followed",
+			path:        "/redirect",
+			options:     Options{},
+			expectedErr: ErrTooManyRedirects,
+		},
+		{
+			name:        "redirect loop",
+			path:        "/loop",
+			options:     Options{MaxRedirects: 3},
+			expectedErr: ErrTooManyRedirects,
+		},
+		{
+			name:        "body too large",
+			path:        "/feed.zip",
+			options:     Options{MaxSize: 50},
+			expectedErr: ErrTooLarge,
+		},
+		{
+			name:           "not found",
+			path:           "/missing",
+			options:        DefaultOptions(),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "timeout",
+			path:        "/slow",
+			options:     Options{Timeout: 50 * time.Millisecond},
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New(tt.options).Fetch(context.Background(), server.URL+tt.path)
+
+			if tt.expectedStatus != 0 {
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.expectedStatus {
+					t.Fatalf("Expected HTTP status %d error, got %v", tt.expectedStatus, err)
+				}
+				return
+			}
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) && !(tt.expectedErr == context.DeadlineExceeded && isTimeout(err)) {
+					t.Fatalf("Expected %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fetch failed: %v", err)
+			}
+
+			if string(result.Data) != body {
+				t.Errorf("Expected %d bytes of body, got %d", len(body), len(result.Data))
+			}
+			if result.Metadata.FinalURL != server.URL+tt.expectedFinalURL {
+				t.Errorf("Expected final URL %s, got %s", server.URL+tt.expectedFinalURL, result.Metadata.FinalURL)
+			}
+			if result.Metadata.ContentLength != int64(len(body)) {
+				t.Errorf("Expected content length %d, got %d", len(body), result.Metadata.ContentLength)
+			}
+			if result.Metadata.FetchedAt.IsZero() {
+				t.Error("Expected FetchedAt to be set")
+			}
+		})
+	}
+}
+
+// isTimeout reports whether err is a client timeout
+func isTimeout(err error) bool {
+	var timeoutErr interface{ Timeout() bool }
+	return errors.As(err, &timeoutErr) && timeoutErr.Timeout()
+}
+
+func TestFetch_ConditionalRequests(t *testing.T) {
+	tests := []struct {
+		name         string
+		etag         string
+		lastModified string
+		cacheDir     bool
+		expectedHits int32 // requests answered 304
+	}{
+		{name: "etag", etag: `"v1"`, cacheDir: true, expectedHits: 2},
+		{name: "last-modified", lastModified: "Mon, 02 Jan 2006 15:04:05 GMT", cacheDir: true, expectedHits: 2},
+		{name: "no validators", cacheDir: true},
+		{name: "no cache directory", etag: `"v1"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests, notModified atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				if (tt.etag != "" && r.Header.Get("If-None-Match") == tt.etag) ||
+					(tt.lastModified != "" && r.Header.Get("If-Modified-Since") == tt.lastModified) {
+					notModified.Add(1)
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				if tt.lastModified != "" {
+					w.Header().Set("Last-Modified", tt.lastModified)
+				}
+				w.Header().Set("Cache-Control", "max-age=3600")
+				_, _ = w.Write([]byte("feed"))
+			}))
+			defer server.Close()
+
+			options := DefaultOptions()
+			if tt.cacheDir {
+				options.CacheDir = t.TempDir()
+			}
+			fetcher := New(options)
+
+			for i := 0; i < 3; i++ {
+				result, err := fetcher.Fetch(context.Background(), server.URL)
+				if err != nil {
+					t.Fatalf("Fetch %d failed: %v", i, err)
+				}
+				if string(result.Data) != "feed" {
+					t.Errorf("Fetch %d: expected cached body, got %q", i, result.Data)
+				}
+				if result.Metadata.ETag != tt.etag || result.Metadata.LastModified != tt.lastModified {
+					t.Errorf("Fetch %d: expected caching headers %q/%q, got %q/%q", i, tt.etag, tt.lastModified, result.Metadata.ETag, result.Metadata.LastModified)
+				}
+				if expected := i > 0 && tt.expectedHits > 0; result.Metadata.NotModified != expected {
+					t.Errorf("Fetch %d: expected NotModified=%v", i, expected)
+				}
+			}
+
+			if requests.Load() != 3 {
+				t.Errorf("Expected 3 requests, got %d", requests.Load())
+			}
+			if notModified.Load() != tt.expectedHits {
+				t.Errorf("Expected %d 304 answers, got %d", tt.expectedHits, notModified.Load())
+			}
+		})
+	}
+}
+
+func TestFetch_UnsupportedScheme(t *testing.T) {
+	if _, err := New(DefaultOptions()).Fetch(context.Background(), "ftp://example.com/feed.zip"); err == nil {
+		t.Error("Expected an error for an ftp URL")
+	}
+}
+
+func TestIsURL(t *testing.T) {
+	tests := map[string]bool{
+		"https://example.com/gtfs.zip": true,
+		"HTTP://example.com/gtfs.zip":  true,
+		"feed.zip":                     false,
+		"./https/feed.zip":             false,
+	}
+	for path, expected := range tests {
+		if IsURL(path) != expected {
+			t.Errorf("IsURL(%q) = %v, expected %v", path, !expected, expected)
+		}
+	}
+}
@@ -4,13 +4,11 @@ import (
 	"embed"
 	"html/template"
 	"io"
-	"log"
 	"os"
 	"strings"
 	"time"
 
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 //go:embed templates/*.html
@@ -19,29 +17,61 @@ var templateFS embed.FS
 // NoticeWithDescription extends NoticeGroup with severity information
 type NoticeWithDescription struct {
 	NoticeGroup
-	SeverityInfo SeverityInfo `json:"severityInfo"`
+	SeverityInfo SeverityInfo   `json:"severityInfo"`
+	Samples      []NoticeSample `json:"samples"`
+}
+
+// NoticeSample pairs a sample notice context with its location
+type NoticeSample struct {
+	Context  map[string]interface{} `json:"context"`
+	Location NoticeLocation         `json:"location"`
+}
+
+// EntityBreakdownSection lists the entities of one type with the most notices
+type EntityBreakdownSection struct {
+	Title    string          `json:"title"`
+	Entities []EntitySummary `json:"entities"`
+	Total    int             `json:"total"`
 }
 
 // HTMLTemplateData represents the data structure passed to HTML templates
 type HTMLTemplateData struct {
-	Summary        Summary                 `json:"summary"`
-	Notices        []NoticeWithDescription `json:"notices"`
+	Summary        Summary                  `json:"summary"`
+	Notices        []NoticeWithDescription  `json:"notices"`
+	Breakdowns     []EntityBreakdownSection `json:"breakdowns"`
 	GeneratedAt    string
 	SeverityCounts map[string]int
+
+	// Lang is the locale of the report text
+	Lang    string
+	printer *message.Printer
+}
+
+// T translates a message of the report template, keyed by its English text,
+// and formats its arguments for the report locale.
+func (d HTMLTemplateData) T(key string, args ...interface{}) string {
+	return d.printer.Sprintf(key, args...)
 }
 
+// generatedAtLayout is the time layout of the report date, translated per locale
+const generatedAtLayout = "January 2, 2006 at 3:04 PM"
+
+// maxBreakdownEntities limits the rows of each per-entity breakdown table
+const maxBreakdownEntities = 20
+
 // HTMLFormatter handles HTML report generation
 type HTMLFormatter struct {
 	template *template.Template
+	locale   string
 }
 
 // NewHTMLFormatter creates a new HTML formatter with embedded templates
 func NewHTMLFormatter() (*HTMLFormatter, error) {
 	// Parse the embedded template
-	caser := cases.Title(language.English)
 	tmpl, err := template.New("report.html").Funcs(template.FuncMap{
-		"title": caser.String,
-	}).ParseFS(templateFS, "templates/report.html")
+		"signed": SignedDelta,
+		"join":   strings.Join,
+	}).ParseFS(templateFS, "templates/report.html", "templates/comparison.html")
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +81,22 @@ func NewHTMLFormatter() (*HTMLFormatter, error) {
 	}, nil
 }
 
+// SetLocale sets the language of the HTML report, e.g. "fr". By default
+// reports are rendered in the locale they were validated with. Notice
+// descriptions of reports validated in another locale are translated.
+func (f *HTMLFormatter) SetLocale(locale string) {
+	f.locale = locale
+}
+
 // GenerateHTML generates an HTML report from the validation results
 func (f *HTMLFormatter) GenerateHTML(report *ValidationReport, writer io.Writer) error {
+	locale := MatchLocale(report.Summary.Locale)
+	if f.locale != "" && MatchLocale(f.locale) != locale {
+		report = report.Localized(f.locale)
+		locale = report.Summary.Locale
+	}
+	printer := bundledCatalog.printer(bundledCatalog.match(locale))
+
 	// Calculate severity counts and add descriptions
 	severityCounts := make(map[string]int)
 	noticesWithDesc := make([]NoticeWithDescription, len(report.Notices))
@@ -61,10 +105,18 @@ func (f *HTMLFormatter) GenerateHTML(report *ValidationReport, writer io.Writer)
 		severity := strings.ToLower(notice.Severity)
 		severityCounts[severity] += 1
 
+		// Pair each sample with its location
+		locations := notice.Locations()
+		samples := make([]NoticeSample, len(notice.SampleNotices))
+		for j, sample := range notice.SampleNotices {
+			samples[j] = NoticeSample{Context: sample, Location: locations[j]}
+		}
+
 		// Add severity information to notice
 		noticesWithDesc[i] = NoticeWithDescription{
 			NoticeGroup:  notice,
-			SeverityInfo: GetSeverityInfo(notice.Severity),
+			SeverityInfo: LocalizedSeverityInfo(notice.Severity, locale),
+			Samples:      samples,
 		}
 	}
 
@@ -72,23 +124,65 @@ func (f *HTMLFormatter) GenerateHTML(report *ValidationReport, writer io.Writer)
 	data := HTMLTemplateData{
 		Summary:        report.Summary,
 		Notices:        noticesWithDesc,
-		GeneratedAt:    time.Now().Format("January 2, 2006 at 3:04 PM"),
+		Breakdowns:     entityBreakdownSections(report, printer),
+		GeneratedAt:    time.Now().Format(printer.Sprintf(generatedAtLayout)),
 		SeverityCounts: severityCounts,
+		Lang:           locale,
+		printer:        printer,
 	}
 
 	// Execute template
 	return f.template.Execute(writer, data)
 }
 
+// entityBreakdownSections builds the per-agency and per-route breakdown tables.
+// Feeds with a single agency skip the agency table as it repeats the summary.
+func entityBreakdownSections(report *ValidationReport, printer *message.Printer) []EntityBreakdownSection {
+	var sections []EntityBreakdownSection
+	add := func(title, entityType string) {
+		entities := report.EntityBreakdown(entityType)
+		if len(entities) == 0 {
+			return
+		}
+		section := EntityBreakdownSection{Title: printer.Sprintf(title), Entities: entities, Total: len(entities)}
+		if len(entities) > maxBreakdownEntities {
+			section.Entities = entities[:maxBreakdownEntities]
+		}
+		sections = append(sections, section)
+	}
+
+	if report.Summary.FeedInfo.AgencyCount != 1 {
+		add("🏢 Notices by Agency", EntityTypeAgency)
+	}
+	add("🚌 Notices by Route", EntityTypeRoute)
+	return sections
+}
+
+// ComparisonTemplateData represents the data passed to the comparison template
+type ComparisonTemplateData struct {
+	Comparison  *ReportComparison
+	GeneratedAt string
+}
+
+// GenerateComparisonHTML generates an HTML report describing the differences between two validation runs
+func (f *HTMLFormatter) GenerateComparisonHTML(comparison *ReportComparison, writer io.Writer) error {
+	data := ComparisonTemplateData{
+		Comparison:  comparison,
+		GeneratedAt: time.Now().Format(generatedAtLayout),
+	}
+	return f.template.ExecuteTemplate(writer, "comparison.html", data)
+}
+
 // GenerateHTMLToFile generates an HTML report and writes it to a file
-func (f *HTMLFormatter) GenerateHTMLToFile(report *ValidationReport, filename string) error {
+func (f *HTMLFormatter) GenerateHTMLToFile(report *ValidationReport, filename string) (err error) {
 	file, err := os.Create(filename) // #nosec G304 -- User-provided output filename
 	if err != nil {
 		return err
 	}
 	defer func() {
-		if closeErr := file.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+		// A failed close can lose buffered data, so it fails the write
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
 		}
 	}()
 
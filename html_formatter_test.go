@@ -1,6 +1,7 @@
 package gtfsvalidator
 
 import (
+	"html/template"
 	"strings"
 	"testing"
 	"time"
@@ -367,3 +368,115 @@ func TestHTMLFormatter_SeverityCounts(t *testing.T) {
 		t.Error("HTML should show correct total notice group count in filter buttons")
 	}
 }
+
+func TestHTMLFormatter_SampleLocations(t *testing.T) {
+	formatter, err := NewHTMLFormatter()
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+
+	report := &ValidationReport{
+		Summary: Summary{Counts: NoticeCounts{Errors: 1, Total: 1}},
+		Notices: []NoticeGroup{
+			{
+				Code:         "foreign_key_violation",
+				Severity:     "ERROR",
+				TotalNotices: 1,
+				// No SampleLocations: the location is derived from the context
+				SampleNotices: []map[string]interface{}{
+					{"filename": "trips.txt", "csvRowNumber": 7.0, "fieldName": "route_id", "fieldValue": "R42"},
+				},
+			},
+		},
+	}
+
+	html, err := formatter.GenerateHTMLString(report)
+	if err != nil {
+		t.Fatalf("GenerateHTMLString() failed: %v", err)
+	}
+	for _, expected := range []string{"sample-location", "trips.txt:7", "route R42"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("HTML missing %q", expected)
+		}
+	}
+}
+
+func TestHTMLFormatter_EntityBreakdown(t *testing.T) {
+	formatter, err := NewHTMLFormatter()
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+
+	report := &ValidationReport{
+		Summary: Summary{Counts: NoticeCounts{Errors: 2, Total: 2}},
+		Notices: []NoticeGroup{
+			{
+				Code:         "foreign_key_violation",
+				Severity:     "ERROR",
+				TotalNotices: 2,
+				SampleNotices: []map[string]interface{}{
+					{"filename": "trips.txt", "csvRowNumber": 2.0, "fieldName": "route_id", "fieldValue": "R42"},
+					{"filename": "routes.txt", "csvRowNumber": 3.0, "fieldName": "agency_id", "fieldValue": "metro"},
+				},
+			},
+		},
+	}
+
+	html, err := formatter.GenerateHTMLString(report)
+	if err != nil {
+		t.Fatalf("GenerateHTMLString() failed: %v", err)
+	}
+	for _, expected := range []string{"breakdown-section", "Notices by Route", "Notices by Agency", "R42", "metro"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("HTML missing %q", expected)
+		}
+	}
+}
+
+func TestHTMLFormatter_Locale(t *testing.T) {
+	formatter, err := NewHTMLFormatter()
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+
+	description := GetEnhancedNoticeDescription("duplicate_key")
+	report := &ValidationReport{
+		Summary: Summary{
+			ValidationTime: 1.5,
+			FeedInfo:       FeedInfo{FeedPath: "test.zip"},
+			Counts:         NoticeCounts{Errors: 1, Total: 1},
+		},
+		Notices: []NoticeGroup{
+			{Code: "duplicate_key", Severity: "ERROR", Description: description.Description, ExampleFix: description.ExampleFix, TotalNotices: 1},
+		},
+	}
+
+	html, err := formatter.GenerateHTMLString(report)
+	if err != nil {
+		t.Fatalf("GenerateHTMLString() failed: %v", err)
+	}
+	for _, expected := range []string{`<html lang="en">`, "Validation FAILED: 1 error found", "1 instance<", description.Description} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("English HTML missing %q", expected)
+		}
+	}
+
+	formatter.SetLocale("fr-FR")
+	html, err = formatter.GenerateHTMLString(report)
+	if err != nil {
+		t.Fatalf("GenerateHTMLString() failed: %v", err)
+	}
+	french := LocalizedNoticeDescription("duplicate_key", "fr")
+	for _, expected := range []string{`<html lang="fr">`, "Rapport de validation GTFS", "Validation ÉCHOUÉE : 1 erreur trouvée", "1 occurrence<",
+		"❌ Erreur", "Erreurs (1)", "Non-conformité critique", template.HTMLEscapeString(french.Description), template.HTMLEscapeString(french.ExampleFix)} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("French HTML missing %q", expected)
+		}
+	}
+	if strings.Contains(html, "Validation FAILED") || strings.Contains(html, description.Description) {
+		t.Error("French HTML should not contain English text")
+	}
+	if report.Notices[0].Description != description.Description {
+		t.Error("SetLocale should not modify the report")
+	}
+}
@@ -0,0 +1,337 @@
+// Package http serves GTFS validation over net/http: a Handler that validates
+// uploaded feeds and a Middleware that validates uploads before passing them
+// on. Import it under another name, e.g. gtfshttp, to keep net/http usable.
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	nethttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+// defaultMaxUploadSize caps uploads when Options.MaxUploadSize is not set
+const defaultMaxUploadSize = 100 << 20 // 100 MiB
+
+// Options configures a Handler or Middleware
+type Options struct {
+	// ValidatorOptions are applied to every validation, before the query string options
+	ValidatorOptions []gtfsvalidator.Option
+	// MaxUploadSize is the maximum request body size in bytes (0 = 100 MiB)
+	MaxUploadSize int64
+	// Timeout bounds each validation (0 = no timeout)
+	Timeout time.Duration
+	// FormField is the multipart field holding the feed; empty uses "file"
+	FormField string
+	// AllowQueryOptions maps query string parameters to validator options, see OptionsFromQuery
+	AllowQueryOptions bool
+	// QueryLimits restricts the modes and notice limits query strings may select
+	QueryLimits QueryLimits
+	// RejectOnErrors makes Middleware answer 422 with the report when the feed
+	// has errors instead of calling the next handler
+	RejectOnErrors bool
+}
+
+// uploadLimit returns MaxUploadSize, or the default cap when it is not set
+func (o Options) uploadLimit() int64 {
+	if o.MaxUploadSize <= 0 {
+		return defaultMaxUploadSize
+	}
+	return o.MaxUploadSize
+}
+
+// DefaultOptions returns a 100 MiB upload limit, a 5 minute timeout, query
+// string options limited to the performance and default modes and 1000
+// notices per type, and rejection of feeds with errors
+func DefaultOptions() Options {
+	return Options{
+		MaxUploadSize:     defaultMaxUploadSize,
+		Timeout:           5 * time.Minute,
+		FormField:         "file",
+		AllowQueryOptions: true,
+		QueryLimits: QueryLimits{
+			Modes:      []gtfsvalidator.ValidationMode{gtfsvalidator.ValidationModePerformance, gtfsvalidator.ValidationModeDefault},
+			MaxNotices: defaultQueryMaxNotices,
+		},
+		RejectOnErrors: true,
+	}
+}
+
+// Handler validates the GTFS ZIP archive uploaded in a POST request, either as
+// a multipart form field or as the request body, and answers with the report
+// in the format chosen by NegotiateFormat.
+//
+// Status codes: 200 with the report, 400 for invalid query options or a missing
+// feed, 405 for methods other than POST, 406 for unsupported Accept headers,
+// 413 for uploads over MaxUploadSize, 415 for bodies that are not ZIP or
+// multipart, 422 for archives that cannot be read and 503 when the validation
+// times out.
+type Handler struct {
+	options Options
+	html    htmlFormatter
+}
+
+// NewHandler creates a Handler
+func NewHandler(options Options) *Handler {
+	return &Handler{options: options}
+}
+
+// ServeHTTP validates the uploaded feed and writes the report
+func (h *Handler) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.Method != nethttp.MethodPost {
+		w.Header().Set("Allow", nethttp.MethodPost)
+		writeError(w, nethttp.StatusMethodNotAllowed, "method not allowed, use POST")
+		return
+	}
+
+	format, err := NegotiateFormat(r)
+	if err != nil {
+		writeError(w, nethttp.StatusNotAcceptable, err.Error())
+		return
+	}
+
+	r.Body = nethttp.MaxBytesReader(w, r.Body, h.options.uploadLimit())
+	report, err := validateRequest(r, h.options)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	status := nethttp.StatusOK
+	if report.limitExceeded {
+		status = nethttp.StatusUnprocessableEntity
+	}
+	h.html.writeReport(w, report.ValidationReport, format, status)
+}
+
+// reportKey is the context key of the report stored by Middleware
+type reportKey struct{}
+
+// ReportFromContext returns the report of the feed validated by Middleware
+func ReportFromContext(ctx context.Context) (*gtfsvalidator.ValidationReport, bool) {
+	report, ok := ctx.Value(reportKey{}).(*gtfsvalidator.ValidationReport)
+	return report, ok
+}
+
+// Middleware validates the feeds uploaded in POST and PUT requests before
+// calling next. The request body is restored for next and the report is
+// available through ReportFromContext. With RejectOnErrors, feeds with errors
+// are answered with 422 and the report; requests that cannot be validated are
+// answered with the status codes of Handler. Other methods pass through.
+func Middleware(options Options) func(nethttp.Handler) nethttp.Handler {
+	var html htmlFormatter
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method != nethttp.MethodPost && r.Method != nethttp.MethodPut {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			format, err := NegotiateFormat(r)
+			if err != nil {
+				writeError(w, nethttp.StatusNotAcceptable, err.Error())
+				return
+			}
+
+			// Buffer the body so that next can read it again
+			raw, err := io.ReadAll(nethttp.MaxBytesReader(w, r.Body, options.uploadLimit()))
+			if err != nil {
+				writeValidationError(w, uploadError(err))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(raw))
+
+			report, err := validateRequest(r, options)
+			if err != nil {
+				writeValidationError(w, err)
+				return
+			}
+			if options.RejectOnErrors && (report.limitExceeded || report.HasErrors()) {
+				html.writeReport(w, report.ValidationReport, format, nethttp.StatusUnprocessableEntity)
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(raw))
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), reportKey{}, report.ValidationReport)))
+		})
+	}
+}
+
+// requestError is an error answered with a specific status code
+type requestError struct {
+	status  int
+	message string
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+// requestReport is a report and whether validation stopped at an archive limit
+type requestReport struct {
+	*gtfsvalidator.ValidationReport
+	limitExceeded bool
+}
+
+// validateRequest reads the feed of a request and validates it
+func validateRequest(r *nethttp.Request, options Options) (requestReport, error) {
+	validatorOptions := append([]gtfsvalidator.Option(nil), options.ValidatorOptions...)
+	if options.AllowQueryOptions {
+		queryOptions, err := OptionsFromQuery(r.URL.Query(), options.QueryLimits)
+		if err != nil {
+			return requestReport{}, &requestError{nethttp.StatusBadRequest, err.Error()}
+		}
+		validatorOptions = append(validatorOptions, queryOptions...)
+	}
+
+	feed, err := readFeed(r, options.FormField)
+	if err != nil {
+		return requestReport{}, err
+	}
+
+	ctx := r.Context()
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	report, err := gtfsvalidator.New(validatorOptions...).ValidateBytesWithContext(ctx, feed)
+	switch {
+	case err == nil:
+		return requestReport{ValidationReport: report}, nil
+	case errors.Is(err, gtfsvalidator.ErrArchiveLimitExceeded) && report != nil:
+		return requestReport{ValidationReport: report, limitExceeded: true}, nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return requestReport{}, &requestError{nethttp.StatusServiceUnavailable, "validation timed out"}
+	case errors.Is(err, context.Canceled):
+		return requestReport{}, err
+	default:
+		return requestReport{}, &requestError{nethttp.StatusUnprocessableEntity, err.Error()}
+	}
+}
+
+// readFeed returns the feed of a multipart form field or of the request body
+func readFeed(r *nethttp.Request, formField string) ([]byte, error) {
+	if formField == "" {
+		formField = "file"
+	}
+
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "application/octet-stream"
+	}
+
+	var feed []byte
+	switch mediaType {
+	case "multipart/form-data":
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return nil, &requestError{nethttp.StatusBadRequest, fmt.Sprintf("missing %q field", formField)}
+			}
+			if err != nil {
+				return nil, uploadError(err)
+			}
+			if part.FormName() == formField {
+				if feed, err = io.ReadAll(part); err != nil {
+					return nil, uploadError(err)
+				}
+				break
+			}
+		}
+	case "application/zip", "application/x-zip-compressed", "application/octet-stream":
+		if feed, err = io.ReadAll(r.Body); err != nil {
+			return nil, uploadError(err)
+		}
+	default:
+		return nil, &requestError{nethttp.StatusUnsupportedMediaType, fmt.Sprintf("unsupported content type %q: upload a ZIP body or a multipart form", mediaType)}
+	}
+
+	if len(feed) == 0 {
+		return nil, &requestError{nethttp.StatusBadRequest, "empty feed"}
+	}
+	return feed, nil
+}
+
+// uploadError answers oversized uploads with 413 and other read errors with 400
+func uploadError(err error) error {
+	var maxBytesErr *nethttp.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &requestError{nethttp.StatusRequestEntityTooLarge, fmt.Sprintf("upload exceeds %d bytes", maxBytesErr.Limit)}
+	}
+	return &requestError{nethttp.StatusBadRequest, fmt.Sprintf("failed to read upload: %v", err)}
+}
+
+// writeValidationError answers a request that could not be validated
+func writeValidationError(w nethttp.ResponseWriter, err error) {
+	var reqErr *requestError
+	switch {
+	case errors.As(err, &reqErr):
+		writeError(w, reqErr.status, reqErr.message)
+	case errors.Is(err, context.Canceled):
+		// The client went away, nobody reads the answer
+	default:
+		writeError(w, nethttp.StatusInternalServerError, err.Error())
+	}
+}
+
+// writeError writes a JSON error response
+func writeError(w nethttp.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+// htmlFormatter creates the HTML formatter on first use
+type htmlFormatter struct {
+	once      sync.Once
+	formatter *gtfsvalidator.HTMLFormatter
+	err       error
+}
+
+// writeReport writes a report in the given format with the notice counts as headers
+func (h *htmlFormatter) writeReport(w nethttp.ResponseWriter, report *gtfsvalidator.ValidationReport, format Format, status int) {
+	var buf bytes.Buffer
+	switch format {
+	case FormatHTML:
+		h.once.Do(func() { h.formatter, h.err = gtfsvalidator.NewHTMLFormatter() })
+		if h.err != nil {
+			writeError(w, nethttp.StatusInternalServerError, fmt.Sprintf("failed to create HTML formatter: %v", h.err))
+			return
+		}
+		if err := h.formatter.GenerateHTML(report, &buf); err != nil {
+			writeError(w, nethttp.StatusInternalServerError, fmt.Sprintf("failed to generate HTML report: %v", err))
+			return
+		}
+	case FormatSARIF:
+		if err := report.WriteSARIF(&buf); err != nil {
+			writeError(w, nethttp.StatusInternalServerError, fmt.Sprintf("failed to generate SARIF report: %v", err))
+			return
+		}
+	default:
+		if err := json.NewEncoder(&buf).Encode(report); err != nil {
+			writeError(w, nethttp.StatusInternalServerError, fmt.Sprintf("failed to encode report: %v", err))
+			return
+		}
+	}
+
+	counts := report.Summary.Counts
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("X-GTFS-Errors", strconv.Itoa(counts.Errors))
+	w.Header().Set("X-GTFS-Warnings", strconv.Itoa(counts.Warnings))
+	w.Header().Set("Vary", "Accept")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,267 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+// zipFeed returns a GTFS ZIP archive of files
+func zipFeed(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(gtfsvalidator.CreateTempZip(t, files))
+	if err != nil {
+		t.Fatalf("Failed to read test feed: %v", err)
+	}
+	return data
+}
+
+// validFeed returns a feed without errors
+func validFeed(t *testing.T) []byte {
+	t.Helper()
+	files := gtfsvalidator.MinimalValidGTFS()
+	files["calendar.txt"] = "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+		"service_1,1,1,1,1,1,0,0,20250101,20991231"
+	return zipFeed(t, files)
+}
+
+// multipartBody wraps a feed in a multipart form
+func multipartBody(t *testing.T, field string, feed []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile(field, "feed.zip")
+	if err != nil {
+		t.Fatalf("Failed to create form file: %v", err)
+	}
+	if _, err := part.Write(feed); err != nil {
+		t.Fatalf("Failed to write form file: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close multipart writer: %v", err)
+	}
+	return &body, writer.FormDataContentType()
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(DefaultOptions())
+	feed := validFeed(t)
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		contentType  string
+		accept       string
+		body         []byte
+		expectStatus int
+		expectType   string
+	}{
+		{"ZIP body as JSON", nethttp.MethodPost, "/validate", "application/zip", "", feed, nethttp.StatusOK, "application/json"},
+		{"HTML via Accept", nethttp.MethodPost, "/validate", "application/zip", "text/html", feed, nethttp.StatusOK, "text/html"},
+		{"SARIF via query", nethttp.MethodPost, "/validate?format=sarif", "application/zip", "text/html", feed, nethttp.StatusOK, "application/sarif+json"},
+		{"Accept quality", nethttp.MethodPost, "/validate", "application/zip", "text/html;q=0.5, application/sarif+json", feed, nethttp.StatusOK, "application/sarif+json"},
+		{"query options", nethttp.MethodPost, "/validate?mode=performance&country=gb&maxNotices=5", "application/zip", "", feed, nethttp.StatusOK, "application/json"},
+		{"wrong method", nethttp.MethodGet, "/validate", "", "", nil, nethttp.StatusMethodNotAllowed, "application/json"},
+		{"unacceptable", nethttp.MethodPost, "/validate", "application/zip", "image/png", feed, nethttp.StatusNotAcceptable, "application/json"},
+		{"invalid option", nethttp.MethodPost, "/validate?mode=fast", "application/zip", "", feed, nethttp.StatusBadRequest, "application/json"},
+		{"mode not allowed", nethttp.MethodPost, "/validate?mode=comprehensive", "application/zip", "", feed, nethttp.StatusBadRequest, "application/json"},
+		{"no notice limit", nethttp.MethodPost, "/validate?maxNotices=0", "application/zip", "", feed, nethttp.StatusBadRequest, "application/json"},
+		{"unknown rule", nethttp.MethodPost, "/validate?disable=no_such_rule", "application/zip", "", feed, nethttp.StatusBadRequest, "application/json"},
+		{"unsupported body", nethttp.MethodPost, "/validate", "text/plain", "", feed, nethttp.StatusUnsupportedMediaType, "application/json"},
+		{"empty body", nethttp.MethodPost, "/validate", "application/zip", "", nil, nethttp.StatusBadRequest, "application/json"},
+		{"not a ZIP", nethttp.MethodPost, "/validate", "application/zip", "", []byte("not a zip"), nethttp.StatusUnprocessableEntity, "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.HasPrefix(rec.Header().Get("Content-Type"), tt.expectType) {
+				t.Errorf("Expected content type %s, got %s", tt.expectType, rec.Header().Get("Content-Type"))
+			}
+			if rec.Code == nethttp.StatusOK && rec.Header().Get("X-GTFS-Errors") != "0" {
+				t.Errorf("Expected no errors in X-GTFS-Errors, got %q", rec.Header().Get("X-GTFS-Errors"))
+			}
+		})
+	}
+}
+
+func TestHandler_Multipart(t *testing.T) {
+	options := DefaultOptions()
+	options.FormField = "gtfs"
+	handler := NewHandler(options)
+
+	body, contentType := multipartBody(t, "gtfs", validFeed(t))
+	req := httptest.NewRequest(nethttp.MethodPost, "/validate", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var report gtfsvalidator.ValidationReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil || report.Summary.FeedInfo.RouteCount != 1 {
+		t.Errorf("Expected a report with one route, got %+v (%v)", report.Summary.FeedInfo, err)
+	}
+
+	body, contentType = multipartBody(t, "other", validFeed(t))
+	req = httptest.NewRequest(nethttp.MethodPost, "/validate", body)
+	req.Header.Set("Content-Type", contentType)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusBadRequest {
+		t.Errorf("Expected 400 without the form field, got %d", rec.Code)
+	}
+}
+
+func TestHandler_MaxUploadSize(t *testing.T) {
+	options := DefaultOptions()
+	options.MaxUploadSize = 64
+	handler := NewHandler(options)
+
+	req := httptest.NewRequest(nethttp.MethodPost, "/validate", bytes.NewReader(validFeed(t)))
+	req.Header.Set("Content-Type", "application/zip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestMiddleware_MaxUploadSize(t *testing.T) {
+	options := DefaultOptions()
+	options.MaxUploadSize = 64
+	called := false
+	next := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		called = true
+	})
+	handler := Middleware(options)(next)
+
+	req := httptest.NewRequest(nethttp.MethodPost, "/feeds", bytes.NewReader(validFeed(t)))
+	req.Header.Set("Content-Type", "application/zip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if called {
+		t.Error("Expected the oversized upload not to reach the next handler")
+	}
+}
+
+func TestOptions_DefaultUploadLimit(t *testing.T) {
+	if limit := (Options{}).uploadLimit(); limit != defaultMaxUploadSize {
+		t.Errorf("Expected the default cap of %d bytes without MaxUploadSize, got %d", defaultMaxUploadSize, limit)
+	}
+	if limit := (Options{MaxUploadSize: 64}).uploadLimit(); limit != 64 {
+		t.Errorf("Expected MaxUploadSize to be kept, got %d", limit)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var received []byte
+	next := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		report, ok := ReportFromContext(r.Context())
+		if r.Method == nethttp.MethodPost && (!ok || report == nil) {
+			t.Error("Expected the report in the request context")
+		}
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(nethttp.StatusCreated)
+	})
+	handler := Middleware(DefaultOptions())(next)
+
+	feed := validFeed(t)
+	body, contentType := multipartBody(t, "file", feed)
+	sent := body.Bytes()
+	req := httptest.NewRequest(nethttp.MethodPost, "/feeds", bytes.NewReader(sent))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusCreated {
+		t.Fatalf("Expected the valid feed to reach the next handler, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !bytes.Equal(received, sent) {
+		t.Error("Expected the next handler to receive the original body")
+	}
+
+	req = httptest.NewRequest(nethttp.MethodPost, "/feeds", bytes.NewReader(zipFeed(t, gtfsvalidator.InvalidGTFS())))
+	req.Header.Set("Content-Type", "application/zip")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422 for a feed with errors, got %d", rec.Code)
+	}
+	if rec.Header().Get("X-GTFS-Errors") == "0" {
+		t.Error("Expected the error count in X-GTFS-Errors")
+	}
+
+	req = httptest.NewRequest(nethttp.MethodGet, "/feeds", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusCreated {
+		t.Errorf("Expected GET requests to pass through, got %d", rec.Code)
+	}
+}
+
+func TestOptionsFromQuery(t *testing.T) {
+	query := url.Values{
+		"mode":       {"comprehensive"},
+		"country":    {"nl"},
+		"date":       {"2025-03-01"},
+		"maxNotices": {"5000"},
+		"disable":    {"block_too_many_trips,all_caps_headsign"},
+		"severity":   {"unused_shape:ERROR"},
+		"lang":       {"fr"},
+	}
+	opts, err := OptionsFromQuery(query, QueryLimits{MaxNotices: 200})
+	if err != nil {
+		t.Fatalf("OptionsFromQuery failed: %v", err)
+	}
+	var config gtfsvalidator.Config
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.ValidationMode != gtfsvalidator.ValidationModeComprehensive || config.CountryCode != "NL" || config.MaxNoticesPerType != 200 {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+	if len(config.DisabledRules) != 2 || config.SeverityOverrides["unused_shape"] != "ERROR" {
+		t.Errorf("Expected disabled rules and severity overrides, got %v and %v", config.DisabledRules, config.SeverityOverrides)
+	}
+	if config.Locale != "fr" {
+		t.Errorf("Expected locale fr, got %q", config.Locale)
+	}
+
+	for _, invalid := range []url.Values{
+		{"maxNotices": {"many"}},
+		{"severity": {"unused_shape"}},
+		{"date": {"tomorrow"}},
+		{"maxNotices": {"0"}},
+		{"maxNotices": {"-1"}},
+		{"mode": {"comprehensive"}},
+	} {
+		if _, err := OptionsFromQuery(invalid, DefaultOptions().QueryLimits); err == nil {
+			t.Errorf("Expected an error for %v", invalid)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package http
+
+import (
+	"fmt"
+	"mime"
+	nethttp "net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+// Format is a report format
+type Format string
+
+// Report formats
+const (
+	FormatJSON  Format = "json"
+	FormatHTML  Format = "html"
+	FormatSARIF Format = "sarif"
+)
+
+// ContentType returns the media type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	case FormatSARIF:
+		return "application/sarif+json"
+	default:
+		return "application/json"
+	}
+}
+
+// mediaTypeFormats maps the media types of an Accept header to formats
+var mediaTypeFormats = map[string]Format{
+	"application/json":       FormatJSON,
+	"application/*":          FormatJSON,
+	"*/*":                    FormatJSON,
+	"text/html":              FormatHTML,
+	"text/*":                 FormatHTML,
+	"application/sarif+json": FormatSARIF,
+}
+
+// NegotiateFormat chooses the report format from the format query parameter
+// (json, html or sarif) or else from the Accept header, preferring the media
+// types with the highest quality. Requests without either get JSON.
+func NegotiateFormat(r *nethttp.Request) (Format, error) {
+	if value := r.URL.Query().Get("format"); value != "" {
+		switch format := Format(strings.ToLower(value)); format {
+		case FormatJSON, FormatHTML, FormatSARIF:
+			return format, nil
+		default:
+			return "", fmt.Errorf("unsupported format %q: expected json, html or sarif", value)
+		}
+	}
+
+	accept := r.Header.Get("Accept")
+	if strings.TrimSpace(accept) == "" {
+		return FormatJSON, nil
+	}
+
+	type candidate struct {
+		format  Format
+		quality float64
+	}
+	var candidates []candidate
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		format, ok := mediaTypeFormats[mediaType]
+		if !ok {
+			continue
+		}
+		quality := 1.0
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			quality = q
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{format, quality})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("none of %q can be produced: use application/json, text/html or application/sarif+json", accept)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+	return candidates[0].format, nil
+}
+
+// defaultQueryMaxNotices is the maxNotices cap of QueryLimits without MaxNotices
+const defaultQueryMaxNotices = 1000
+
+// QueryLimits restricts the validator options a query string may select
+type QueryLimits struct {
+	// Modes lists the validation modes the mode parameter may select; empty allows all modes
+	Modes []gtfsvalidator.ValidationMode
+	// MaxNotices is the largest maxNotices value; larger values are clamped to it (0 = 1000)
+	MaxNotices int
+}
+
+// maxNotices returns MaxNotices, or the default cap when it is not set
+func (l QueryLimits) maxNotices() int {
+	if l.MaxNotices <= 0 {
+		return defaultQueryMaxNotices
+	}
+	return l.MaxNotices
+}
+
+// allowsMode reports whether the mode parameter may select mode
+func (l QueryLimits) allowsMode(mode gtfsvalidator.ValidationMode) bool {
+	if len(l.Modes) == 0 {
+		return true
+	}
+	for _, allowed := range l.Modes {
+		if allowed == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// OptionsFromQuery maps query string parameters to validator options:
+//
+//	mode=performance|default|comprehensive
+//	country=GB
+//	date=2025-03-01 (or 20250301)
+//	maxNotices=50 (at least 1, clamped to limits.MaxNotices)
+//	disable=code1,code2 (repeatable)
+//	severity=code:INFO (repeatable)
+//	lang=fr
+//
+// The values are checked like the settings of a configuration file, and modes
+// outside limits.Modes are rejected.
+func OptionsFromQuery(query url.Values, limits QueryLimits) ([]gtfsvalidator.Option, error) {
+	file := gtfsvalidator.ConfigFile{
+		Mode:        query.Get("mode"),
+		Country:     query.Get("country"),
+		CurrentDate: query.Get("date"),
+		Locale:      query.Get("lang"),
+	}
+
+	if file.Mode != "" && !limits.allowsMode(gtfsvalidator.ValidationMode(file.Mode)) {
+		return nil, fmt.Errorf("mode %q is not allowed: expected %s", file.Mode, joinModes(limits.Modes))
+	}
+
+	if value := query.Get("maxNotices"); value != "" {
+		maxNotices, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("maxNotices must be a number, got %q", value)
+		}
+		if maxNotices < 1 {
+			return nil, fmt.Errorf("maxNotices must be at least 1, got %d", maxNotices)
+		}
+		if maxNotices > limits.maxNotices() {
+			maxNotices = limits.maxNotices()
+		}
+		file.MaxNotices = &maxNotices
+	}
+
+	for _, value := range query["disable"] {
+		for _, code := range strings.Split(value, ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				file.DisabledRules = append(file.DisabledRules, code)
+			}
+		}
+	}
+
+	for _, value := range query["severity"] {
+		code, severity, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("severity must be code:LEVEL, got %q", value)
+		}
+		if file.SeverityOverrides == nil {
+			file.SeverityOverrides = make(map[string]string)
+		}
+		file.SeverityOverrides[strings.TrimSpace(code)] = strings.TrimSpace(severity)
+	}
+
+	return file.Options()
+}
+
+// joinModes lists modes for error messages
+func joinModes(modes []gtfsvalidator.ValidationMode) string {
+	names := make([]string, len(modes))
+	for i, mode := range modes {
+		names[i] = string(mode)
+	}
+	return strings.Join(names, ", ")
+}
@@ -1,16 +1,21 @@
 package gtfsvalidator
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"io/fs"
 	"os"
+	"runtime/debug"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/fetch"
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/report"
@@ -40,6 +45,11 @@ func (v *validatorImpl) ValidateFileWithContext(ctx context.Context, path string
 	validationConfig := v.createValidationConfig()
 	internalValidator := newInternalValidator(internalConfig, validationConfig)
 
+	return v.validatePath(ctx, internalValidator, path, startTime)
+}
+
+// validatePath validates a ZIP file or directory and converts the report to the public API format.
+func (v *validatorImpl) validatePath(ctx context.Context, internalValidator *internalValidator, path string, startTime time.Time) (*ValidationReport, error) {
 	// Set up progress reporting
 	if v.config.ProgressCallback != nil {
 		internalValidator.progressCallback = v.config.ProgressCallback
@@ -63,15 +73,17 @@ func (v *validatorImpl) ValidateFileWithContext(ctx context.Context, path string
 		internalReport, err = internalValidator.ValidateDirectoryWithContext(ctx, path)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
 	// Convert internal report to public API format
-	return v.convertReport(internalReport, time.Since(startTime)), nil
+	return v.finishReport(internalValidator, internalReport, startTime, err)
 }
 
+// readerMemoryThreshold is the largest archive ValidateReaderWithContext keeps
+// in memory; larger archives are spilled to a temporary file.
+const readerMemoryThreshold = 8 << 20 // 8 MiB
+
 // ValidateReaderWithContext validates a GTFS feed from an io.Reader.
+// Archives up to readerMemoryThreshold are read into memory; larger ones are
+// copied to a temporary file that is removed when validation ends.
 func (v *validatorImpl) ValidateReaderWithContext(ctx context.Context, reader io.Reader) (*ValidationReport, error) {
 	// Check context cancellation
 	select {
@@ -80,49 +92,190 @@ func (v *validatorImpl) ValidateReaderWithContext(ctx context.Context, reader io
 	default:
 	}
 
-	// Create temporary file for the ZIP content
+	// Read at most one byte more than the archive size limit
+	limits := v.config.ArchiveLimits
+	if limits.MaxArchiveSize > 0 {
+		reader = io.LimitReader(reader, limits.MaxArchiveSize+1)
+	}
+
+	head, err := io.ReadAll(io.LimitReader(reader, readerMemoryThreshold+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+	if len(head) <= readerMemoryThreshold {
+		return v.ValidateBytesWithContext(ctx, head)
+	}
+
+	// Create temporary file for the rest of the ZIP content
 	tmpFile, err := os.CreateTemp("", "gtfs-*.zip")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temporary file: %w", err)
 	}
 	defer func() {
 		if err := os.Remove(tmpFile.Name()); err != nil {
-			log.Printf("Warning: failed to remove temp file: %v", err)
+			v.config.logger().Warn("Failed to remove temp file", logging.String("file", tmpFile.Name()), logging.ErrorField("error", err))
 		}
 	}()
 	defer func() {
-		if closeErr := tmpFile.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+		if err := tmpFile.Close(); err != nil {
+			v.config.logger().Warn("Failed to close temp file", logging.String("file", tmpFile.Name()), logging.ErrorField("error", err))
 		}
 	}()
 
 	// Copy reader content to temporary file
-	_, err = io.Copy(tmpFile, reader)
+	size, err := io.Copy(tmpFile, io.MultiReader(bytes.NewReader(head), reader))
 	if err != nil {
 		return nil, fmt.Errorf("failed to write temporary file: %w", err)
 	}
 
-	// Close the file to ensure all data is written
-	if err := tmpFile.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close temporary file: %w", err)
+	return v.validateZipReaderAt(ctx, tmpFile, size)
+}
+
+// ValidateBytesWithContext validates a GTFS ZIP archive held in memory.
+func (v *validatorImpl) ValidateBytesWithContext(ctx context.Context, data []byte) (*ValidationReport, error) {
+	return v.validateZipReaderAt(ctx, bytes.NewReader(data), int64(len(data)))
+}
+
+// validateZipReaderAt validates a GTFS ZIP archive read from r, which must stay
+// valid until validation ends.
+func (v *validatorImpl) validateZipReaderAt(ctx context.Context, r io.ReaderAt, size int64) (*ValidationReport, error) {
+	startTime := time.Now()
+
+	loader, err := v.config.loadFeed("", func() (*parser.FeedLoader, error) {
+		return parser.LoadFromZipReaderWithOptions(r, size, v.config.archiveOptions())
+	})
+	if err != nil {
+		if errors.Is(err, ErrArchiveLimitExceeded) {
+			internalValidator := newInternalValidator(v.createInternalConfig(), v.createValidationConfig())
+			return v.finishReport(internalValidator, internalValidator.archiveLimitReport(err, "", startTime), startTime, err)
+		}
+		return nil, fmt.Errorf("failed to load zip archive: %w", err)
+	}
+
+	return v.validateLoader(ctx, loader, "")
+}
+
+// ValidateFSWithContext validates a GTFS feed stored at the root of a file system.
+func (v *validatorImpl) ValidateFSWithContext(ctx context.Context, fsys fs.FS) (*ValidationReport, error) {
+	loader, err := v.config.loadFeed("", func() (*parser.FeedLoader, error) {
+		return parser.LoadFromFS(fsys)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feed: %w", err)
+	}
+
+	return v.validateLoader(ctx, loader, "")
+}
+
+// ValidateURLWithContext downloads a GTFS ZIP archive and validates it in memory.
+func (v *validatorImpl) ValidateURLWithContext(ctx context.Context, url string) (*ValidationReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	options := v.config.Fetch
+	if options.MaxSize == 0 {
+		options.MaxSize = v.config.ArchiveLimits.MaxArchiveSize
+	}
+
+	result, err := fetch.New(options).Fetch(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download feed: %w", err)
+	}
+
+	validationReport, err := v.ValidateBytesWithContext(ctx, result.Data)
+	if validationReport != nil {
+		download := result.Metadata
+		validationReport.Summary.FeedInfo.FeedPath = url
+		validationReport.Summary.FeedInfo.Download = &download
+	}
+	return validationReport, err
+}
+
+// validateLoader runs the configured validation on an opened feed and closes it.
+func (v *validatorImpl) validateLoader(ctx context.Context, loader *parser.FeedLoader, feedPath string) (*ValidationReport, error) {
+	defer func() {
+		if err := loader.Close(); err != nil {
+			loader.Logger().Warn("Failed to close loader", logging.String("feed", feedPath), logging.ErrorField("error", err))
+		}
+	}()
+
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	startTime := time.Now()
+
+	internalValidator := newInternalValidator(v.createInternalConfig(), v.createValidationConfig())
+	if v.config.ProgressCallback != nil {
+		internalValidator.progressCallback = v.config.ProgressCallback
+	}
+
+	internalReport, err := internalValidator.ValidateLoaderWithContext(ctx, loader, feedPath)
+	return v.finishReport(internalValidator, internalReport, startTime, err)
+}
+
+// finishReport converts an internal report to the public format. The report is
+// returned together with err when validation stopped early but still produced
+// notices, e.g. on ErrArchiveLimitExceeded.
+func (v *validatorImpl) finishReport(internalValidator *internalValidator, internalReport *report.ValidationReport, startTime time.Time, err error) (*ValidationReport, error) {
+	if internalReport == nil {
+		return nil, err
 	}
 
-	// Validate the temporary file
-	return v.ValidateFileWithContext(ctx, tmpFile.Name())
+	validationReport := v.convertReport(internalReport, time.Since(startTime))
+	validationReport.entityIndex = internalValidator.entityIndex
+	if internalValidator.entityIndex != nil {
+		validationReport.Entities = internalValidator.entityIndex.relations(validationReport.Notices)
+	}
+	return validationReport, err
 }
 
 // createInternalConfig creates the internal validator configuration.
 func (v *validatorImpl) createInternalConfig() Config {
 	return Config{
-		CountryCode:      v.config.CountryCode,
-		CurrentDate:      v.config.CurrentDate,
-		MaxMemory:        v.config.MaxMemory,
-		ParallelWorkers:  v.config.ParallelWorkers,
-		ValidatorVersion: v.config.ValidatorVersion,
-		EnableCaching:    v.config.EnableCaching,
+		CountryCode:                 v.config.CountryCode,
+		CurrentDate:                 v.config.CurrentDate,
+		MaxMemory:                   v.config.MaxMemory,
+		ParallelWorkers:             v.config.ParallelWorkers,
+		ValidatorVersion:            v.config.ValidatorVersion,
+		EnableCaching:               v.config.EnableCaching,
+		DiffThresholds:              v.config.DiffThresholds,
+		IgnoreSubfolderFiles:        v.config.IgnoreSubfolderFiles,
+		ArchiveLimits:               v.config.ArchiveLimits,
+		TranscodeToUTF8:             v.config.TranscodeToUTF8,
+		RecoverMalformedCSV:         v.config.RecoverMalformedCSV,
+		CrossFeedStopDistanceMeters: v.config.CrossFeedStopDistanceMeters,
+		SeverityOverrides:           v.config.SeverityOverrides,
+		DisabledRules:               v.config.DisabledRules,
+		Observer:                    v.config.Observer,
+		Logger:                      v.config.Logger,
+		Locale:                      v.config.Locale,
 	}
 }
 
+// archiveOptions returns the parser options for loading ZIP archives.
+func (c Config) archiveOptions() parser.ArchiveOptions {
+	return parser.ArchiveOptions{IgnoreSubfolderFiles: c.IgnoreSubfolderFiles, Limits: c.ArchiveLimits}
+}
+
+// severityOverrides returns the configured severity overrides, skipping invalid severities.
+func (c Config) severityOverrides() map[string]notice.SeverityLevel {
+	overrides := make(map[string]notice.SeverityLevel, len(c.SeverityOverrides))
+	for code, name := range c.SeverityOverrides {
+		if severity, err := notice.ParseSeverity(name); err == nil {
+			overrides[code] = severity
+		}
+	}
+	return overrides
+}
+
 // createValidationConfig creates the validation configuration based on mode.
 func (v *validatorImpl) createValidationConfig() validationConfig {
 	switch v.config.ValidationMode {
@@ -146,19 +299,21 @@ func (v *validatorImpl) convertReport(internal *report.ValidationReport, elapsed
 			// but handle it gracefully
 			group.TotalNotices += n.TotalNotices
 			group.SampleNotices = append(group.SampleNotices, n.SampleNotices...)
+			group.SampleLocations = append(group.SampleLocations, n.SampleLocations...)
 		} else {
-			enhanced := GetEnhancedNoticeDescription(n.Code)
+			enhanced := LocalizedNoticeDescription(n.Code, v.config.Locale)
 			noticeGroups[n.Code] = &NoticeGroup{
-				Code:           n.Code,
-				Severity:       n.Severity,
-				Description:    enhanced.Description,
-				GTFSReference:  enhanced.GTFSReference,
-				AffectedFiles:  enhanced.AffectedFiles,
-				AffectedFields: enhanced.AffectedFields,
-				ExampleFix:     enhanced.ExampleFix,
-				Impact:         enhanced.Impact,
-				TotalNotices:   n.TotalNotices,
-				SampleNotices:  n.SampleNotices,
+				Code:            n.Code,
+				Severity:        n.Severity,
+				Description:     enhanced.Description,
+				GTFSReference:   enhanced.GTFSReference,
+				AffectedFiles:   enhanced.AffectedFiles,
+				AffectedFields:  enhanced.AffectedFields,
+				ExampleFix:      enhanced.ExampleFix,
+				Impact:          enhanced.Impact,
+				TotalNotices:    n.TotalNotices,
+				SampleNotices:   n.SampleNotices,
+				SampleLocations: n.SampleLocations,
 			}
 		}
 	}
@@ -170,6 +325,7 @@ func (v *validatorImpl) convertReport(internal *report.ValidationReport, elapsed
 	}
 
 	return &ValidationReport{
+		SchemaVersion: ReportSchemaVersion,
 		Summary: Summary{
 			ValidatorVersion: internal.Summary.ValidatorVersion,
 			ValidationTime:   internal.Summary.ValidationTime,
@@ -190,6 +346,7 @@ func (v *validatorImpl) convertReport(internal *report.ValidationReport, elapsed
 				Infos:    internal.Summary.Counts.Infos,
 				Total:    internal.Summary.Counts.Total,
 			},
+			Locale: MatchLocale(v.config.Locale),
 		},
 		Notices: notices,
 	}
@@ -208,6 +365,7 @@ type validationConfig struct {
 	EnableGeospatial      bool
 	EnableNetworkTopology bool
 	EnableDateTrips       bool
+	EnableRawFileChecks   bool // Checks that re-read the bytes of every file
 	MaxNoticesPerType     int
 }
 
@@ -220,6 +378,7 @@ func defaultValidationConfig() validationConfig {
 		EnableAccessibility: true,
 		EnableFare:          true,
 		EnableMeta:          true,
+		EnableRawFileChecks: true,
 		MaxNoticesPerType:   100,
 	}
 }
@@ -245,6 +404,7 @@ func comprehensiveValidationConfig() validationConfig {
 		EnableGeospatial:      true,
 		EnableNetworkTopology: true,
 		EnableDateTrips:       true,
+		EnableRawFileChecks:   true,
 		MaxNoticesPerType:     1000,
 	}
 }
@@ -257,9 +417,10 @@ type internalValidator struct {
 	feedLoader       *parser.FeedLoader
 	validators       []validator.Validator
 	progressCallback func(ProgressInfo)
-	noticeCallback   NoticeCallback // For streaming validation
-	streamedCount    int            // Track how many notices we've already streamed
-	streamMutex      sync.Mutex     // Protect streaming state in parallel mode
+	noticeCallback   NoticeCallback  // For streaming validation
+	pendingNotices   []notice.Notice // Notices added since the last streamed batch
+	streamMutex      sync.Mutex      // Protect streaming state in parallel mode
+	entityIndex      *entityIndex    // Notices by referenced entity, built with the report
 }
 
 // newInternalValidator creates a new internal validator.
@@ -270,6 +431,7 @@ func newInternalValidator(config Config, validationConfig validationConfig) *int
 	} else {
 		noticeContainer = notice.NewNoticeContainer()
 	}
+	noticeContainer.SetRuleOverrides(config.severityOverrides(), config.DisabledRules)
 
 	return &internalValidator{
 		config:           config,
@@ -286,68 +448,75 @@ func newInternalValidatorWithStreaming(config Config, validationConfig validatio
 	} else {
 		noticeContainer = newStreamingNoticeContainer(callback)
 	}
+	noticeContainer.SetRuleOverrides(config.severityOverrides(), config.DisabledRules)
 
-	return &internalValidator{
+	v := &internalValidator{
 		config:           config,
 		validationConfig: validationConfig,
 		noticeContainer:  noticeContainer,
 		noticeCallback:   callback,
 	}
+	noticeContainer.SetListener(func(n notice.Notice) {
+		v.streamMutex.Lock()
+		v.pendingNotices = append(v.pendingNotices, n)
+		v.streamMutex.Unlock()
+	})
+	return v
 }
 
 // ValidateZipWithContext validates a ZIP file with context support.
 func (v *internalValidator) ValidateZipWithContext(ctx context.Context, zipPath string) (*report.ValidationReport, error) {
-	startTime := time.Now()
-
 	// Load the feed
-	loader, err := parser.LoadFromZip(zipPath)
+	loader, err := v.config.loadFeed(zipPath, func() (*parser.FeedLoader, error) {
+		return parser.LoadFromZipWithOptions(zipPath, v.config.archiveOptions())
+	})
 	if err != nil {
+		if errors.Is(err, parser.ErrArchiveLimitExceeded) {
+			return v.archiveLimitReport(err, zipPath, time.Now()), err
+		}
 		return nil, fmt.Errorf("failed to load zip file: %w", err)
 	}
 	defer func() {
 		if err := loader.Close(); err != nil {
-			log.Printf("Warning: failed to close loader: %v", err)
+			loader.Logger().Warn("Failed to close loader", logging.String("feed", zipPath), logging.ErrorField("error", err))
 		}
 	}()
 
-	// Enable caching if configured (Phase 1 optimization)
-	if v.config.EnableCaching {
-		loader.EnableCaching()
-	}
-
-	v.feedLoader = loader
-
-	// Run validation with context
-	feedInfo, err := v.validateWithContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	feedInfo.FeedPath = zipPath
-
-	// Generate report
-	validationTime := time.Since(startTime).Seconds()
-	reportGen := report.NewReportGenerator(v.config.ValidatorVersion)
-	return reportGen.GenerateReport(v.noticeContainer, feedInfo, validationTime), nil
+	return v.ValidateLoaderWithContext(ctx, loader, zipPath)
 }
 
 // ValidateDirectoryWithContext validates a directory with context support.
 func (v *internalValidator) ValidateDirectoryWithContext(ctx context.Context, dirPath string) (*report.ValidationReport, error) {
-	startTime := time.Now()
-
 	// Load the feed
-	loader, err := parser.LoadFromDirectory(dirPath)
+	loader, err := v.config.loadFeed(dirPath, func() (*parser.FeedLoader, error) {
+		return parser.LoadFromDirectory(dirPath)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to load directory: %w", err)
 	}
 	defer func() {
 		if err := loader.Close(); err != nil {
-			log.Printf("Warning: failed to close loader: %v", err)
+			loader.Logger().Warn("Failed to close loader", logging.String("feed", dirPath), logging.ErrorField("error", err))
 		}
 	}()
 
+	return v.ValidateLoaderWithContext(ctx, loader, dirPath)
+}
+
+// ValidateLoaderWithContext validates an already opened feed. The caller owns the loader.
+func (v *internalValidator) ValidateLoaderWithContext(ctx context.Context, loader *parser.FeedLoader, feedPath string) (*report.ValidationReport, error) {
+	startTime := time.Now()
+
 	// Enable caching if configured (Phase 1 optimization)
 	if v.config.EnableCaching {
 		loader.EnableCaching()
+		v.config.observeCache(loader)
+	}
+	if v.config.TranscodeToUTF8 {
+		loader.EnableTranscoding()
+	}
+	if v.config.RecoverMalformedCSV {
+		loader.EnableCSVRecovery()
 	}
 
 	v.feedLoader = loader
@@ -357,12 +526,42 @@ func (v *internalValidator) ValidateDirectoryWithContext(ctx context.Context, di
 	if err != nil {
 		return nil, err
 	}
-	feedInfo.FeedPath = dirPath
+	feedInfo.FeedPath = feedPath
+
+	// Decompression stopped at an archive limit: report what was validated so far
+	if limitErr := loader.LimitError(); limitErr != nil {
+		v.addArchiveLimitNotice(limitErr)
+		return v.generateReport(feedInfo, startTime), limitErr
+	}
+
+	return v.generateReport(feedInfo, startTime), nil
+}
+
+// archiveLimitReport builds a report for an archive rejected before validation.
+func (v *internalValidator) archiveLimitReport(err error, feedPath string, startTime time.Time) *report.ValidationReport {
+	v.addArchiveLimitNotice(err)
+	return v.generateReport(report.FeedInfo{FeedPath: feedPath}, startTime)
+}
+
+// addArchiveLimitNotice records an archive limit error as a notice.
+func (v *internalValidator) addArchiveLimitNotice(err error) {
+	var limitErr *parser.ArchiveLimitError
+	if errors.As(err, &limitErr) {
+		v.noticeContainer.AddNotice(notice.NewArchiveLimitExceededNotice(limitErr.Limit, limitErr.Path, limitErr.Actual, limitErr.Max))
+	}
+}
+
+// generateReport builds the entity index and the validation report from the collected notices.
+func (v *internalValidator) generateReport(feedInfo report.FeedInfo, startTime time.Time) *report.ValidationReport {
+	v.entityIndex = newEntityIndex()
+	v.entityIndex.addNotices(v.noticeContainer.GetNotices())
+	if v.feedLoader != nil {
+		v.entityIndex.loadEntityRelations(v.feedLoader)
+	}
 
-	// Generate report
 	validationTime := time.Since(startTime).Seconds()
 	reportGen := report.NewReportGenerator(v.config.ValidatorVersion)
-	return reportGen.GenerateReport(v.noticeContainer, feedInfo, validationTime), nil
+	return reportGen.GenerateReport(v.noticeContainer, feedInfo, validationTime)
 }
 
 // validateWithContext performs the actual validation with context support.
@@ -438,24 +637,14 @@ func (v *internalValidator) runValidatorsSequential(ctx context.Context, validat
 		}
 
 		// Run validator with error recovery
-		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					// Log the panic but continue with other validators
-					v.noticeContainer.AddNotice(notice.NewValidatorErrorNotice(
-						fmt.Sprintf("%T", validatorImpl),
-						fmt.Sprintf("Validator panic: %v", r),
-					))
-				}
-			}()
-
-			validatorImpl.Validate(v.feedLoader, v.noticeContainer, validatorConfig)
+		v.runValidator(validatorImpl, v.noticeContainer, func(container *notice.NoticeContainer) {
+			validatorImpl.Validate(v.feedLoader, container, validatorConfig)
 
 			// Stream notice groups after each validator if streaming is enabled
 			if v.noticeCallback != nil {
 				v.streamNoticeGroups()
 			}
-		}()
+		})
 	}
 	return nil
 }
@@ -493,27 +682,16 @@ func (v *internalValidator) runValidatorsParallel(ctx context.Context, validator
 				default:
 				}
 
-				// Run validator with error recovery
-				func() {
-					defer func() {
-						if r := recover(); r != nil {
-							// Log the panic but continue with other validators
-							// NoticeContainer is thread-safe
-							v.noticeContainer.AddNotice(notice.NewValidatorErrorNotice(
-								fmt.Sprintf("%T", validatorImpl),
-								fmt.Sprintf("Validator panic: %v", r),
-							))
-						}
-					}()
-
-					validatorImpl.Validate(v.feedLoader, v.noticeContainer, validatorConfig)
+				// Run validator with error recovery (NoticeContainer is thread-safe)
+				v.runValidator(validatorImpl, v.noticeContainer, func(container *notice.NoticeContainer) {
+					validatorImpl.Validate(v.feedLoader, container, validatorConfig)
 
 					// Stream notice groups after each validator if streaming is enabled
 					// Note: In parallel mode, this will stream notices as they become available
 					if v.noticeCallback != nil {
 						v.streamNoticeGroups()
 					}
-				}()
+				})
 
 				// Update progress atomically
 				completedCount := atomic.AddInt64(&completed, 1)
@@ -545,6 +723,43 @@ func (v *internalValidator) runValidatorsParallel(ctx context.Context, validator
 	}
 }
 
+// runValidator runs a validator into container. A panic is reported as a
+// validator_error notice and validation continues with the other validators.
+// The run is reported to the observer, if any.
+func (v *internalValidator) runValidator(validatorImpl interface{}, container *notice.NoticeContainer, run func(container *notice.NoticeContainer)) {
+	name := fmt.Sprintf("%T", validatorImpl)
+	observer := v.config.Observer
+	panicked := false
+
+	if observer != nil {
+		// Count the notices of this validator while they go to the shared container
+		counter := notice.NewCountingNoticeContainer(container)
+		container = counter
+		observer.ValidatorStarted(ValidatorStartEvent{Validator: name})
+		start := time.Now()
+		defer func() {
+			observer.ValidatorFinished(ValidatorEndEvent{
+				Validator: name,
+				Duration:  time.Since(start),
+				Notices:   counter.KeptCount(),
+				Panicked:  panicked,
+			})
+		}()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			if observer != nil {
+				observer.ValidatorPanicked(ValidatorPanicEvent{Validator: name, Value: r, Stack: debug.Stack()})
+			}
+			container.AddNotice(notice.NewValidatorErrorNotice(name, fmt.Sprintf("Validator panic: %v", r)))
+		}
+	}()
+
+	run(container)
+}
+
 // checkRequiredFiles checks for required GTFS files.
 func (v *internalValidator) checkRequiredFiles() {
 	for _, filename := range parser.RequiredFiles {
@@ -602,11 +817,11 @@ func (v *internalValidator) countRowsInFile(filename string) int {
 
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			v.feedLoader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, v.feedLoader.CSVOptions())
 	if err != nil {
 		return 0
 	}
@@ -627,11 +842,11 @@ func (v *internalValidator) extractServiceDates(feedInfo *report.FeedInfo) {
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			v.feedLoader.Logger().Warn("Failed to close reader", logging.String("file", "feed_info.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "feed_info.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "feed_info.txt", v.feedLoader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -659,6 +874,14 @@ func (v *internalValidator) initializeValidators() {
 
 	// Core validators
 	if v.validationConfig.EnableCore {
+		v.validators = append(v.validators, core.NewArchiveLayoutValidator())
+		// Raw file checks read every file once more, so they are skipped in performance mode
+		if v.validationConfig.EnableRawFileChecks {
+			v.validators = append(v.validators,
+				core.NewEncodingValidator(),
+				core.NewStrictCSVValidator(),
+			)
+		}
 		v.validators = append(v.validators,
 			core.NewMissingFilesValidator(),
 			core.NewEmptyFileValidator(),
@@ -775,7 +998,8 @@ func (v *internalValidator) initializeValidators() {
 // For streaming validation, we'll implement a post-validation streaming approach
 // where we stream notice groups after each validator completes.
 
-// streamNoticeGroups converts and streams only new notice groups from the container
+// streamNoticeGroups converts and streams the notices added since the last batch.
+// TotalNotices of each group counts the notices of the batch, not of the whole feed.
 func (v *internalValidator) streamNoticeGroups() {
 	if v.noticeCallback == nil {
 		return
@@ -784,17 +1008,13 @@ func (v *internalValidator) streamNoticeGroups() {
 	v.streamMutex.Lock()
 	defer v.streamMutex.Unlock()
 
-	// Get all notices from the container
-	notices := v.noticeContainer.GetNotices()
-
-	// Only process new notices (those beyond our streamed count)
-	if len(notices) <= v.streamedCount {
+	// Take the notices added since the last batch
+	newNotices := v.pendingNotices
+	v.pendingNotices = nil
+	if len(newNotices) == 0 {
 		return // No new notices to stream
 	}
 
-	newNotices := notices[v.streamedCount:]
-	v.streamedCount = len(notices)
-
 	// Group new notices by code for streaming
 	noticeGroups := make(map[string][]notice.Notice)
 	for _, n := range newNotices {
@@ -810,27 +1030,30 @@ func (v *internalValidator) streamNoticeGroups() {
 
 		// Create sample notices (limit to 5 samples)
 		sampleNotices := make([]map[string]interface{}, 0)
+		sampleLocations := make([]NoticeLocation, 0)
 		sampleLimit := 5
 		for i, n := range groupNotices {
 			if i >= sampleLimit {
 				break
 			}
 			sampleNotices = append(sampleNotices, n.Context())
+			sampleLocations = append(sampleLocations, n.Location())
 		}
 
 		// Create notice group for streaming
-		enhanced := GetEnhancedNoticeDescription(code)
+		enhanced := LocalizedNoticeDescription(code, v.config.Locale)
 		noticeGroup := NoticeGroup{
-			Code:           code,
-			Severity:       groupNotices[0].Severity().String(),
-			Description:    enhanced.Description,
-			GTFSReference:  enhanced.GTFSReference,
-			AffectedFiles:  enhanced.AffectedFiles,
-			AffectedFields: enhanced.AffectedFields,
-			ExampleFix:     enhanced.ExampleFix,
-			Impact:         enhanced.Impact,
-			TotalNotices:   len(groupNotices),
-			SampleNotices:  sampleNotices,
+			Code:            code,
+			Severity:        groupNotices[0].Severity().String(),
+			Description:     enhanced.Description,
+			GTFSReference:   enhanced.GTFSReference,
+			AffectedFiles:   enhanced.AffectedFiles,
+			AffectedFields:  enhanced.AffectedFields,
+			ExampleFix:      enhanced.ExampleFix,
+			Impact:          enhanced.Impact,
+			TotalNotices:    len(groupNotices),
+			SampleNotices:   sampleNotices,
+			SampleLocations: sampleLocations,
 		}
 
 		// Stream the notice group
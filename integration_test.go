@@ -1,10 +1,17 @@
 package gtfsvalidator
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"strings"
 	"sync"
 	"testing"
+	"testing/fstest"
 	"time"
 )
 
@@ -133,6 +140,328 @@ test_agency,Test Transit Agency,https://example.com,America/New_York`
 	}
 }
 
+func TestValidateReader_ValidZip(t *testing.T) {
+	zipPath := CreateTempZip(t, MinimalValidGTFS())
+	file, err := os.Open(zipPath) // #nosec G304 -- test fixture
+	if err != nil {
+		t.Fatalf("Failed to open zip: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	report, err := New().ValidateReader(file)
+	if err != nil {
+		t.Fatalf("ValidateReader failed: %v", err)
+	}
+	if report.Summary.FeedInfo.RouteCount != 1 {
+		t.Errorf("Expected 1 route, got %d", report.Summary.FeedInfo.RouteCount)
+	}
+}
+
+func TestValidateReader_LargeArchiveSpillsToDisk(t *testing.T) {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for name, content := range MinimalValidGTFS() {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to add %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	// A stored entry pushes the archive past the in-memory threshold
+	w, err := zipWriter.CreateHeader(&zip.FileHeader{Name: "padding.bin", Method: zip.Store})
+	if err != nil {
+		t.Fatalf("Failed to add padding: %v", err)
+	}
+	if _, err := w.Write(make([]byte, readerMemoryThreshold)); err != nil {
+		t.Fatalf("Failed to write padding: %v", err)
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatalf("Failed to close zip: %v", err)
+	}
+
+	report, err := New().ValidateReader(&buf)
+	if err != nil {
+		t.Fatalf("ValidateReader failed: %v", err)
+	}
+	if report.Summary.FeedInfo.RouteCount != 1 {
+		t.Errorf("Expected 1 route, got %d", report.Summary.FeedInfo.RouteCount)
+	}
+}
+
+func TestValidateBytes(t *testing.T) {
+	data, err := os.ReadFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil {
+		t.Fatalf("Failed to read zip: %v", err)
+	}
+
+	validator := New()
+	report, err := validator.ValidateBytes(data)
+	if err != nil {
+		t.Fatalf("ValidateBytes failed: %v", err)
+	}
+	if report.Summary.FeedInfo.TripCount != 1 {
+		t.Errorf("Expected 1 trip, got %d", report.Summary.FeedInfo.TripCount)
+	}
+
+	if _, err := validator.ValidateBytes([]byte("not a zip")); err == nil {
+		t.Error("Expected error for invalid zip data")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := validator.ValidateBytesWithContext(ctx, data); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestValidateFS(t *testing.T) {
+	fsys := fstest.MapFS{}
+	for name, content := range MinimalValidGTFS() {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+
+	validator := New()
+	report, err := validator.ValidateFS(fsys)
+	if err != nil {
+		t.Fatalf("ValidateFS failed: %v", err)
+	}
+	if report.Summary.FeedInfo.StopCount != 2 {
+		t.Errorf("Expected 2 stops, got %d", report.Summary.FeedInfo.StopCount)
+	}
+
+	// The same feed validated from disk must produce the same notices
+	fileReport, err := validator.ValidateFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	if report.Summary.Counts != fileReport.Summary.Counts {
+		t.Errorf("Expected identical counts, got %+v vs %+v", report.Summary.Counts, fileReport.Summary.Counts)
+	}
+
+	delete(fsys, "stops.txt")
+	report, err = validator.ValidateFS(fsys)
+	if err != nil {
+		t.Fatalf("ValidateFS failed: %v", err)
+	}
+	if !report.HasErrors() {
+		t.Error("Expected errors for feed without stops.txt")
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	data, err := os.ReadFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil {
+		t.Fatalf("Failed to read zip: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest" {
+			http.Redirect(w, r, "/gtfs.zip", http.StatusMovedPermanently)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	validator := New(WithCacheDir(t.TempDir()))
+	fileReport, err := validator.ValidateFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+
+	for i, notModified := range []bool{false, true} {
+		report, err := validator.ValidateURL(server.URL + "/latest")
+		if err != nil {
+			t.Fatalf("ValidateURL %d failed: %v", i, err)
+		}
+		if report.Summary.Counts != fileReport.Summary.Counts {
+			t.Errorf("Expected the counts of the local feed, got %+v vs %+v", report.Summary.Counts, fileReport.Summary.Counts)
+		}
+
+		feedInfo := report.Summary.FeedInfo
+		if feedInfo.FeedPath != server.URL+"/latest" {
+			t.Errorf("Expected feed path to be the URL, got %s", feedInfo.FeedPath)
+		}
+		download := feedInfo.Download
+		if download == nil {
+			t.Fatal("Expected download info in the report")
+		}
+		if download.FinalURL != server.URL+"/gtfs.zip" || download.ETag != `"v1"` || download.ContentLength != int64(len(data)) {
+			t.Errorf("Unexpected download info: %+v", download)
+		}
+		if download.NotModified != notModified {
+			t.Errorf("ValidateURL %d: expected NotModified=%v", i, notModified)
+		}
+	}
+
+	_, err = New(WithFetchOptions(FetchOptions{MaxSize: 10})).ValidateURL(server.URL + "/gtfs.zip")
+	if !errors.Is(err, ErrDownloadTooLarge) {
+		t.Errorf("Expected ErrDownloadTooLarge, got %v", err)
+	}
+}
+
+func TestValidateFile_ZipWithSubfolder(t *testing.T) {
+	files := make(map[string]string)
+	for name, content := range MinimalValidGTFS() {
+		files["feed/"+name] = content
+	}
+	zipPath := CreateTempZip(t, files)
+
+	report, err := New().ValidateFile(zipPath)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+	if countNotices(report, "archive_file_in_subfolder") != len(files) {
+		t.Errorf("Expected %d archive_file_in_subfolder notices, got %d", len(files), countNotices(report, "archive_file_in_subfolder"))
+	}
+	if countNotices(report, "missing_required_file") != 0 {
+		t.Error("Expected subfolder files to be used for validation by default")
+	}
+
+	report, err = New(WithIgnoreSubfolderFiles(true)).ValidateFile(zipPath)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+	if countNotices(report, "missing_required_file") == 0 {
+		t.Error("Expected missing required files when subfolder files are ignored")
+	}
+}
+
+func TestValidateFile_NonUTF8Encoding(t *testing.T) {
+	files := MinimalValidGTFS()
+	files["stops.txt"] = "stop_id,stop_name,stop_lat,stop_lon\nstop_1,Caf\xe9 \x93Central\x94,40.7128,-74.0060\nstop_2,Gr\xfcnplatz,40.7589,-73.9851"
+	zipPath := CreateTempZip(t, files)
+
+	tests := []struct {
+		name       string
+		options    []Option
+		transcoded bool
+	}{
+		{name: "reported without transcoding"},
+		{name: "reported with transcoding", options: []Option{WithTranscoding(true)}, transcoded: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := New(tt.options...).ValidateFile(zipPath)
+			if err != nil {
+				t.Fatalf("Validation failed: %v", err)
+			}
+			if countNotices(report, "non_utf8_encoding") != 1 {
+				t.Fatalf("Expected 1 non_utf8_encoding notice, got %d", countNotices(report, "non_utf8_encoding"))
+			}
+			for _, group := range report.Notices {
+				if group.Code != "non_utf8_encoding" {
+					continue
+				}
+				sample := group.SampleNotices[0]
+				if sample["encoding"] != "Windows-1252" || sample["transcoded"] != tt.transcoded {
+					t.Errorf("Unexpected notice context: %v", sample)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFile_MalformedRowRecovery(t *testing.T) {
+	files := MinimalValidGTFS()
+	files["stop_times.txt"] = `trip_id,arrival_time,departure_time,stop_id,stop_sequence
+trip_1,08:00:00,08:00:00,"stop_1,1
+trip_1,08:15:00,08:15:00,stop_2,2
+trip_1,08:30:00,08:30:00,no_such_stop,3`
+
+	report, err := New(WithCSVRecovery(true)).ValidateFile(CreateTempZip(t, files))
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+	if countNotices(report, "csv_parsing_failed") != 1 {
+		t.Errorf("Expected 1 csv_parsing_failed notice, got %d", countNotices(report, "csv_parsing_failed"))
+	}
+	// Rows after the malformed one are still validated
+	if countNotices(report, "foreign_key_violation") == 0 {
+		t.Error("Expected a foreign_key_violation for the row after the malformed one")
+	}
+	for _, group := range report.Notices {
+		if group.Code == "csv_parsing_failed" {
+			location := group.SampleLocations[0]
+			if location.File != "stop_times.txt" || location.RowNumber != 2 {
+				t.Errorf("Unexpected location: %+v", location)
+			}
+		}
+	}
+}
+
+// countNotices returns the number of notices with the given code
+func countNotices(report *ValidationReport, code string) int {
+	for _, group := range report.Notices {
+		if group.Code == code {
+			return group.TotalNotices
+		}
+	}
+	return 0
+}
+
+func TestValidateFile_ArchiveLimits(t *testing.T) {
+	zipPath := CreateTempZip(t, MinimalValidGTFS())
+	data, err := os.ReadFile(zipPath) // #nosec G304 -- test fixture
+	if err != nil {
+		t.Fatalf("Failed to read zip: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		limits        ArchiveLimits
+		validate      func(v Validator) (*ValidationReport, error)
+		expectedLimit string
+	}{
+		{
+			name:          "file too large",
+			limits:        ArchiveLimits{MaxFileSize: 64},
+			validate:      func(v Validator) (*ValidationReport, error) { return v.ValidateFile(zipPath) },
+			expectedLimit: "file_size",
+		},
+		{
+			name:          "too many entries",
+			limits:        ArchiveLimits{MaxEntries: 2},
+			validate:      func(v Validator) (*ValidationReport, error) { return v.ValidateBytes(data) },
+			expectedLimit: "entries",
+		},
+		{
+			name:          "reader larger than archive size limit",
+			limits:        ArchiveLimits{MaxArchiveSize: 100},
+			validate:      func(v Validator) (*ValidationReport, error) { return v.ValidateReader(bytes.NewReader(data)) },
+			expectedLimit: "archive_size",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := tt.validate(New(WithArchiveLimits(tt.limits)))
+			if !errors.Is(err, ErrArchiveLimitExceeded) {
+				t.Fatalf("Expected ErrArchiveLimitExceeded, got %v", err)
+			}
+			var limitErr *ArchiveLimitError
+			if !errors.As(err, &limitErr) || limitErr.Limit != tt.expectedLimit {
+				t.Errorf("Expected %s limit, got %v", tt.expectedLimit, err)
+			}
+			if report == nil || countNotices(report, "archive_limit_exceeded") != 1 {
+				t.Errorf("Expected a report with an archive_limit_exceeded notice, got %+v", report)
+			}
+		})
+	}
+
+	if _, err := New().ValidateFile(zipPath); err != nil {
+		t.Errorf("Expected default limits to accept a normal feed, got %v", err)
+	}
+}
+
 func TestValidateFile_NonExistentPath(t *testing.T) {
 	validator := New()
 
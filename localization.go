@@ -0,0 +1,246 @@
+package gtfsvalidator
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// DefaultLocale is the locale of reports validated without WithLocale.
+const DefaultLocale = "en"
+
+// localeMessages is the content of a locales/<tag>.json file. Notices and
+// severities are keyed by notice code and severity level; entries or fields
+// missing from a locale fall back to English. Messages holds the text of the
+// HTML report keyed by its English format string, either as a string or as an
+// object of CLDR plural forms ("one", "other", ...) selected by the first argument.
+type localeMessages struct {
+	Severities map[string]SeverityInfo      `json:"severities"`
+	Notices    map[string]NoticeDescription `json:"notices"`
+	Messages   map[string]json.RawMessage   `json:"messages"`
+}
+
+// messageCatalog holds the bundled locales.
+type messageCatalog struct {
+	tags     []language.Tag
+	matcher  language.Matcher
+	builder  *catalog.Builder
+	messages map[language.Tag]localeMessages
+}
+
+// pluralForms maps the plural form names of locale files to CLDR plural forms.
+var pluralForms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// bundledCatalog is loaded from the embedded locale files.
+var bundledCatalog = mustLoadMessageCatalog()
+
+func mustLoadMessageCatalog() *messageCatalog {
+	c, err := loadMessageCatalog()
+	if err != nil {
+		panic(fmt.Sprintf("gtfsvalidator: invalid bundled locale: %v", err))
+	}
+	return c
+}
+
+// loadMessageCatalog reads every embedded locale file, English first so that
+// it is the fallback of the language matcher.
+func loadMessageCatalog() (*messageCatalog, error) {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTag := language.MustParse(DefaultLocale)
+	c := &messageCatalog{
+		tags:     []language.Tag{defaultTag},
+		builder:  catalog.NewBuilder(catalog.Fallback(defaultTag)),
+		messages: make(map[language.Tag]localeMessages),
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), ".json")
+		tag, err := language.Parse(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		data, err := localeFS.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var messages localeMessages
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		for key, raw := range messages.Messages {
+			if err := c.setMessage(tag, key, raw); err != nil {
+				return nil, fmt.Errorf("%s: message %q: %w", file.Name(), key, err)
+			}
+		}
+		c.messages[tag] = messages
+		if tag != defaultTag {
+			c.tags = append(c.tags, tag)
+		}
+	}
+	c.matcher = language.NewMatcher(c.tags)
+	return c, nil
+}
+
+// setMessage adds a plain or pluralized message to the catalog builder.
+func (c *messageCatalog) setMessage(tag language.Tag, key string, raw json.RawMessage) error {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return c.builder.SetString(tag, key, text)
+	}
+
+	var forms map[string]string
+	if err := json.Unmarshal(raw, &forms); err != nil {
+		return fmt.Errorf("expected a string or an object of plural forms")
+	}
+	names := make([]string, 0, len(forms))
+	for name := range forms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	cases := make([]interface{}, 0, 2*len(forms))
+	for _, name := range names {
+		form, ok := pluralForms[name]
+		if !ok {
+			return fmt.Errorf("unknown plural form %q", name)
+		}
+		cases = append(cases, form, forms[name])
+	}
+	return c.builder.Set(tag, key, plural.Selectf(1, "%d", cases...))
+}
+
+// match returns the bundled locale closest to a BCP 47 tag, or English.
+func (c *messageCatalog) match(locale string) language.Tag {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return c.tags[0]
+	}
+	_, index, confidence := c.matcher.Match(tag)
+	if confidence == language.No {
+		return c.tags[0]
+	}
+	return c.tags[index]
+}
+
+// printer returns a printer formatting catalog messages and numbers for a bundled locale.
+func (c *messageCatalog) printer(tag language.Tag) *message.Printer {
+	return message.NewPrinter(tag, message.Catalog(c.builder))
+}
+
+// SupportedLocales returns the bundled locales, English first.
+func SupportedLocales() []string {
+	locales := make([]string, len(bundledCatalog.tags))
+	for i, tag := range bundledCatalog.tags {
+		locales[i] = tag.String()
+	}
+	return locales
+}
+
+// MatchLocale returns the bundled locale used for a BCP 47 language tag, such
+// as "fr" for "fr-CA". Tags without a close bundled locale and invalid tags
+// fall back to DefaultLocale.
+func MatchLocale(locale string) string {
+	return bundledCatalog.match(locale).String()
+}
+
+// LocalizedNoticeDescription returns GetEnhancedNoticeDescription with the
+// description, impact and example fix translated to a locale. Untranslated
+// codes and fields are returned in English.
+func LocalizedNoticeDescription(code, locale string) NoticeDescription {
+	tag := bundledCatalog.match(locale)
+	description := GetEnhancedNoticeDescription(code)
+	translated, exists := bundledCatalog.messages[tag].Notices[code]
+	if !exists {
+		if _, known := noticeDescriptions[code]; !known {
+			// Codes without a description get a generic one built from their name
+			p := bundledCatalog.printer(tag)
+			description.Description = p.Sprintf(genericNoticeDescription, noticeCodeTitle(code))
+			description.Impact = p.Sprintf(genericNoticeImpact)
+		}
+		return description
+	}
+	if translated.Description != "" {
+		description.Description = translated.Description
+	}
+	if translated.Impact != "" {
+		description.Impact = translated.Impact
+	}
+	if translated.ExampleFix != "" {
+		description.ExampleFix = translated.ExampleFix
+	}
+	return description
+}
+
+// LocalizedSeverityInfo returns GetSeverityInfo translated to a locale,
+// including the severity label in Level.
+func LocalizedSeverityInfo(severity, locale string) SeverityInfo {
+	info := GetSeverityInfo(severity)
+	translated, exists := bundledCatalog.messages[bundledCatalog.match(locale)].Severities[strings.ToUpper(severity)]
+	if !exists {
+		return info
+	}
+	if translated.Level != "" {
+		info.Level = translated.Level
+	}
+	if translated.Description != "" {
+		info.Description = translated.Description
+	}
+	if translated.Impact != "" {
+		info.Impact = translated.Impact
+	}
+	if translated.Urgency != "" {
+		info.Urgency = translated.Urgency
+	}
+	return info
+}
+
+// Localized returns a copy of the report with notice descriptions, impacts and
+// example fixes in another locale. Summary.Locale is set to the bundled locale used.
+func (r *ValidationReport) Localized(locale string) *ValidationReport {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	localized := &ValidationReport{
+		SchemaVersion: r.SchemaVersion,
+		Summary:       r.Summary,
+		Notices:       make([]NoticeGroup, len(r.Notices)),
+		Entities:      r.Entities,
+		entityIndex:   r.entityIndex,
+	}
+	localized.Summary.Locale = MatchLocale(locale)
+	for i, group := range r.Notices {
+		_, described := noticeDescriptions[group.Code]
+		if _, registered := notice.LookupRule(group.Code); !described && !registered {
+			// Keep the stored description of codes unknown to this version
+			localized.Notices[i] = group
+			continue
+		}
+		description := LocalizedNoticeDescription(group.Code, localized.Summary.Locale)
+		group.Description = description.Description
+		group.Impact = description.Impact
+		group.ExampleFix = description.ExampleFix
+		localized.Notices[i] = group
+	}
+	return localized
+}
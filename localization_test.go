@@ -0,0 +1,151 @@
+package gtfsvalidator
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"golang.org/x/text/language"
+)
+
+func TestMatchLocale(t *testing.T) {
+	tests := map[string]string{
+		"":      "en",
+		"en-GB": "en",
+		"fr":    "fr",
+		"fr-CA": "fr",
+		"ja":    "en",
+		"not a": "en",
+	}
+	for locale, expected := range tests {
+		if got := MatchLocale(locale); got != expected {
+			t.Errorf("MatchLocale(%q) = %q, expected %q", locale, got, expected)
+		}
+	}
+
+	if locales := SupportedLocales(); len(locales) < 2 || locales[0] != DefaultLocale {
+		t.Errorf("expected English first among several locales, got %v", locales)
+	}
+}
+
+// TestBundledLocales_Complete checks that every bundled locale translates all
+// described notice codes of the registry and only those, the severities and the
+// messages of the HTML report, with the same format verbs as the English text.
+func TestBundledLocales_Complete(t *testing.T) {
+	template, err := templateFS.ReadFile("templates/report.html")
+	if err != nil {
+		t.Fatalf("Failed to read template: %v", err)
+	}
+	keys := []string{genericNoticeDescription, genericNoticeImpact, generatedAtLayout, "🏢 Notices by Agency", "🚌 Notices by Route"}
+	for _, match := range regexp.MustCompile(`\.T "([^"]+)"`).FindAllStringSubmatch(string(template), -1) {
+		keys = append(keys, match[1])
+	}
+
+	verbs := regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+	for tag, messages := range bundledCatalog.messages {
+		if tag == language.English {
+			continue
+		}
+		for code := range noticeDescriptions {
+			if _, registered := notice.LookupRule(code); !registered {
+				// Legacy codes keep their English description for stored reports
+				continue
+			}
+			translated := messages.Notices[code]
+			if translated.Description == "" || translated.Impact == "" || translated.ExampleFix == "" {
+				t.Errorf("%s: incomplete translation of %s", tag, code)
+			}
+		}
+		for code := range messages.Notices {
+			if _, exists := noticeDescriptions[code]; !exists {
+				t.Errorf("%s: translation of undescribed code %s", tag, code)
+			}
+			if _, registered := notice.LookupRule(code); !registered {
+				t.Errorf("%s: translation of code %s missing from notice.Rules()", tag, code)
+			}
+		}
+		for _, severity := range []string{"ERROR", "WARNING", "INFO"} {
+			if messages.Severities[severity].Level == "" {
+				t.Errorf("%s: missing severity %s", tag, severity)
+			}
+		}
+		for _, key := range keys {
+			raw, exists := messages.Messages[key]
+			if !exists {
+				t.Errorf("%s: missing message %q", tag, key)
+				continue
+			}
+			expected := verbs.FindAllString(key, -1)
+			for _, text := range regexp.MustCompile(`"((?:[^"\\]|\\.)*)"\s*[,}]`).FindAllStringSubmatch(string(raw), -1) {
+				if got := verbs.FindAllString(text[1], -1); strings.Join(got, " ") != strings.Join(expected, " ") {
+					t.Errorf("%s: message %q uses verbs %v, expected %v", tag, key, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestLocalizedNoticeDescription(t *testing.T) {
+	english := GetEnhancedNoticeDescription("duplicate_key")
+	french := LocalizedNoticeDescription("duplicate_key", "fr-BE")
+	if french.Description == english.Description || !strings.Contains(french.Description, "clé primaire") {
+		t.Errorf("expected a French description, got %q", french.Description)
+	}
+	if french.GTFSReference != english.GTFSReference {
+		t.Errorf("expected the GTFS reference to be kept, got %q", french.GTFSReference)
+	}
+	if got := LocalizedNoticeDescription("duplicate_key", "de"); got.Description != english.Description {
+		t.Errorf("expected English for an unsupported locale, got %q", got.Description)
+	}
+
+	generic := LocalizedNoticeDescription("some_custom_check", "fr")
+	if generic.Description != "Some Custom Check. Ce contrôle de validation a détecté un problème à examiner et à corriger." {
+		t.Errorf("unexpected generic description: %q", generic.Description)
+	}
+
+	severity := LocalizedSeverityInfo("warning", "fr")
+	if severity.Level != "AVERTISSEMENT" || severity.Urgency == GetSeverityInfo("WARNING").Urgency {
+		t.Errorf("expected a French severity, got %+v", severity)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	files := MinimalValidGTFS()
+	files["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,40.0,-74.0
+stop_1,Duplicate Stop,40.1,-74.1`
+
+	report, err := New(WithLocale("fr-CA")).ValidateFile(CreateTempZip(t, files))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	if report.Summary.Locale != "fr" {
+		t.Errorf("expected locale fr, got %q", report.Summary.Locale)
+	}
+	codes := make([]string, 0, len(report.Notices))
+	for _, group := range report.Notices {
+		codes = append(codes, group.Code)
+		if group.Code == "duplicate_key" && group.Description != LocalizedNoticeDescription("duplicate_key", "fr").Description {
+			t.Errorf("expected a French description, got %q", group.Description)
+		}
+	}
+	sort.Strings(codes)
+	if sort.SearchStrings(codes, "duplicate_key") == len(codes) {
+		t.Fatalf("expected a duplicate_key notice, got %v", codes)
+	}
+
+	english := report.Localized("en")
+	if english.Summary.Locale != "en" || report.Summary.Locale != "fr" {
+		t.Errorf("expected a localized copy, got locales %q and %q", english.Summary.Locale, report.Summary.Locale)
+	}
+	for _, group := range english.Notices {
+		if group.Description != GetEnhancedNoticeDescription(group.Code).Description {
+			t.Errorf("expected the English description of %s, got %q", group.Code, group.Description)
+		}
+	}
+	if len(english.NoticesForStop("stop_1")) == 0 {
+		t.Error("expected the localized copy to keep the entity index")
+	}
+}
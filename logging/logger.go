@@ -26,6 +26,22 @@ const (
 	ERROR
 )
 
+// ParseLevel parses a log level name (debug, info, warn or error) case-insensitively
+func ParseLevel(name string) (LogLevel, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "DEBUG":
+		return DEBUG, nil
+	case "INFO":
+		return INFO, nil
+	case "WARN", "WARNING":
+		return WARN, nil
+	case "ERROR":
+		return ERROR, nil
+	default:
+		return INFO, fmt.Errorf("invalid log level %q: expected debug, info, warn or error", name)
+	}
+}
+
 // String returns the string representation of a log level
 func (l LogLevel) String() string {
 	switch l {
@@ -149,6 +165,12 @@ type standardLogger struct {
 	formatter Formatter
 	level     LogLevel
 	fields    map[string]interface{}
+	writes    *writeLock // created on first write, shared with the loggers created by With
+}
+
+// writeLock serializes the writes of a logger and of the loggers created by its With
+type writeLock struct {
+	sync.Mutex
 }
 
 // NewLogger creates a new logger with default configuration
@@ -171,6 +193,18 @@ func NewJSONLogger() Logger {
 	}
 }
 
+// NewStderrLogger creates a text logger without colors that writes warnings
+// and errors to stderr, like the standard library logger. It is used where
+// no logger was configured.
+func NewStderrLogger() Logger {
+	return &standardLogger{
+		writer:    os.Stderr,
+		formatter: &TextFormatter{DisableColors: true},
+		level:     WARN,
+		fields:    make(map[string]interface{}),
+	}
+}
+
 // NewLoggerWithWriter creates a logger with custom writer
 func NewLoggerWithWriter(writer io.Writer) Logger {
 	return &standardLogger{
@@ -181,6 +215,16 @@ func NewLoggerWithWriter(writer io.Writer) Logger {
 	}
 }
 
+// NewLoggerWithFormatter creates a logger with a custom writer and formatter
+func NewLoggerWithFormatter(writer io.Writer, formatter Formatter) Logger {
+	return &standardLogger{
+		writer:    writer,
+		formatter: formatter,
+		level:     INFO,
+		fields:    make(map[string]interface{}),
+	}
+}
+
 // Debug logs a debug message
 func (l *standardLogger) Debug(msg string, fields ...Field) {
 	l.log(DEBUG, msg, fields...)
@@ -239,6 +283,7 @@ func (l *standardLogger) With(fields ...Field) Logger {
 		formatter: l.formatter,
 		level:     l.level,
 		fields:    newFields,
+		writes:    l.writeLock(),
 	}
 }
 
@@ -247,6 +292,17 @@ func (l *standardLogger) WithField(key string, value interface{}) Logger {
 	return l.With(Field{Key: key, Value: value})
 }
 
+// writeLock returns the lock shared with the loggers created by With,
+// creating it for loggers that were not built by a constructor
+func (l *standardLogger) writeLock() *writeLock {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.writes == nil {
+		l.writes = &writeLock{}
+	}
+	return l.writes
+}
+
 // SetLevel sets the minimum log level
 func (l *standardLogger) SetLevel(level LogLevel) {
 	l.mutex.Lock()
@@ -291,9 +347,14 @@ func (l *standardLogger) log(level LogLevel, msg string, fields ...Field) {
 	}
 
 	l.mutex.RLock()
-	_, err = l.writer.Write(data)
+	writer := l.writer
 	l.mutex.RUnlock()
 
+	writes := l.writeLock()
+	writes.Lock()
+	_, err = writer.Write(data)
+	writes.Unlock()
+
 	if err != nil {
 		// Fallback to standard library logger
 		log.Printf("Logger write error: %v, original message: %s", err, msg)
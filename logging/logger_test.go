@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -265,3 +266,54 @@ func TestErrorField(t *testing.T) {
 		t.Error("Error field should contain error string")
 	}
 }
+
+func TestLogger_ConcurrentWrites(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter(&buf)
+	derived := logger.WithField("component", "test")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			logger.Info("from logger")
+		}()
+		go func() {
+			defer wg.Done()
+			derived.Info("from derived logger")
+		}()
+	}
+	wg.Wait()
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 20 {
+		t.Errorf("Expected 20 log lines, got %d", lines)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected LogLevel
+		wantErr  bool
+	}{
+		{"debug", DEBUG, false},
+		{"INFO", INFO, false},
+		{" warn ", WARN, false},
+		{"warning", WARN, false},
+		{"Error", ERROR, false},
+		{"verbose", INFO, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, err := ParseLevel(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if level != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, level)
+			}
+		})
+	}
+}
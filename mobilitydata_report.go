@@ -0,0 +1,313 @@
+package gtfsvalidator
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// MobilityDataReport is a validation report in the report.json layout of the
+// MobilityData canonical GTFS validator. Notices with a canonical counterpart
+// use the canonical code and context keys; the others are listed separately in
+// UnmappedNotices with this validator's codes, so that tools reading Notices
+// only see codes they know.
+type MobilityDataReport struct {
+	Summary MobilityDataSummary  `json:"summary"`
+	Notices []MobilityDataNotice `json:"notices"`
+
+	// UnmappedNotices contains the notice groups without a canonical counterpart.
+	UnmappedNotices []MobilityDataNotice `json:"unmappedNotices,omitempty"`
+}
+
+// MobilityDataSummary is the summary section of a MobilityDataReport.
+type MobilityDataSummary struct {
+	ValidatorVersion string             `json:"validatorVersion"`
+	ValidatedAt      string             `json:"validatedAt"`
+	GtfsInput        string             `json:"gtfsInput"`
+	Counts           MobilityDataCounts `json:"counts"`
+}
+
+// MobilityDataCounts holds the entity counts of the validated feed, keyed as
+// in the canonical report.
+type MobilityDataCounts struct {
+	Agencies int `json:"Agencies"`
+	Routes   int `json:"Routes"`
+	Trips    int `json:"Trips"`
+	Stops    int `json:"Stops"`
+}
+
+// MobilityDataNotice is a group of notices with the same code.
+type MobilityDataNotice struct {
+	Code          string                   `json:"code"`
+	Severity      string                   `json:"severity"`
+	TotalNotices  int                      `json:"totalNotices"`
+	SampleNotices []map[string]interface{} `json:"sampleNotices"`
+}
+
+// mobilityDataMapping maps a notice code to its canonical counterpart.
+type mobilityDataMapping struct {
+	// code is the canonical notice code
+	code string
+	// fields maps context keys to canonical keys; keys not listed are dropped
+	fields map[string]string
+	// constants are canonical keys with a fixed value for this notice code
+	constants map[string]interface{}
+}
+
+// mobilityDataMappings lists the notice codes with a canonical counterpart.
+// Several codes may map to the same canonical code.
+var mobilityDataMappings = map[string]mobilityDataMapping{
+	"attribution_without_role": {code: "attribution_without_role",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "attributionId": "attributionId"}},
+	"block_trips_overlap": {code: "block_trips_with_overlapping_stop_times",
+		fields: map[string]string{"blockId": "blockId", "trip1RowNumber": "csvRowNumberA", "trip1Id": "tripIdA", "service1Id": "serviceIdA",
+			"trip2RowNumber": "csvRowNumberB", "trip2Id": "tripIdB", "service2Id": "serviceIdB"}},
+	"calendar_end_before_start": {code: "start_and_end_range_out_of_order",
+		fields:    map[string]string{"csvRowNumber": "csvRowNumber", "serviceId": "entityId", "startDate": "startValue", "endDate": "endValue"},
+		constants: map[string]interface{}{"filename": "calendar.txt", "startFieldName": "start_date", "endFieldName": "end_date"}},
+	"decreasing_or_equal_shape_distance": {code: "decreasing_or_equal_shape_distance",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "shapeId": "shapeId", "shapePtSequence": "shapePtSequence", "shapeDistTraveled": "shapeDistTraveled",
+			"prevCsvRowNumber": "prevCsvRowNumber", "prevShapeDistTraveled": "prevShapeDistTraveled", "prevShapePtSequence": "prevShapePtSequence"}},
+	"decreasing_or_equal_stop_time_distance": {code: "decreasing_or_equal_stop_time_distance",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId", "stopId": "stopId", "stopSequence": "stopSequence", "shapeDistTraveled": "shapeDistTraveled",
+			"prevCsvRowNumber": "prevCsvRowNumber", "prevShapeDistTraveled": "prevShapeDistTraveled", "prevStopSequence": "prevStopSequence"}},
+	"duplicate_composite_key": {code: "duplicate_key",
+		fields: map[string]string{"filename": "filename", "firstRow": "oldCsvRowNumber", "duplicateRow": "newCsvRowNumber"}},
+	"duplicate_header": {code: "duplicated_column",
+		fields: map[string]string{"filename": "filename", "headerName": "fieldName"}},
+	"duplicate_key": {code: "duplicate_key",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "oldCsvRowNumber", "duplicateRow": "newCsvRowNumber", "fieldName": "fieldName1", "fieldValue": "fieldValue1"}},
+	"duplicate_route_name_combination": {code: "duplicate_route_name",
+		fields: map[string]string{"firstRouteId": "routeId1", "csvRowNumber": "csvRowNumber2", "routeId": "routeId2", "routeShortName": "routeShortName",
+			"routeLongName": "routeLongName", "routeType": "routeTypeValue", "agencyId": "agencyId"}},
+	"empty_file": {code: "empty_file",
+		fields: map[string]string{"filename": "filename"}},
+	"expired_service": {code: "expired_calendar",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "serviceId": "serviceId"}},
+	"feed_expiration_date_7_days": {code: "feed_expiration_date7_days",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "currentDate": "currentDate", "feedEndDate": "feedEndDate", "suggestedExpirationDate": "suggestedExpirationDate"}},
+	"feed_expiration_date_30_days": {code: "feed_expiration_date30_days",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "currentDate": "currentDate", "feedEndDate": "feedEndDate", "suggestedExpirationDate": "suggestedExpirationDate"}},
+	"feed_info_end_date_before_start_date": {code: "start_and_end_range_out_of_order",
+		fields:    map[string]string{"csvRowNumber": "csvRowNumber", "startDate": "startValue", "endDate": "endValue"},
+		constants: map[string]interface{}{"filename": "feed_info.txt", "startFieldName": "feed_start_date", "endFieldName": "feed_end_date"}},
+	"feed_info_end_date_missing": {code: "missing_feed_info_date",
+		fields:    map[string]string{},
+		constants: map[string]interface{}{"fieldName": "feed_end_date"}},
+	"foreign_key_violation": {code: "foreign_key_violation",
+		fields: map[string]string{"filename": "childFilename", "fieldName": "childFieldName", "referencedTable": "parentFilename", "referencedField": "parentFieldName",
+			"fieldValue": "fieldValue", "csvRowNumber": "csvRowNumber"}},
+	"invalid_bikes_allowed_value": enumMapping("trips.txt", "bikes_allowed", "csvRowNumber", "bikesAllowed"),
+	"invalid_color": {code: "invalid_color",
+		fields:    map[string]string{"csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "colorValue": "fieldValue"},
+		constants: map[string]interface{}{"filename": "routes.txt"}},
+	"invalid_currency_code": {code: "invalid_currency",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "currencyCode": "fieldValue"}},
+	"invalid_date_format": {code: "invalid_date",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "dateValue": "fieldValue"}},
+	"invalid_direction_id":   enumMapping("trips.txt", "direction_id", "rowNumber", "directionId"),
+	"invalid_email":          fieldValueMapping("invalid_email"),
+	"invalid_exact_times":    enumMapping("frequencies.txt", "exact_times", "csvRowNumber", "exactTimes"),
+	"invalid_exception_type": enumMapping("calendar_dates.txt", "exception_type", "csvRowNumber", "exceptionType"),
+	"invalid_language_code": {code: "invalid_language_code",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "languageCode": "fieldValue"}},
+	"invalid_latitude":      rangeMapping("stops.txt", "stop_lat", "float", "rowNumber", "latitude"),
+	"invalid_location_type": enumMapping("stops.txt", "location_type", "csvRowNumber", "locationType"),
+	"invalid_longitude":     rangeMapping("stops.txt", "stop_lon", "float", "rowNumber", "longitude"),
+	"invalid_parent_station_type": {code: "wrong_parent_location_type",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stopId": "stopId", "parentStation": "parentStation", "parentLocationType": "parentLocationType"}},
+	"invalid_pathway_mode":   enumMapping("pathways.txt", "pathway_mode", "csvRowNumber", "pathwayMode"),
+	"invalid_payment_method": enumMapping("fare_attributes.txt", "payment_method", "csvRowNumber", "paymentMethod"),
+	"invalid_route_type":     enumMapping("routes.txt", "route_type", "csvRowNumber", "routeType"),
+	"invalid_time_format": {code: "invalid_time",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "timeValue": "fieldValue"}},
+	"invalid_timepoint":             enumMapping("stop_times.txt", "timepoint", "csvRowNumber", "timepoint"),
+	"invalid_timezone":              fieldValueMapping("invalid_timezone"),
+	"invalid_transfer_type":         enumMapping("transfers.txt", "transfer_type", "csvRowNumber", "transferType"),
+	"invalid_url":                   fieldValueMapping("invalid_url"),
+	"invalid_wheelchair_accessible": enumMapping("trips.txt", "wheelchair_accessible", "rowNumber", "wheelchairAccessible"),
+	"invalid_wheelchair_boarding":   enumMapping("stops.txt", "wheelchair_boarding", "rowNumber", "wheelchairBoarding"),
+	"leading_whitespace": {code: "leading_or_trailing_whitespaces",
+		fields: map[string]string{"filename": "filename", "rowNumber": "csvRowNumber", "fieldName": "fieldName", "fieldValue": "fieldValue"}},
+	"missing_calendar_and_calendar_date_files": {code: "missing_calendar_and_calendar_date_files",
+		fields: map[string]string{}},
+	"missing_feed_info": {code: "missing_recommended_file",
+		fields:    map[string]string{},
+		constants: map[string]interface{}{"filename": "feed_info.txt"}},
+	"missing_parent_station": {code: "location_without_parent_station",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stopId": "stopId", "locationType": "locationType"}},
+	"missing_recommended_field": {code: "missing_recommended_field",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName"}},
+	"missing_required_column": {code: "missing_required_column",
+		fields: map[string]string{"filename": "filename", "columnName": "fieldName"}},
+	"missing_required_field": {code: "missing_required_field",
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName"}},
+	"missing_required_file": {code: "missing_required_file",
+		fields: map[string]string{"filename": "filename"}},
+	"missing_required_stop_name": {code: "missing_stop_name",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stopId": "stopId", "locationType": "locationType"}},
+	"missing_route_name": {code: "route_both_short_and_long_name_missing",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "routeId": "routeId"}},
+	"missing_trip_first_time": {code: "missing_trip_edge",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId"}},
+	"missing_trip_last_time": {code: "missing_trip_edge",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId"}},
+	"multiple_records_in_single_record_file": {code: "more_than_one_entity",
+		fields: map[string]string{"filename": "filename", "recordCount": "entityCount"}},
+	"negative_min_transfer_time": rangeMapping("transfers.txt", "min_transfer_time", "integer", "csvRowNumber", "minTransferTime"),
+	"negative_shape_distance":    rangeMapping("stop_times.txt", "shape_dist_traveled", "float", "rowNumber", "shapeDistance"),
+	"negative_shape_sequence":    rangeMapping("shapes.txt", "shape_pt_sequence", "integer", "rowNumber", "sequence"),
+	"negative_stop_sequence":     rangeMapping("stop_times.txt", "stop_sequence", "integer", "rowNumber", "stopSequence"),
+	"orphaned_station": {code: "unused_station",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stationId": "stopId"}},
+	"overlapping_frequency": {code: "overlapping_frequency",
+		fields: map[string]string{"rowNumber1": "prevCsvRowNumber", "endTime1": "prevEndTime", "rowNumber2": "currCsvRowNumber", "startTime2": "currStartTime", "tripId": "tripId"}},
+	"pathway_to_same_stop": {code: "pathway_loop",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "pathwayId": "pathwayId", "stopId": "stopId"}},
+	"route_color_contrast": {code: "route_color_contrast",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "routeId": "routeId", "routeColor": "routeColor", "routeTextColor": "routeTextColor"}},
+	"route_short_name_too_long": {code: "route_short_name_too_long",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "routeId": "routeId", "routeShortName": "routeShortName"}},
+	"same_name_and_description": {code: "same_name_and_description_for_route",
+		fields:    map[string]string{"csvRowNumber": "csvRowNumber", "routeId": "routeId", "fieldValue": "routeDesc", "fieldName1": "specifiedField"},
+		constants: map[string]interface{}{"filename": "routes.txt"}},
+	"station_with_parent_station": {code: "station_with_parent_station",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stopId": "stopId", "parentStation": "parentStation"}},
+	"stop_name_description_duplicate": {code: "same_name_and_description_for_stop",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "stopId": "stopId", "stopName": "stopDesc"}},
+	"stop_time_arrival_after_departure": {code: "stop_time_with_departure_before_arrival_time",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId", "stopSequence": "stopSequence", "departureTime": "departureTime", "arrivalTime": "arrivalTime"}},
+	"stop_time_decreasing_time": {code: "stop_time_with_arrival_before_previous_departure_time",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "prevCsvRowNumber": "prevCsvRowNumber", "tripId": "tripId", "prevDepartureTime": "departureTime", "arrivalTime": "arrivalTime"}},
+	"timepoint_without_times": {code: "stop_time_timepoint_without_times",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId", "stopSequence": "stopSequence"}},
+	"trailing_whitespace": {code: "leading_or_trailing_whitespaces",
+		fields: map[string]string{"filename": "filename", "rowNumber": "csvRowNumber", "fieldName": "fieldName", "fieldValue": "fieldValue"}},
+	"trip_usability": {code: "unusable_trip",
+		fields: map[string]string{"csvRowNumber": "csvRowNumber", "tripId": "tripId"}},
+	"unknown_column": {code: "unknown_column",
+		fields: map[string]string{"filename": "filename", "columnName": "fieldName", "columnIndex": "index"}},
+	"unknown_file": {code: "unknown_file",
+		fields: map[string]string{"filename": "filename"}},
+	"unused_shape": {code: "unused_shape",
+		fields: map[string]string{"shapeId": "shapeId"}},
+	"wrong_number_of_fields": {code: "invalid_row_length",
+		fields: map[string]string{"filename": "filename", "rowNumber": "csvRowNumber", "actualFields": "rowLength", "expectedFields": "headerCount"}},
+}
+
+// fieldValueMapping maps a notice with filename, csvRowNumber, fieldName and
+// fieldValue keys to the canonical code of the same name.
+func fieldValueMapping(code string) mobilityDataMapping {
+	return mobilityDataMapping{code: code,
+		fields: map[string]string{"filename": "filename", "csvRowNumber": "csvRowNumber", "fieldName": "fieldName", "fieldValue": "fieldValue"}}
+}
+
+// enumMapping maps an invalid enum value notice to unexpected_enum_value.
+func enumMapping(filename, fieldName, rowKey, valueKey string) mobilityDataMapping {
+	return mobilityDataMapping{code: "unexpected_enum_value",
+		fields:    map[string]string{rowKey: "csvRowNumber", valueKey: "fieldValue"},
+		constants: map[string]interface{}{"filename": filename, "fieldName": fieldName}}
+}
+
+// rangeMapping maps an out of range value notice to number_out_of_range.
+func rangeMapping(filename, fieldName, fieldType, rowKey, valueKey string) mobilityDataMapping {
+	return mobilityDataMapping{code: "number_out_of_range",
+		fields:    map[string]string{rowKey: "csvRowNumber", valueKey: "fieldValue"},
+		constants: map[string]interface{}{"filename": filename, "fieldName": fieldName, "fieldType": fieldType}}
+}
+
+// MobilityDataNoticeCode returns the canonical MobilityData notice code for a
+// notice code of this validator, and false if it has no counterpart.
+func MobilityDataNoticeCode(code string) (string, bool) {
+	mapping, exists := mobilityDataMappings[code]
+	return mapping.code, exists
+}
+
+// ToMobilityData converts the report to the layout of the MobilityData
+// canonical validator. Notice groups mapping to the same canonical code are
+// merged, keeping the highest severity. Severities are the ones of this
+// report, so severity overrides are preserved.
+func (r *ValidationReport) ToMobilityData() *MobilityDataReport {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := &MobilityDataReport{
+		Summary: MobilityDataSummary{
+			ValidatorVersion: r.Summary.ValidatorVersion,
+			ValidatedAt:      r.Summary.Date,
+			GtfsInput:        r.Summary.FeedInfo.FeedPath,
+			Counts: MobilityDataCounts{
+				Agencies: r.Summary.FeedInfo.AgencyCount,
+				Routes:   r.Summary.FeedInfo.RouteCount,
+				Trips:    r.Summary.FeedInfo.TripCount,
+				Stops:    r.Summary.FeedInfo.StopCount,
+			},
+		},
+		Notices: []MobilityDataNotice{},
+	}
+
+	merged := make(map[string]*MobilityDataNotice)
+	for _, group := range r.Notices {
+		mapping, exists := mobilityDataMappings[group.Code]
+		if !exists {
+			result.UnmappedNotices = append(result.UnmappedNotices, MobilityDataNotice{
+				Code:          group.Code,
+				Severity:      group.Severity,
+				TotalNotices:  group.TotalNotices,
+				SampleNotices: group.SampleNotices,
+			})
+			continue
+		}
+
+		canonical, exists := merged[mapping.code]
+		if !exists {
+			canonical = &MobilityDataNotice{Code: mapping.code, Severity: group.Severity, SampleNotices: []map[string]interface{}{}}
+			merged[mapping.code] = canonical
+		}
+		if severityRank(group.Severity) < severityRank(canonical.Severity) {
+			canonical.Severity = group.Severity
+		}
+		canonical.TotalNotices += group.TotalNotices
+		for _, sample := range group.SampleNotices {
+			canonical.SampleNotices = append(canonical.SampleNotices, mapping.convert(sample))
+		}
+	}
+
+	for _, notice := range merged {
+		result.Notices = append(result.Notices, *notice)
+	}
+	sortMobilityDataNotices(result.Notices)
+	sortMobilityDataNotices(result.UnmappedNotices)
+	return result
+}
+
+// WriteMobilityDataJSON writes the report in the layout of the MobilityData canonical validator.
+func (r *ValidationReport) WriteMobilityDataJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r.ToMobilityData())
+}
+
+// convert renames the context keys of a sample notice to their canonical names.
+func (m mobilityDataMapping) convert(sample map[string]interface{}) map[string]interface{} {
+	converted := make(map[string]interface{}, len(m.fields)+len(m.constants))
+	for key, canonicalKey := range m.fields {
+		if value, exists := sample[key]; exists {
+			converted[canonicalKey] = value
+		}
+	}
+	for key, value := range m.constants {
+		converted[key] = value
+	}
+	return converted
+}
+
+// sortMobilityDataNotices sorts notices by severity (errors first), then code.
+func sortMobilityDataNotices(notices []MobilityDataNotice) {
+	sort.Slice(notices, func(i, j int) bool {
+		if severityRank(notices[i].Severity) != severityRank(notices[j].Severity) {
+			return severityRank(notices[i].Severity) < severityRank(notices[j].Severity)
+		}
+		return notices[i].Code < notices[j].Code
+	})
+}
@@ -0,0 +1,133 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+func TestMobilityDataMappings_MatchRegistry(t *testing.T) {
+	rules := make(map[string]notice.Rule)
+	for _, rule := range notice.Rules() {
+		rules[rule.Code] = rule
+	}
+
+	for code, mapping := range mobilityDataMappings {
+		rule, exists := rules[code]
+		if !exists {
+			t.Errorf("mapped notice code %s is not registered", code)
+			continue
+		}
+		keys := make(map[string]bool)
+		for _, field := range rule.Context {
+			keys[field.Name] = true
+		}
+		for key, canonicalKey := range mapping.fields {
+			if !keys[key] {
+				t.Errorf("%s has no context key %s", code, key)
+			}
+			if _, exists := mapping.constants[canonicalKey]; exists {
+				t.Errorf("%s maps %s to constant key %s", code, key, canonicalKey)
+			}
+		}
+	}
+}
+
+func TestValidationReport_ToMobilityData(t *testing.T) {
+	report := &ValidationReport{
+		Summary: Summary{
+			ValidatorVersion: "1.2.3",
+			Date:             "2025-01-02T00:00:00Z",
+			FeedInfo:         FeedInfo{FeedPath: "feed.zip", AgencyCount: 1, RouteCount: 2, TripCount: 3, StopCount: 4},
+		},
+		Notices: []NoticeGroup{
+			{
+				Code:          "leading_whitespace",
+				Severity:      "WARNING",
+				TotalNotices:  2,
+				SampleNotices: []map[string]interface{}{{"filename": "stops.txt", "rowNumber": 3, "fieldName": "stop_name", "fieldValue": " A"}},
+			},
+			{
+				Code:          "trailing_whitespace",
+				Severity:      "ERROR",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{{"filename": "stops.txt", "rowNumber": 5, "fieldName": "stop_name", "fieldValue": "B "}},
+			},
+			{
+				Code:          "invalid_route_type",
+				Severity:      "ERROR",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{{"csvRowNumber": 2, "routeId": "R1", "routeType": 99}},
+			},
+			{
+				Code:          "feed_info_lang_and_agency_lang_mismatch",
+				Severity:      "WARNING",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{{"feedLang": "en"}},
+			},
+		},
+	}
+
+	result := report.ToMobilityData()
+
+	if result.Summary.GtfsInput != "feed.zip" || result.Summary.ValidatedAt != "2025-01-02T00:00:00Z" || result.Summary.Counts.Stops != 4 {
+		t.Errorf("unexpected summary: %+v", result.Summary)
+	}
+
+	if len(result.Notices) != 2 {
+		t.Fatalf("expected 2 canonical notice groups, got %+v", result.Notices)
+	}
+	// Sorted by severity, then code
+	whitespace := result.Notices[0]
+	if whitespace.Code != "leading_or_trailing_whitespaces" {
+		t.Fatalf("expected leading_or_trailing_whitespaces first, got %s", whitespace.Code)
+	}
+	if whitespace.Severity != "ERROR" || whitespace.TotalNotices != 3 || len(whitespace.SampleNotices) != 2 {
+		t.Errorf("expected merged ERROR group with 3 notices and 2 samples, got %+v", whitespace)
+	}
+	if whitespace.SampleNotices[0]["csvRowNumber"] != 3 {
+		t.Errorf("expected rowNumber renamed to csvRowNumber, got %v", whitespace.SampleNotices[0])
+	}
+
+	enum := result.Notices[1]
+	if enum.Code != "unexpected_enum_value" {
+		t.Fatalf("expected unexpected_enum_value, got %s", enum.Code)
+	}
+	sample := enum.SampleNotices[0]
+	if sample["filename"] != "routes.txt" || sample["fieldName"] != "route_type" || sample["fieldValue"] != 99 || sample["csvRowNumber"] != 2 {
+		t.Errorf("unexpected enum sample: %v", sample)
+	}
+	if _, exists := sample["routeId"]; exists {
+		t.Errorf("expected unmapped context keys to be dropped, got %v", sample)
+	}
+
+	if len(result.UnmappedNotices) != 1 || result.UnmappedNotices[0].Code != "feed_info_lang_and_agency_lang_mismatch" {
+		t.Errorf("expected the language mismatch to be unmapped, got %+v", result.UnmappedNotices)
+	}
+}
+
+func TestValidationReport_WriteMobilityDataJSON(t *testing.T) {
+	report, err := New().ValidateFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteMobilityDataJSON(&buf); err != nil {
+		t.Fatalf("WriteMobilityDataJSON failed: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if _, ok := decoded["notices"].([]interface{}); !ok {
+		t.Errorf("expected a notices array, got %v", decoded["notices"])
+	}
+	counts := decoded["summary"].(map[string]interface{})["counts"].(map[string]interface{})
+	if counts["Stops"] != float64(report.Summary.FeedInfo.StopCount) {
+		t.Errorf("expected Stops count %d, got %v", report.Summary.FeedInfo.StopCount, counts["Stops"])
+	}
+}
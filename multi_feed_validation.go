@@ -0,0 +1,190 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/report"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator/crossfeed"
+)
+
+// MultiFeedReport contains the reports of feeds validated together and the
+// cross-feed checks run between them.
+type MultiFeedReport struct {
+	// Feeds contains the individual report of each feed, in the order given.
+	Feeds []FeedReport `json:"feeds"`
+
+	// CrossFeed contains the notices about the feeds taken together: colliding
+	// IDs, nearby stops of different feeds and inconsistent timezones.
+	CrossFeed *ValidationReport `json:"crossFeed"`
+}
+
+// FeedReport is the result of validating one feed of a multi-feed validation.
+type FeedReport struct {
+	// Name identifies the feed in cross-feed notices (the file name without extension).
+	Name string `json:"name"`
+
+	// Path is the path of the feed.
+	Path string `json:"path"`
+
+	// Report is the validation report of the feed, nil if it could not be validated.
+	Report *ValidationReport `json:"report,omitempty"`
+
+	// Error describes why the feed could not be validated or why validation stopped early.
+	Error string `json:"error,omitempty"`
+}
+
+// HasErrors returns true if any feed or the cross-feed checks reported errors, or a feed could not be validated.
+func (r *MultiFeedReport) HasErrors() bool {
+	return r.ErrorCount() > 0
+}
+
+// ErrorCount returns the number of errors across all feeds and the cross-feed checks.
+// A feed that could not be validated counts as one error.
+func (r *MultiFeedReport) ErrorCount() int {
+	count := 0
+	for _, feed := range r.Feeds {
+		if feed.Report != nil {
+			count += feed.Report.ErrorCount()
+		} else {
+			count++
+		}
+	}
+	if r.CrossFeed != nil {
+		count += r.CrossFeed.ErrorCount()
+	}
+	return count
+}
+
+// WarningCount returns the number of warnings across all feeds and the cross-feed checks.
+func (r *MultiFeedReport) WarningCount() int {
+	count := 0
+	for _, feed := range r.Feeds {
+		if feed.Report != nil {
+			count += feed.Report.WarningCount()
+		}
+	}
+	if r.CrossFeed != nil {
+		count += r.CrossFeed.WarningCount()
+	}
+	return count
+}
+
+// ValidateFeeds validates several feeds individually and checks them against each other.
+func (v *validatorImpl) ValidateFeeds(paths []string) (*MultiFeedReport, error) {
+	return v.ValidateFeedsWithContext(context.Background(), paths)
+}
+
+// ValidateFeedsWithContext validates each feed (ZIP file or directory) with the
+// standard validators, then runs the cross-feed validators on the feeds that
+// could be opened. A feed that fails to load is recorded in its FeedReport and
+// does not stop the other feeds from being validated.
+func (v *validatorImpl) ValidateFeedsWithContext(ctx context.Context, paths []string) (*MultiFeedReport, error) {
+	if len(paths) < 2 {
+		return nil, errors.New("multi-feed validation requires at least two feeds")
+	}
+
+	names := feedNames(paths)
+	result := &MultiFeedReport{Feeds: make([]FeedReport, len(paths))}
+
+	for i, path := range paths {
+		feedReport, err := v.ValidateFileWithContext(ctx, path)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		result.Feeds[i] = FeedReport{Name: names[i], Path: path, Report: feedReport}
+		if err != nil {
+			result.Feeds[i].Error = err.Error()
+		}
+	}
+
+	startTime := time.Now()
+
+	var feeds []validator.NamedFeed
+	defer func() {
+		for _, feed := range feeds {
+			if err := feed.Loader.Close(); err != nil {
+				feed.Loader.Logger().Warn("Failed to close loader", logging.String("feed", feed.Name), logging.ErrorField("error", err))
+			}
+		}
+	}()
+	for i, path := range paths {
+		loader, err := v.config.openFeedLoader(path)
+		if err != nil {
+			continue
+		}
+		if v.config.TranscodeToUTF8 {
+			loader.EnableTranscoding()
+		}
+		if v.config.RecoverMalformedCSV {
+			loader.EnableCSVRecovery()
+		}
+		feeds = append(feeds, validator.NamedFeed{Name: names[i], Loader: loader})
+	}
+
+	internalValidator := newInternalValidator(v.createInternalConfig(), v.createValidationConfig())
+	internalReport, err := internalValidator.ValidateCrossFeedWithContext(ctx, feeds, strings.Join(paths, ", "))
+	result.CrossFeed, err = v.finishReport(internalValidator, internalReport, startTime, err)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// feedNames derives a short unique name for each feed from its path.
+func feedNames(paths []string) []string {
+	names := make([]string, len(paths))
+	used := make(map[string]int)
+	for i, path := range paths {
+		base := filepath.Base(filepath.Clean(path))
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		used[name]++
+		if used[name] > 1 {
+			name = fmt.Sprintf("%s (%d)", name, used[name])
+		}
+		names[i] = name
+	}
+	return names
+}
+
+// ValidateCrossFeedWithContext runs the cross-feed validators on already opened feeds.
+func (v *internalValidator) ValidateCrossFeedWithContext(ctx context.Context, feeds []validator.NamedFeed, feedPath string) (*report.ValidationReport, error) {
+	startTime := time.Now()
+
+	validatorConfig := validator.Config{
+		CountryCode:     v.config.CountryCode,
+		CurrentDate:     v.config.CurrentDate,
+		MaxMemory:       v.config.MaxMemory,
+		ParallelWorkers: v.config.ParallelWorkers,
+	}
+
+	for _, crossFeedValidator := range v.crossFeedValidators() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		v.runValidator(crossFeedValidator, v.noticeContainer, func(container *notice.NoticeContainer) {
+			crossFeedValidator.ValidateFeeds(feeds, container, validatorConfig)
+		})
+	}
+
+	return v.generateReport(report.FeedInfo{FeedPath: feedPath}, startTime), nil
+}
+
+// crossFeedValidators returns the cross-feed validators configured with the current thresholds.
+func (v *internalValidator) crossFeedValidators() []validator.CrossFeedValidator {
+	return []validator.CrossFeedValidator{
+		crossfeed.NewIDCollisionValidator(),
+		crossfeed.NewNearbyStopsValidator(v.config.CrossFeedStopDistanceMeters),
+		crossfeed.NewTimezoneConsistencyValidator(),
+	}
+}
@@ -0,0 +1,88 @@
+package gtfsvalidator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeFeedDir writes a feed to a directory with the given name
+func writeFeedDir(t *testing.T, name string, files map[string]string) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), name)
+	if err := os.Mkdir(dir, 0o750); err != nil {
+		t.Fatalf("Failed to create feed directory: %v", err)
+	}
+	for filename, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, filename), []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", filename, err)
+		}
+	}
+	return dir
+}
+
+func TestValidateFeeds(t *testing.T) {
+	metroFiles := MinimalValidGTFS()
+	metroPath := writeFeedDir(t, "metro", metroFiles)
+
+	// Shares agency, route and stop IDs with the metro feed; stop_1 sits ~5m from metro's stop_1
+	busFiles := MinimalValidGTFS()
+	busFiles["agency.txt"] = `agency_id,agency_name,agency_url,agency_timezone
+test_agency,Bus Company,https://example.com,America/Chicago`
+	busFiles["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,40.75894,-73.9851
+bus_2,Far Stop,40.8000,-73.9000`
+	busFiles["stop_times.txt"] = `trip_id,arrival_time,departure_time,stop_id,stop_sequence
+trip_1,08:00:00,08:00:00,stop_1,1
+trip_1,08:15:00,08:15:00,bus_2,2`
+	busPath := writeFeedDir(t, "bus", busFiles)
+
+	missingPath := filepath.Join(t.TempDir(), "missing.zip")
+
+	report, err := New().ValidateFeeds([]string{metroPath, busPath, missingPath})
+	if err != nil {
+		t.Fatalf("ValidateFeeds failed: %v", err)
+	}
+
+	if len(report.Feeds) != 3 {
+		t.Fatalf("Expected 3 feed reports, got %d", len(report.Feeds))
+	}
+	names := []string{report.Feeds[0].Name, report.Feeds[1].Name, report.Feeds[2].Name}
+	if !reflect.DeepEqual(names, []string{"metro", "bus", "missing"}) {
+		t.Errorf("Unexpected feed names %v", names)
+	}
+	if report.Feeds[0].Report == nil || report.Feeds[0].Report.Summary.FeedInfo.StopCount != 2 {
+		t.Error("Expected an individual report for the metro feed")
+	}
+	if report.Feeds[2].Report != nil || report.Feeds[2].Error == "" {
+		t.Error("Expected an error for the missing feed")
+	}
+
+	expected := map[string]int{
+		"cross_feed_id_collision":          3, // agency_id, stop_id and route_id
+		"cross_feed_nearby_stops":          1,
+		"cross_feed_inconsistent_timezone": 1,
+	}
+	for code, count := range expected {
+		if actual := countNotices(report.CrossFeed, code); actual != count {
+			t.Errorf("Expected %d %s notices, got %d", count, code, actual)
+		}
+	}
+	if !report.HasErrors() {
+		t.Error("Expected ID collisions and the missing feed to count as errors")
+	}
+
+	if _, err := New().ValidateFeeds([]string{metroPath}); err == nil {
+		t.Error("Expected an error for a single feed")
+	}
+}
+
+func TestFeedNames(t *testing.T) {
+	names := feedNames([]string{"feeds/metro.zip", "other/metro.zip", "./bus/", "tram"})
+	expected := []string{"metro", "metro (2)", "bus", "tram"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
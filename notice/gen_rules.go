@@ -0,0 +1,508 @@
+//go:build ignore
+
+// gen_rules generates rules_generated.go, the registry of every notice code.
+//
+// It parses validation_notices.go to find each constructor's code, severity and
+// context fields (typed from the constructor parameters), then scans the validator sources to find which validators emit each code.
+//
+// Usage (from the notice directory):
+//
+//	go run gen_rules.go [-root ..] [-o rules_generated.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// categoryOrder decides the category of codes emitted from several packages
+var categoryOrder = []string{"core", "entity", "relationship", "business", "accessibility", "fare", "meta", "diff", "crossfeed", "validator"}
+
+var severityNames = map[string]int{"INFO": 0, "WARNING": 1, "ERROR": 2}
+
+type constructorInfo struct {
+	name     string
+	code     string
+	severity string         // empty when the severity is passed in by the caller
+	fields   []contextField // context keys in source order
+}
+
+type contextField struct {
+	name     string
+	jsonType string // empty when the type is not fixed
+}
+
+type ruleInfo struct {
+	code         string
+	severities   map[string]bool
+	constructors map[string]bool
+	validators   map[string]bool
+	packages     map[string]bool
+	fields       []contextField
+	direct       bool // emitted with NewBaseNotice outside a constructor
+}
+
+func main() {
+	root := flag.String("root", "..", "repository root")
+	output := flag.String("o", "rules_generated.go", "output file")
+	flag.Parse()
+
+	constructors, err := parseConstructors(filepath.Join(*root, "notice", "validation_notices.go"))
+	if err != nil {
+		log.Fatalf("parse constructors: %v", err)
+	}
+
+	rules := make(map[string]*ruleInfo)
+	ruleFor := func(code string) *ruleInfo {
+		rule, exists := rules[code]
+		if !exists {
+			rule = &ruleInfo{
+				code:         code,
+				severities:   make(map[string]bool),
+				constructors: make(map[string]bool),
+				validators:   make(map[string]bool),
+				packages:     make(map[string]bool),
+			}
+			rules[code] = rule
+		}
+		return rule
+	}
+
+	for _, c := range constructors {
+		rule := ruleFor(c.code)
+		rule.constructors[c.name] = true
+		rule.fields = c.fields
+		if c.severity != "" {
+			rule.severities[c.severity] = true
+		}
+	}
+
+	files, err := sourceFiles(*root)
+	if err != nil {
+		log.Fatalf("list sources: %v", err)
+	}
+	for _, path := range files {
+		if err := scanEmitters(path, constructors, ruleFor); err != nil {
+			log.Fatalf("scan %s: %v", path, err)
+		}
+	}
+
+	source, err := render(rules)
+	if err != nil {
+		log.Fatalf("render: %v", err)
+	}
+	if err := os.WriteFile(*output, source, 0600); err != nil {
+		log.Fatalf("write %s: %v", *output, err)
+	}
+}
+
+// parseConstructors finds every NewXxxNotice function and the code and severity it passes to
+// NewBaseNotice or newLocatedNotice
+func parseConstructors(path string) (map[string]constructorInfo, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	constructors := make(map[string]constructorInfo)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "New") || !strings.HasSuffix(fn.Name.Name, "Notice") {
+			continue
+		}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			if ident, ok := call.Fun.(*ast.Ident); !ok || (ident.Name != "NewBaseNotice" && ident.Name != "newLocatedNotice") {
+				return true
+			}
+			code, ok := stringLiteral(call.Args[0])
+			if !ok {
+				return true
+			}
+			info := constructorInfo{name: fn.Name.Name, code: code, fields: constructorFields(fn)}
+			if ident, ok := call.Args[1].(*ast.Ident); ok {
+				if _, known := severityNames[ident.Name]; known {
+					info.severity = ident.Name
+				}
+			}
+			constructors[fn.Name.Name] = info
+			return false
+		})
+	}
+	return constructors, nil
+}
+
+// constructorFields returns the keys of the context map literal built by a
+// constructor, typed from the constructor parameters or the value expressions
+func constructorFields(fn *ast.FuncDecl) []contextField {
+	params := make(map[string]string)
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			params[name.Name] = goJSONType(field.Type)
+		}
+	}
+
+	var fields []contextField
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		// Only the context map; locations carry their primary key in a map[string]string
+		mapType, isMap := lit.Type.(*ast.MapType)
+		if !isMap {
+			return true
+		}
+		if _, isContext := mapType.Value.(*ast.InterfaceType); !isContext {
+			return false
+		}
+		fields = []contextField{}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := stringLiteral(kv.Key)
+			if !ok {
+				continue
+			}
+			fields = append(fields, contextField{name: key, jsonType: exprJSONType(kv.Value, params)})
+		}
+		return false
+	})
+	return fields
+}
+
+// goJSONType returns the JSON type a Go type is encoded as, or "" when it varies
+func goJSONType(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return "string"
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+			return "integer"
+		case "float32", "float64":
+			return "number"
+		case "bool":
+			return "boolean"
+		}
+	case *ast.ArrayType:
+		return "array"
+	}
+	return ""
+}
+
+// exprJSONType infers the JSON type of a context value expression
+func exprJSONType(expr ast.Expr, params map[string]string) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return params[e.Name]
+	case *ast.BasicLit:
+		switch e.Kind {
+		case token.STRING:
+			return "string"
+		case token.INT:
+			return "integer"
+		case token.FLOAT:
+			return "number"
+		}
+	case *ast.CallExpr:
+		if ident, ok := e.Fun.(*ast.Ident); ok {
+			if ident.Name == "len" {
+				return "integer"
+			}
+			return goJSONType(ident)
+		}
+	case *ast.BinaryExpr:
+		return exprJSONType(e.X, params)
+	case *ast.ParenExpr:
+		return exprJSONType(e.X, params)
+	}
+	return ""
+}
+
+// sourceFiles lists the non-test Go files of the root package and the validator packages
+func sourceFiles(root string) ([]string, error) {
+	var files []string
+
+	rootFiles, err := filepath.Glob(filepath.Join(root, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, rootFiles...)
+
+	err = filepath.Walk(filepath.Join(root, "validator"), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == "testdata" {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && strings.HasSuffix(path, ".go") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := files[:0]
+	for _, path := range files {
+		if !strings.HasSuffix(path, "_test.go") {
+			result = append(result, path)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// scanEmitters records which validator emits each notice constructed in the given file
+func scanEmitters(path string, constructors map[string]constructorInfo, ruleFor func(string) *ruleInfo) error {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	if isIgnored(file) {
+		return nil
+	}
+	pkg := file.Name.Name
+
+	// Helper functions without a receiver are attributed to the validator declared in the same file
+	var fileValidator string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok && fileValidator == "" && strings.HasSuffix(ts.Name.Name, "Validator") {
+				fileValidator = ts.Name.Name
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		owner := receiverName(fn)
+		if owner == "" {
+			owner = fileValidator
+		}
+		if owner == "" {
+			owner = fn.Name.Name
+		}
+		emitter := pkg + "." + owner
+		funcSeverities := selectorSeverities(fn.Body)
+
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "notice" {
+				return true
+			}
+
+			var rule *ruleInfo
+			switch {
+			case sel.Sel.Name == "NewBaseNotice" && len(call.Args) >= 2:
+				code, ok := stringLiteral(call.Args[0])
+				if !ok {
+					return true
+				}
+				rule = ruleFor(code)
+				rule.direct = true
+				if severity := selectorSeverity(call.Args[1]); severity != "" {
+					rule.severities[severity] = true
+				} else {
+					mergeSeverities(rule, funcSeverities)
+				}
+			default:
+				c, known := constructors[sel.Sel.Name]
+				if !known {
+					return true
+				}
+				rule = ruleFor(c.code)
+				if c.severity == "" {
+					mergeSeverities(rule, funcSeverities)
+				}
+			}
+			rule.validators[emitter] = true
+			rule.packages[pkg] = true
+			return true
+		})
+	}
+	return nil
+}
+
+func isIgnored(file *ast.File) bool {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, "//go:build ignore") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func receiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// selectorSeverities collects the notice.ERROR/WARNING/INFO references within a function body
+func selectorSeverities(body *ast.BlockStmt) map[string]bool {
+	severities := make(map[string]bool)
+	ast.Inspect(body, func(node ast.Node) bool {
+		if expr, ok := node.(ast.Expr); ok {
+			if severity := selectorSeverity(expr); severity != "" {
+				severities[severity] = true
+			}
+		}
+		return true
+	})
+	return severities
+}
+
+func selectorSeverity(expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "notice" {
+		return ""
+	}
+	if _, known := severityNames[sel.Sel.Name]; !known {
+		return ""
+	}
+	return sel.Sel.Name
+}
+
+func mergeSeverities(rule *ruleInfo, severities map[string]bool) {
+	for severity := range severities {
+		rule.severities[severity] = true
+	}
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func category(rule *ruleInfo) string {
+	for _, candidate := range categoryOrder {
+		if rule.packages[candidate] {
+			return candidate
+		}
+	}
+	if len(rule.packages) > 0 {
+		return "system"
+	}
+	return "unused"
+}
+
+func highestSeverity(severities map[string]bool) string {
+	highest := ""
+	for severity := range severities {
+		if highest == "" || severityNames[severity] > severityNames[highest] {
+			highest = severity
+		}
+	}
+	if highest == "" {
+		return "INFO"
+	}
+	return highest
+}
+
+func render(rules map[string]*ruleInfo) ([]byte, error) {
+	codes := make([]string, 0, len(rules))
+	for code := range rules {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_rules.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package notice\n\n")
+	buf.WriteString("var ruleRegistry = []Rule{\n")
+	for _, code := range codes {
+		rule := rules[code]
+		fmt.Fprintf(&buf, "\t{Code: %q, Severity: %s, ", code, highestSeverity(rule.severities))
+		if len(rule.severities) > 1 {
+			buf.WriteString("VariableSeverity: true, ")
+		}
+		fmt.Fprintf(&buf, "Category: %q, Constructors: %s, Validators: %s",
+			category(rule), stringSlice(rule.constructors), stringSlice(rule.validators))
+		if rule.fields != nil && !rule.direct {
+			fmt.Fprintf(&buf, ", Context: %s", fieldSlice(rule.fields))
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func stringSlice(set map[string]bool) string {
+	if len(set) == 0 {
+		return "nil"
+	}
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, strconv.Quote(value))
+	}
+	sort.Strings(values)
+	return "[]string{" + strings.Join(values, ", ") + "}"
+}
+
+// fieldSlice renders context fields sorted by name
+func fieldSlice(fields []contextField) string {
+	sorted := make([]contextField, len(fields))
+	copy(sorted, fields)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+
+	values := make([]string, len(sorted))
+	for i, field := range sorted {
+		values[i] = fmt.Sprintf("{Name: %q, Type: %q}", field.name, field.jsonType)
+	}
+	return "[]ContextField{" + strings.Join(values, ", ") + "}"
+}
@@ -0,0 +1,281 @@
+package notice
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// EntityRef identifies a GTFS entity referenced by a notice
+type EntityRef struct {
+	// Type is the entity type, e.g. "route", "stop", "trip"
+	Type string `json:"type"`
+	// ID is the entity identifier
+	ID string `json:"id"`
+}
+
+// NoticeLocation points at the record a notice refers to
+type NoticeLocation struct {
+	// File is the GTFS file containing the offending record
+	File string `json:"file,omitempty"`
+	// RowNumber is the CSV row number of the record (header is row 1)
+	RowNumber int `json:"rowNumber,omitempty"`
+	// OtherRowNumbers are rows of other records in the same file the notice refers to,
+	// e.g. the first occurrence of a duplicate
+	OtherRowNumbers []int `json:"otherRowNumbers,omitempty"`
+	// FieldName is the offending field, when the notice is about a single field
+	FieldName string `json:"fieldName,omitempty"`
+	// PrimaryKey holds the primary key values of the record keyed by GTFS field name
+	PrimaryKey map[string]string `json:"primaryKey,omitempty"`
+	// RelatedEntities lists every entity the notice refers to, sorted by type and ID
+	RelatedEntities []EntityRef `json:"relatedEntities,omitempty"`
+}
+
+// IsZero reports whether the location carries no information
+func (l NoticeLocation) IsZero() bool {
+	return l.File == "" && l.RowNumber == 0 && len(l.OtherRowNumbers) == 0 && l.FieldName == "" && len(l.PrimaryKey) == 0 && len(l.RelatedEntities) == 0
+}
+
+// EntityIDs returns the IDs of related entities of the given type
+func (l NoticeLocation) EntityIDs(entityType string) []string {
+	var ids []string
+	for _, entity := range l.RelatedEntities {
+		if entity.Type == entityType {
+			ids = append(ids, entity.ID)
+		}
+	}
+	return ids
+}
+
+// entityContextKeys maps notice context keys to the entity type they reference
+var entityContextKeys = map[string]string{
+	"agencyId":        "agency",
+	"routeId":         "route",
+	"firstRouteId":    "route",
+	"previousRouteId": "route",
+	"tripId":          "trip",
+	"trip1Id":         "trip",
+	"trip2Id":         "trip",
+	"stopId":          "stop",
+	"fromStopId":      "stop",
+	"toStopId":        "stop",
+	"previousStopId":  "stop",
+	"stop1Id":         "stop",
+	"stop2Id":         "stop",
+	"parentStation":   "stop",
+	"parentStationId": "stop",
+	"stationId":       "stop",
+	"serviceId":       "service",
+	"service1Id":      "service",
+	"service2Id":      "service",
+	"shapeId":         "shape",
+	"fareId":          "fare",
+	"zoneId":          "zone",
+	"blockId":         "block",
+	"pathwayId":       "pathway",
+	"pathwayId1":      "pathway",
+	"pathwayId2":      "pathway",
+	"levelId":         "level",
+	"attributionId":   "attribution",
+	"attributionId1":  "attribution",
+	"attributionId2":  "attribution",
+}
+
+// entityFieldNames maps GTFS field names to the entity type they reference
+var entityFieldNames = map[string]string{
+	"agency_id":      "agency",
+	"route_id":       "route",
+	"trip_id":        "trip",
+	"stop_id":        "stop",
+	"from_stop_id":   "stop",
+	"to_stop_id":     "stop",
+	"parent_station": "stop",
+	"service_id":     "service",
+	"shape_id":       "shape",
+	"fare_id":        "fare",
+	"zone_id":        "zone",
+	"block_id":       "block",
+	"pathway_id":     "pathway",
+	"level_id":       "level",
+	"attribution_id": "attribution",
+}
+
+// primaryKeyField is a primary key field and the context key holding its value
+type primaryKeyField struct {
+	field      string
+	contextKey string
+}
+
+// primaryKeys lists the primary key of each file. The order is used to infer the
+// file of notices without a filename, so more specific keys come first.
+var primaryKeys = []struct {
+	file   string
+	fields []primaryKeyField
+}{
+	{"stop_times.txt", []primaryKeyField{{"trip_id", "tripId"}, {"stop_sequence", "stopSequence"}}},
+	{"shapes.txt", []primaryKeyField{{"shape_id", "shapeId"}, {"shape_pt_sequence", "shapePtSequence"}}},
+	{"calendar_dates.txt", []primaryKeyField{{"service_id", "serviceId"}, {"date", "date"}}},
+	{"frequencies.txt", []primaryKeyField{{"trip_id", "tripId"}, {"start_time", "startTime"}}},
+	{"transfers.txt", []primaryKeyField{{"from_stop_id", "fromStopId"}, {"to_stop_id", "toStopId"}}},
+	{"pathways.txt", []primaryKeyField{{"pathway_id", "pathwayId"}}},
+	{"levels.txt", []primaryKeyField{{"level_id", "levelId"}}},
+	{"attributions.txt", []primaryKeyField{{"attribution_id", "attributionId"}}},
+	{"fare_attributes.txt", []primaryKeyField{{"fare_id", "fareId"}}},
+	{"trips.txt", []primaryKeyField{{"trip_id", "tripId"}}},
+	{"stops.txt", []primaryKeyField{{"stop_id", "stopId"}}},
+	{"routes.txt", []primaryKeyField{{"route_id", "routeId"}}},
+	{"calendar.txt", []primaryKeyField{{"service_id", "serviceId"}}},
+	{"agency.txt", []primaryKeyField{{"agency_id", "agencyId"}}},
+}
+
+// inferableFiles are the files whose primary key identifies a notice without a filename.
+// Link tables (calendar_dates, frequencies, transfers) are only used when the filename is known.
+var inferableFiles = map[string]bool{
+	"stop_times.txt": true, "shapes.txt": true, "pathways.txt": true, "levels.txt": true,
+	"attributions.txt": true, "fare_attributes.txt": true, "trips.txt": true, "stops.txt": true,
+	"routes.txt": true, "calendar.txt": true, "agency.txt": true,
+}
+
+// LocationFromContext derives a location from notice context keys. Notices set
+// their location explicitly; this is only a fallback for reports decoded from
+// JSON written before locations were reported, where numbers are float64.
+//
+// The file is taken from "filename" or inferred from the most specific entity
+// identifier present, e.g. tripId and stopSequence point at stop_times.txt.
+func LocationFromContext(context map[string]interface{}) NoticeLocation {
+	var location NoticeLocation
+	if len(context) == 0 {
+		return location
+	}
+
+	location.File = contextString(context, "filename")
+	for _, key := range []string{"csvRowNumber", "rowNumber"} {
+		if row, ok := contextInt(context, key); ok {
+			location.RowNumber = row
+			break
+		}
+	}
+	for _, key := range []string{"fieldName", "columnName", "headerName"} {
+		if field := contextString(context, key); field != "" {
+			location.FieldName = field
+			break
+		}
+	}
+
+	location.PrimaryKey = primaryKeyFromContext(context, &location)
+	location.RelatedEntities = entitiesFromContext(context, location)
+	return location
+}
+
+// primaryKeyFromContext returns the primary key values of the notice's record,
+// setting location.File when it has to be inferred
+func primaryKeyFromContext(context map[string]interface{}, location *NoticeLocation) map[string]string {
+	fieldValue := contextString(context, "fieldValue")
+
+	for _, candidate := range primaryKeys {
+		if location.File != "" && candidate.file != location.File {
+			continue
+		}
+		if location.File == "" && !inferableFiles[candidate.file] {
+			continue
+		}
+
+		key := make(map[string]string, len(candidate.fields))
+		for _, field := range candidate.fields {
+			value := contextString(context, field.contextKey)
+			// Generic notices carry the key in fieldName/fieldValue (e.g. duplicate_key)
+			if value == "" && len(candidate.fields) == 1 && location.FieldName == field.field {
+				value = fieldValue
+			}
+			if value == "" {
+				key = nil
+				break
+			}
+			key[field.field] = value
+		}
+
+		if key != nil {
+			location.File = candidate.file
+			return key
+		}
+		if location.File != "" {
+			return nil
+		}
+	}
+	return nil
+}
+
+// entitiesFromContext collects all entity references from context keys and the primary key
+func entitiesFromContext(context map[string]interface{}, location NoticeLocation) []EntityRef {
+	seen := make(map[EntityRef]bool)
+	add := func(entityType, id string) {
+		if id != "" {
+			seen[EntityRef{Type: entityType, ID: id}] = true
+		}
+	}
+
+	for key, entityType := range entityContextKeys {
+		add(entityType, contextString(context, key))
+	}
+	for field, value := range location.PrimaryKey {
+		if entityType, ok := entityFieldNames[field]; ok {
+			add(entityType, value)
+		}
+	}
+	// Field-level notices reference an entity through fieldName/fieldValue (e.g. foreign_key_violation),
+	// unless the primary key already holds the field's value
+	if entityType, ok := entityFieldNames[location.FieldName]; ok && location.PrimaryKey[location.FieldName] == "" {
+		add(entityType, contextString(context, "fieldValue"))
+	}
+
+	if len(seen) == 0 {
+		return nil
+	}
+	entities := make([]EntityRef, 0, len(seen))
+	for entity := range seen {
+		entities = append(entities, entity)
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Type != entities[j].Type {
+			return entities[i].Type < entities[j].Type
+		}
+		return entities[i].ID < entities[j].ID
+	})
+	return entities
+}
+
+// contextString formats a context value as a string, returning "" for missing values
+func contextString(context map[string]interface{}, key string) string {
+	switch value := context[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		if value == math.Trunc(value) {
+			return strconv.FormatInt(int64(value), 10)
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// contextInt reads an integer context value
+func contextInt(context map[string]interface{}, key string) (int, bool) {
+	switch value := context[key].(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case float64:
+		return int(value), true
+	case json.Number:
+		n, err := value.Int64()
+		return int(n), err == nil
+	default:
+		return 0, false
+	}
+}
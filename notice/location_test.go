@@ -0,0 +1,245 @@
+package notice
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLocationFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		context  map[string]interface{}
+		expected NoticeLocation
+	}{
+		{
+			name:     "empty context",
+			context:  nil,
+			expected: NoticeLocation{},
+		},
+		{
+			name: "filename and field key",
+			context: map[string]interface{}{
+				"filename":     "stops.txt",
+				"fieldName":    "stop_id",
+				"fieldValue":   "S1",
+				"csvRowNumber": 4,
+			},
+			expected: NoticeLocation{
+				File:            "stops.txt",
+				RowNumber:       4,
+				FieldName:       "stop_id",
+				PrimaryKey:      map[string]string{"stop_id": "S1"},
+				RelatedEntities: []EntityRef{{Type: "stop", ID: "S1"}},
+			},
+		},
+		{
+			name: "foreign key reference",
+			context: map[string]interface{}{
+				"filename":     "trips.txt",
+				"fieldName":    "route_id",
+				"fieldValue":   "R9",
+				"csvRowNumber": 2,
+			},
+			expected: NoticeLocation{
+				File:            "trips.txt",
+				RowNumber:       2,
+				FieldName:       "route_id",
+				RelatedEntities: []EntityRef{{Type: "route", ID: "R9"}},
+			},
+		},
+		{
+			name: "stop time inferred from trip and sequence",
+			context: map[string]interface{}{
+				"tripId":       "T1",
+				"stopId":       "S2",
+				"stopSequence": 3,
+				"csvRowNumber": 10,
+			},
+			expected: NoticeLocation{
+				File:            "stop_times.txt",
+				RowNumber:       10,
+				PrimaryKey:      map[string]string{"trip_id": "T1", "stop_sequence": "3"},
+				RelatedEntities: []EntityRef{{Type: "stop", ID: "S2"}, {Type: "trip", ID: "T1"}},
+			},
+		},
+		{
+			name: "decoded JSON numbers",
+			context: map[string]interface{}{
+				"shapeId":         "SH1",
+				"shapePtSequence": float64(7),
+				"rowNumber":       float64(12),
+			},
+			expected: NoticeLocation{
+				File:            "shapes.txt",
+				RowNumber:       12,
+				PrimaryKey:      map[string]string{"shape_id": "SH1", "shape_pt_sequence": "7"},
+				RelatedEntities: []EntityRef{{Type: "shape", ID: "SH1"}},
+			},
+		},
+		{
+			name: "link table needs filename",
+			context: map[string]interface{}{
+				"fromStopId": "A",
+				"toStopId":   "B",
+			},
+			expected: NoticeLocation{
+				RelatedEntities: []EntityRef{{Type: "stop", ID: "A"}, {Type: "stop", ID: "B"}},
+			},
+		},
+		{
+			name: "known filename without key values",
+			context: map[string]interface{}{
+				"filename": "feed_info.txt",
+				"routeId":  "R1",
+			},
+			expected: NoticeLocation{
+				File:            "feed_info.txt",
+				RelatedEntities: []EntityRef{{Type: "route", ID: "R1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := LocationFromContext(tt.context)
+			if !reflect.DeepEqual(location, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, location)
+			}
+		})
+	}
+}
+
+func TestBaseNotice_Location(t *testing.T) {
+	n := NewForeignKeyViolationNotice("stop_times.txt", "stop_id", "S9", 5, "stops.txt", "stop_id")
+	location := n.Location()
+	if location.File != "stop_times.txt" || location.RowNumber != 5 || location.FieldName != "stop_id" {
+		t.Errorf("Unexpected constructor location: %+v", location)
+	}
+	if ids := location.EntityIDs("stop"); len(ids) != 1 || ids[0] != "S9" {
+		t.Errorf("Expected stop S9 to be related, got %v", ids)
+	}
+	if location.IsZero() {
+		t.Error("Expected non-zero location")
+	}
+
+	explicit := NoticeLocation{File: "stops.txt", RowNumber: 2}
+	n.SetLocation(explicit)
+	if !reflect.DeepEqual(n.Location(), explicit) {
+		t.Errorf("Expected explicit location %+v, got %+v", explicit, n.Location())
+	}
+
+	if !(NoticeLocation{}).IsZero() {
+		t.Error("Expected empty location to be zero")
+	}
+}
+
+func TestBaseNotice_LocationNotInferred(t *testing.T) {
+	n := NewBaseNotice("custom", WARNING, map[string]interface{}{
+		"tripId":       "T1",
+		"stopSequence": 3,
+		"csvRowNumber": 4,
+	})
+	location := n.Location()
+	if location.File != "" || location.RowNumber != 0 || location.PrimaryKey != nil {
+		t.Errorf("Expected no file, row or key without an explicit location, got %+v", location)
+	}
+	if ids := location.EntityIDs("trip"); len(ids) != 1 || ids[0] != "T1" {
+		t.Errorf("Expected trip T1 to be related, got %v", ids)
+	}
+}
+
+func TestConstructorLocations(t *testing.T) {
+	tests := []struct {
+		name   string
+		notice Notice
+		want   NoticeLocation
+	}{
+		{
+			name:   "stop_times row of a trip",
+			notice: NewConsecutiveDuplicateStopsNotice("T1", "S1", 2, 3, 7),
+			want: NoticeLocation{
+				File:            "stop_times.txt",
+				RowNumber:       7,
+				PrimaryKey:      map[string]string{"trip_id": "T1", "stop_sequence": "3"},
+				RelatedEntities: []EntityRef{{Type: "stop", ID: "S1"}, {Type: "trip", ID: "T1"}},
+			},
+		},
+		{
+			name:   "two trips of a block",
+			notice: NewBlockTripsOverlapNotice("B1", "T1", "T2", "WK", "WK", "08:00:00", "09:00:00", "08:30:00", "09:30:00", 2, 5),
+			want: NoticeLocation{
+				File:            "trips.txt",
+				RowNumber:       5,
+				OtherRowNumbers: []int{2},
+				PrimaryKey:      map[string]string{"trip_id": "T2"},
+				RelatedEntities: []EntityRef{{Type: "block", ID: "B1"}, {Type: "service", ID: "WK"}, {Type: "trip", ID: "T1"}, {Type: "trip", ID: "T2"}},
+			},
+		},
+		{
+			name:   "duplicate key",
+			notice: NewDuplicateKeyNotice("stops.txt", "stop_id", "S1", 4, 2),
+			want: NoticeLocation{
+				File:            "stops.txt",
+				RowNumber:       4,
+				OtherRowNumbers: []int{2},
+				FieldName:       "stop_id",
+				PrimaryKey:      map[string]string{"stop_id": "S1"},
+				RelatedEntities: []EntityRef{{Type: "stop", ID: "S1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.notice.Location(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Location() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestConstructorsSetLocation guards against constructors that take a file or row
+// but leave their location to be guessed from the context
+func TestConstructorsSetLocation(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "validation_notices.go", nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse constructors: %v", err)
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "New") {
+			continue
+		}
+		pointsAtRecord := false
+		for _, field := range fn.Type.Params.List {
+			for _, name := range field.Names {
+				lower := strings.ToLower(name.Name)
+				if name.Name == "filename" || (strings.Contains(lower, "row") && fmt.Sprint(field.Type) == "int") {
+					pointsAtRecord = true
+				}
+			}
+		}
+		if !pointsAtRecord {
+			continue
+		}
+
+		located := false
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok {
+				if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "newLocatedNotice" {
+					located = true
+				}
+			}
+			return !located
+		})
+		if !located {
+			t.Errorf("%s takes a file or row but does not set its location", fn.Name.Name)
+		}
+	}
+}
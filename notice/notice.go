@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Notice is the interface for all validation notices
@@ -11,6 +12,7 @@ type Notice interface {
 	Code() string
 	Severity() SeverityLevel
 	Context() map[string]interface{}
+	Location() NoticeLocation
 }
 
 // BaseNotice provides common functionality for all notices
@@ -18,6 +20,7 @@ type BaseNotice struct {
 	code     string
 	severity SeverityLevel
 	context  map[string]interface{}
+	location *NoticeLocation
 }
 
 // NewBaseNotice creates a new base notice
@@ -44,6 +47,41 @@ func (n *BaseNotice) Context() map[string]interface{} {
 	return n.context
 }
 
+// newLocatedNotice creates a base notice pointing at the given location.
+// Related entities are collected from the context and the primary key.
+func newLocatedNotice(code string, severity SeverityLevel, context map[string]interface{}, location NoticeLocation) *BaseNotice {
+	location.RelatedEntities = entitiesFromContext(context, location)
+	return &BaseNotice{
+		code:     code,
+		severity: severity,
+		context:  context,
+		location: &location,
+	}
+}
+
+// Location returns where the notice points in the feed. Notices created without
+// a location only list the entities referenced by their context.
+func (n *BaseNotice) Location() NoticeLocation {
+	if n.location != nil {
+		return *n.location
+	}
+	return NoticeLocation{RelatedEntities: entitiesFromContext(n.context, NoticeLocation{})}
+}
+
+// SetLocation sets the location of the notice, replacing the one set by its constructor
+func (n *BaseNotice) SetLocation(location NoticeLocation) {
+	n.location = &location
+}
+
+// SetPrimaryKey sets the primary key of the record the notice points at, for
+// validators that know a composite key the constructor is not given
+func (n *BaseNotice) SetPrimaryKey(key map[string]string) {
+	location := n.Location()
+	location.PrimaryKey = key
+	location.RelatedEntities = entitiesFromContext(n.context, location)
+	n.location = &location
+}
+
 // GetCode generates a code from a notice type name
 func GetCode(typeName string) string {
 	// Convert from CamelCase to snake_case
@@ -67,6 +105,14 @@ type NoticeContainer struct {
 	noticeCounts map[string]int
 	maxPerType   int
 	mutex        sync.RWMutex
+
+	severityOverrides map[string]SeverityLevel
+	disabledCodes     map[string]bool
+	listener          func(Notice)
+
+	// target receives the notices of a counting container, see NewCountingNoticeContainer
+	target *NoticeContainer
+	kept   int64
 }
 
 // NewNoticeContainer creates a new notice container
@@ -87,20 +133,64 @@ func NewNoticeContainerWithLimit(maxPerType int) *NoticeContainer {
 	}
 }
 
+// NewCountingNoticeContainer creates a container that adds every notice to target
+// and only counts the notices target keeps, see KeptCount. It attributes notices
+// to one validator while they are collected in a shared container. The notices
+// are not stored, so GetNotices returns none.
+func NewCountingNoticeContainer(target *NoticeContainer) *NoticeContainer {
+	return &NoticeContainer{
+		notices:      make([]Notice, 0),
+		noticeCounts: make(map[string]int),
+		target:       target,
+	}
+}
+
+// KeptCount returns the number of notices a counting container passed on to its
+// target that the target kept
+func (nc *NoticeContainer) KeptCount() int {
+	return int(atomic.LoadInt64(&nc.kept))
+}
+
 // AddNotice adds a notice to the container with optional limiting
 func (nc *NoticeContainer) AddNotice(notice Notice) {
+	if nc.target != nil {
+		if nc.target.keep(notice) {
+			atomic.AddInt64(&nc.kept, 1)
+		}
+		return
+	}
+	nc.keep(notice)
+}
+
+// keep adds a notice unless its code is disabled or over the limit and reports whether it was added
+func (nc *NoticeContainer) keep(notice Notice) bool {
 	nc.mutex.Lock()
-	defer nc.mutex.Unlock()
 
 	code := notice.Code()
+	if nc.disabledCodes[code] {
+		nc.mutex.Unlock()
+		return false
+	}
+	if severity, ok := nc.severityOverrides[code]; ok && severity != notice.Severity() {
+		notice = &severityOverrideNotice{Notice: notice, severity: severity}
+	}
 
 	// Check if we've hit the limit for this notice type
 	if nc.maxPerType > 0 && nc.noticeCounts[code] >= nc.maxPerType {
-		return // Skip adding more notices of this type
+		nc.mutex.Unlock()
+		return false // Skip adding more notices of this type
 	}
 
 	nc.notices = append(nc.notices, notice)
 	nc.noticeCounts[code]++
+	listener := nc.listener
+	nc.mutex.Unlock()
+
+	// Call the listener outside the lock so that it can block
+	if listener != nil {
+		listener(notice)
+	}
+	return true
 }
 
 // SetMaxNoticesPerType sets the maximum number of notices per type
@@ -108,6 +198,44 @@ func (nc *NoticeContainer) SetMaxNoticesPerType(max int) {
 	nc.maxPerType = max
 }
 
+// MaxNoticesPerType returns the maximum number of notices kept per type (0 = no limit)
+func (nc *NoticeContainer) MaxNoticesPerType() int {
+	return nc.maxPerType
+}
+
+// SetRuleOverrides makes the container report notices of the given codes with
+// another severity and drop the notices of disabled codes. Call it before adding notices.
+func (nc *NoticeContainer) SetRuleOverrides(severities map[string]SeverityLevel, disabled []string) {
+	nc.mutex.Lock()
+	defer nc.mutex.Unlock()
+
+	nc.severityOverrides = severities
+	nc.disabledCodes = make(map[string]bool, len(disabled))
+	for _, code := range disabled {
+		nc.disabledCodes[code] = true
+	}
+}
+
+// SetListener registers a function called with every notice the container keeps,
+// after severity overrides and limits. It runs on the goroutine adding the notice,
+// outside the container lock, so a blocking listener slows down the validators.
+func (nc *NoticeContainer) SetListener(listener func(Notice)) {
+	nc.mutex.Lock()
+	defer nc.mutex.Unlock()
+	nc.listener = listener
+}
+
+// severityOverrideNotice reports a notice with a configured severity
+type severityOverrideNotice struct {
+	Notice
+	severity SeverityLevel
+}
+
+// Severity returns the configured severity
+func (n *severityOverrideNotice) Severity() SeverityLevel {
+	return n.severity
+}
+
 // GetNotices returns all notices
 func (nc *NoticeContainer) GetNotices() []Notice {
 	nc.mutex.RLock()
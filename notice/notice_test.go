@@ -1,6 +1,7 @@
 package notice
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -251,3 +252,98 @@ func TestNotice_Fields(t *testing.T) {
 		t.Errorf("Expected filename 'routes.txt' in context, got %v", notice.Context()["filename"])
 	}
 }
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected SeverityLevel
+		wantErr  bool
+	}{
+		{"ERROR", ERROR, false},
+		{"warning", WARNING, false},
+		{" Info ", INFO, false},
+		{"fatal", INFO, true},
+		{"", INFO, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			severity, err := ParseSeverity(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if severity != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, severity)
+			}
+		})
+	}
+}
+
+func TestNoticeContainer_SetRuleOverrides(t *testing.T) {
+	container := NewNoticeContainer()
+	container.SetRuleOverrides(map[string]SeverityLevel{"downgraded": INFO}, []string{"disabled"})
+
+	location := NoticeLocation{File: "stops.txt", RowNumber: 3}
+	downgraded := NewBaseNotice("downgraded", ERROR, map[string]interface{}{"stopId": "S1"})
+	downgraded.SetLocation(location)
+	container.AddNotice(downgraded)
+	container.AddNotice(NewBaseNotice("disabled", ERROR, nil))
+	container.AddNotice(NewBaseNotice("unchanged", WARNING, nil))
+
+	notices := container.GetNotices()
+	if len(notices) != 2 {
+		t.Fatalf("Expected the disabled notice to be dropped, got %d notices", len(notices))
+	}
+	if notices[0].Code() != "downgraded" || notices[0].Severity() != INFO {
+		t.Errorf("Expected downgraded notice with INFO severity, got %s %s", notices[0].Code(), notices[0].Severity())
+	}
+	if notices[0].Context()["stopId"] != "S1" || notices[0].Location().RowNumber != location.RowNumber {
+		t.Error("Expected the overridden notice to keep its context and location")
+	}
+	if notices[1].Severity() != WARNING {
+		t.Errorf("Expected unchanged notice to keep WARNING, got %s", notices[1].Severity())
+	}
+	if container.HasErrors() {
+		t.Error("Expected no errors after overrides")
+	}
+}
+
+func TestNoticeContainer_SetListener(t *testing.T) {
+	container := NewNoticeContainerWithLimit(1)
+	container.SetRuleOverrides(nil, []string{"disabled"})
+
+	var received []string
+	container.SetListener(func(n Notice) {
+		// The container must not be locked while the listener runs
+		received = append(received, fmt.Sprintf("%s/%d", n.Code(), len(container.GetNotices())))
+	})
+
+	container.AddNotice(NewBaseNotice("kept", ERROR, nil))
+	container.AddNotice(NewBaseNotice("kept", ERROR, nil)) // over the limit
+	container.AddNotice(NewBaseNotice("disabled", ERROR, nil))
+	container.AddNotice(NewBaseNotice("other", INFO, nil))
+
+	expected := []string{"kept/1", "other/2"}
+	if fmt.Sprint(received) != fmt.Sprint(expected) {
+		t.Errorf("Expected listener calls %v, got %v", expected, received)
+	}
+}
+
+func TestNoticeContainer_Counting(t *testing.T) {
+	target := NewNoticeContainerWithLimit(1)
+	target.SetRuleOverrides(nil, []string{"disabled"})
+	first := NewCountingNoticeContainer(target)
+	second := NewCountingNoticeContainer(target)
+
+	first.AddNotice(NewBaseNotice("kept", ERROR, nil))
+	first.AddNotice(NewBaseNotice("disabled", ERROR, nil))
+	second.AddNotice(NewBaseNotice("kept", ERROR, nil)) // over the limit
+	second.AddNotice(NewBaseNotice("other", WARNING, nil))
+
+	if first.KeptCount() != 1 || second.KeptCount() != 1 {
+		t.Errorf("Expected one kept notice per container, got %d and %d", first.KeptCount(), second.KeptCount())
+	}
+	if len(target.GetNotices()) != 2 || len(first.GetNotices()) != 0 {
+		t.Errorf("Expected the notices in the target only, got %d and %d", len(target.GetNotices()), len(first.GetNotices()))
+	}
+}
@@ -0,0 +1,50 @@
+package notice
+
+import "sort"
+
+//go:generate go run gen_rules.go -root .. -o rules_generated.go
+
+// Rule describes a notice code that the validator can emit
+type Rule struct {
+	// Code is the notice code, e.g. "duplicate_key"
+	Code string
+	// Severity is the highest severity the notice is emitted with
+	Severity SeverityLevel
+	// VariableSeverity is set when validators choose the severity at runtime
+	VariableSeverity bool
+	// Category is the validator package emitting the notice ("system" for the
+	// validation pipeline itself, "unused" when nothing emits it)
+	Category string
+	// Constructors lists the New...Notice functions creating the notice
+	Constructors []string
+	// Validators lists the emitting validators as "package.Type"
+	Validators []string
+	// Context lists the keys of the notice context sorted by name; nil when
+	// validators build the context themselves and the keys are not known
+	Context []ContextField
+}
+
+// ContextField describes a key of a notice context
+type ContextField struct {
+	// Name is the context key, e.g. "csvRowNumber"
+	Name string
+	// Type is the JSON type of the value: "string", "integer", "number",
+	// "boolean" or "array", or empty when it is not fixed
+	Type string
+}
+
+// Rules returns every registered rule sorted by code
+func Rules() []Rule {
+	rules := make([]Rule, len(ruleRegistry))
+	copy(rules, ruleRegistry)
+	return rules
+}
+
+// LookupRule returns the rule registered for a notice code
+func LookupRule(code string) (Rule, bool) {
+	i := sort.Search(len(ruleRegistry), func(i int) bool { return ruleRegistry[i].Code >= code })
+	if i < len(ruleRegistry) && ruleRegistry[i].Code == code {
+		return ruleRegistry[i], true
+	}
+	return Rule{}, false
+}
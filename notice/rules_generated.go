@@ -0,0 +1,328 @@
+// Code generated by gen_rules.go; DO NOT EDIT.
+
+package notice
+
+var ruleRegistry = []Rule{
+	{Code: "agency_mixed_route_types", Severity: INFO, Category: "entity", Constructors: []string{"NewAgencyMixedRouteTypesNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "routeTypes", Type: "array"}, {Name: "typeCount", Type: "integer"}}},
+	{Code: "all_caps_headsign", Severity: INFO, Category: "entity", Constructors: []string{"NewAllCapsHeadsignNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headsign", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "all_stops_no_drop_off", Severity: ERROR, Category: "relationship", Constructors: []string{"NewAllStopsNoDropOffNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "tripId", Type: "string"}}},
+	{Code: "all_stops_no_pickup", Severity: ERROR, Category: "relationship", Constructors: []string{"NewAllStopsNoPickupNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "tripId", Type: "string"}}},
+	{Code: "archive_duplicate_file_name", Severity: ERROR, Category: "core", Constructors: []string{"NewArchiveDuplicateFileNameNotice"}, Validators: []string{"core.ArchiveLayoutValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "ignoredPaths", Type: "array"}, {Name: "usedPath", Type: "string"}}},
+	{Code: "archive_file_in_subfolder", Severity: ERROR, Category: "core", Constructors: []string{"NewArchiveFileInSubfolderNotice"}, Validators: []string{"core.ArchiveLayoutValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "path", Type: "string"}, {Name: "used", Type: "boolean"}}},
+	{Code: "archive_limit_exceeded", Severity: ERROR, Category: "system", Constructors: []string{"NewArchiveLimitExceededNotice"}, Validators: []string{"gtfsvalidator.internalValidator"}, Context: []ContextField{{Name: "actual", Type: "integer"}, {Name: "limit", Type: "string"}, {Name: "max", Type: "integer"}, {Name: "path", Type: "string"}}},
+	{Code: "archive_nested_zip", Severity: WARNING, Category: "core", Constructors: []string{"NewArchiveNestedZipNotice"}, Validators: []string{"core.ArchiveLayoutValidator"}, Context: []ContextField{{Name: "path", Type: "string"}}},
+	{Code: "archive_non_utf8_file_name", Severity: WARNING, Category: "core", Constructors: []string{"NewArchiveNonUTF8FileNameNotice"}, Validators: []string{"core.ArchiveLayoutValidator"}, Context: []ContextField{{Name: "path", Type: "string"}}},
+	{Code: "archive_os_metadata", Severity: WARNING, Category: "core", Constructors: []string{"NewArchiveOSMetadataNotice"}, Validators: []string{"core.ArchiveLayoutValidator"}, Context: []ContextField{{Name: "path", Type: "string"}}},
+	{Code: "attribution_all_roles", Severity: INFO, Category: "entity", Constructors: []string{"NewAttributionAllRolesNotice"}, Validators: []string{"entity.AttributionWithoutRoleValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "organizationName", Type: "string"}}},
+	{Code: "attribution_role_name_mismatch", Severity: INFO, Category: "entity", Constructors: []string{"NewAttributionRoleNameMismatchNotice"}, Validators: []string{"entity.AttributionWithoutRoleValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "expectedRole", Type: "string"}, {Name: "organizationName", Type: "string"}}},
+	{Code: "attribution_without_role", Severity: ERROR, Category: "entity", Constructors: []string{"NewAttributionWithoutRoleNotice"}, Validators: []string{"entity.AttributionWithoutRoleValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "organizationName", Type: "string"}}},
+	{Code: "bike_wheelchair_accessibility_mismatch", Severity: INFO, Category: "entity", Constructors: []string{"NewBikeWheelchairAccessibilityMismatchNotice"}, Validators: []string{"entity.BikesAllowanceValidator"}, Context: []ContextField{{Name: "bikesAllowed", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "tripId", Type: "string"}, {Name: "wheelchairAccessible", Type: "integer"}}},
+	{Code: "block_multiple_routes", Severity: INFO, Category: "entity", Constructors: []string{"NewBlockMultipleRoutesNotice"}, Validators: []string{"entity.TripBlockIdValidator"}, Context: []ContextField{{Name: "blockId", Type: "string"}, {Name: "routeIds", Type: "array"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "block_service_mismatch", Severity: ERROR, Category: "entity", Constructors: []string{"NewBlockServiceMismatchNotice"}, Validators: []string{"entity.TripBlockIdValidator"}, Context: []ContextField{{Name: "blockId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "service1Id", Type: "string"}, {Name: "service2Id", Type: "string"}, {Name: "trip1Id", Type: "string"}, {Name: "trip2Id", Type: "string"}}},
+	{Code: "block_too_many_trips", Severity: WARNING, Category: "entity", Constructors: []string{"NewBlockTooManyTripsNotice"}, Validators: []string{"entity.TripBlockIdValidator"}, Context: []ContextField{{Name: "blockId", Type: "string"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "block_trips_overlap", Severity: ERROR, Category: "entity", Constructors: []string{"NewBlockTripsOverlapNotice"}, Validators: []string{"business.BlockOverlappingValidator", "entity.TripBlockIdValidator"}, Context: []ContextField{{Name: "blockId", Type: "string"}, {Name: "service1Id", Type: "string"}, {Name: "service2Id", Type: "string"}, {Name: "trip1EndTime", Type: "string"}, {Name: "trip1Id", Type: "string"}, {Name: "trip1RowNumber", Type: "integer"}, {Name: "trip1StartTime", Type: "string"}, {Name: "trip2EndTime", Type: "string"}, {Name: "trip2Id", Type: "string"}, {Name: "trip2RowNumber", Type: "integer"}, {Name: "trip2StartTime", Type: "string"}}},
+	{Code: "calendar_end_before_start", Severity: ERROR, Category: "business", Constructors: []string{"NewCalendarEndBeforeStartNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "calendar_no_days_selected", Severity: ERROR, Category: "business", Constructors: []string{"NewCalendarNoDaysSelectedNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "child_station_too_far_from_parent", Severity: WARNING, Category: "business", Constructors: []string{"NewChildStationTooFarFromParentNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "childId", Type: "string"}, {Name: "distance", Type: "number"}, {Name: "parentId", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "circular_station_reference", Severity: ERROR, Category: "entity", Constructors: []string{"NewCircularStationReferenceNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "close_stops_not_possible_transfer", Severity: WARNING, Category: "business", Constructors: []string{"NewCloseStopsNotPossibleTransferNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "conflicting_attribution_scope", Severity: ERROR, Category: "relationship", Constructors: []string{"NewConflictingAttributionScopeNotice"}, Validators: []string{"relationship.AttributionValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}}},
+	{Code: "conflicting_calendar_exception", Severity: ERROR, Category: "entity", Constructors: []string{"NewConflictingCalendarExceptionNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber1", Type: "integer"}, {Name: "csvRowNumber2", Type: "integer"}, {Name: "date", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "conflicting_fare_rule_fields", Severity: WARNING, Category: "fare", Constructors: []string{"NewConflictingFareRuleFieldsNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}}},
+	{Code: "consecutive_duplicate_stops", Severity: WARNING, Category: "entity", Constructors: []string{"NewConsecutiveDuplicateStopsNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "sequence1", Type: "integer"}, {Name: "sequence2", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "cross_feed_id_collision", Severity: ERROR, Category: "crossfeed", Constructors: []string{"NewCrossFeedIDCollisionNotice"}, Validators: []string{"crossfeed.IDCollisionValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "feedName", Type: "string"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "otherCsvRowNumber", Type: "integer"}, {Name: "otherFeedName", Type: "string"}}},
+	{Code: "cross_feed_inconsistent_timezone", Severity: WARNING, Category: "crossfeed", Constructors: []string{"NewCrossFeedInconsistentTimezoneNotice"}, Validators: []string{"crossfeed.TimezoneConsistencyValidator"}, Context: []ContextField{{Name: "agencyTimezone", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "feedName", Type: "string"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "otherCsvRowNumber", Type: "integer"}, {Name: "otherFeedName", Type: "string"}, {Name: "otherTimezone", Type: "string"}}},
+	{Code: "cross_feed_nearby_stops", Severity: WARNING, Category: "crossfeed", Constructors: []string{"NewCrossFeedNearbyStopsNotice"}, Validators: []string{"crossfeed.NearbyStopsValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "feedName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "otherCsvRowNumber", Type: "integer"}, {Name: "otherFeedName", Type: "string"}, {Name: "otherStopId", Type: "string"}, {Name: "otherStopName", Type: "string"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "cross_trip_frequency_overlap", Severity: WARNING, Category: "business", Constructors: []string{"NewCrossTripFrequencyOverlapNotice"}, Validators: []string{"business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "end1", Type: "string"}, {Name: "end2", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "start1", Type: "string"}, {Name: "start2", Type: "string"}, {Name: "trip1Id", Type: "string"}, {Name: "trip2Id", Type: "string"}}},
+	{Code: "csv_bare_quote", Severity: WARNING, Category: "core", Constructors: []string{"NewCSVBareQuoteNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "column", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "csv_invalid_quote", Severity: WARNING, Category: "core", Constructors: []string{"NewCSVInvalidQuoteNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "column", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "csv_missing_trailing_newline", Severity: INFO, Category: "core", Constructors: []string{"NewCSVMissingTrailingNewlineNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "csv_mixed_line_endings", Severity: WARNING, Category: "core", Constructors: []string{"NewCSVMixedLineEndingsNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "crlfLines", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "lfLines", Type: "integer"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "csv_nul_byte", Severity: ERROR, Category: "core", Constructors: []string{"NewCSVNULByteNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "column", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "csv_parsing_failed", Severity: ERROR, Category: "core", Constructors: []string{"NewCSVParsingFailedNotice"}, Validators: []string{"core.InvalidRowValidator", "validator.FileStructureValidator"}},
+	{Code: "csv_unterminated_quote", Severity: ERROR, Category: "core", Constructors: []string{"NewCSVUnterminatedQuoteNotice"}, Validators: []string{"core.StrictCSVValidator"}, Context: []ContextField{{Name: "column", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "lineNumber", Type: "integer"}}},
+	{Code: "dark_text_on_dark_background", Severity: WARNING, Category: "entity", Constructors: []string{"NewDarkTextOnDarkBackgroundNotice"}, Validators: []string{"entity.RouteColorContrastValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "decreasing_or_equal_shape_distance", Severity: ERROR, Category: "relationship", Constructors: []string{"NewDecreasingOrEqualShapeDistanceNotice"}, Validators: []string{"relationship.ShapeDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "prevCsvRowNumber", Type: "integer"}, {Name: "prevShapeDistTraveled", Type: "number"}, {Name: "prevShapePtSequence", Type: "integer"}, {Name: "shapeDistTraveled", Type: "number"}, {Name: "shapeId", Type: "string"}, {Name: "shapePtSequence", Type: "integer"}}},
+	{Code: "decreasing_or_equal_stop_time_distance", Severity: ERROR, Category: "relationship", Constructors: []string{"NewDecreasingOrEqualStopTimeDistanceNotice"}, Validators: []string{"relationship.StopTimeSequenceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "prevCsvRowNumber", Type: "integer"}, {Name: "prevShapeDistTraveled", Type: "number"}, {Name: "prevStopSequence", Type: "integer"}, {Name: "shapeDistTraveled", Type: "number"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "decreasing_shape_distance", Severity: ERROR, Category: "entity", Constructors: []string{"NewDecreasingShapeDistanceNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentDistance", Type: "number"}, {Name: "previousDistance", Type: "number"}, {Name: "shapeId", Type: "string"}, {Name: "shapePtSequence", Type: "integer"}}},
+	{Code: "deprecated_route_type", Severity: WARNING, Category: "entity", Constructors: []string{"NewDeprecatedRouteTypeNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "recommendedType", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "duplicate_attribution_scope", Severity: WARNING, Category: "relationship", Constructors: []string{"NewDuplicateAttributionScopeNotice"}, Validators: []string{"relationship.AttributionValidator"}, Context: []ContextField{{Name: "attributionId1", Type: "string"}, {Name: "attributionId2", Type: "string"}, {Name: "csvRowNumber1", Type: "integer"}, {Name: "csvRowNumber2", Type: "integer"}, {Name: "scope", Type: "string"}}},
+	{Code: "duplicate_calendar_date", Severity: ERROR, Category: "business", Constructors: []string{"NewDuplicateCalendarDateNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "date", Type: "string"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "firstRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "duplicate_calendar_exception", Severity: WARNING, Category: "entity", Constructors: []string{"NewDuplicateCalendarExceptionNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber1", Type: "integer"}, {Name: "csvRowNumber2", Type: "integer"}, {Name: "date", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "duplicate_composite_key", Severity: ERROR, Category: "core", Constructors: []string{"NewDuplicateCompositeKeyNotice"}, Validators: []string{"core.DuplicateKeyValidator"}, Context: []ContextField{{Name: "duplicateRow", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "firstRow", Type: "integer"}, {Name: "keyFields", Type: "string"}, {Name: "keyValue", Type: "string"}}},
+	{Code: "duplicate_header", Severity: ERROR, Category: "core", Constructors: []string{"NewDuplicateHeaderNotice"}, Validators: []string{"core.DuplicateHeaderValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "headerName", Type: "string"}, {Name: "positions", Type: "array"}}},
+	{Code: "duplicate_key", Severity: ERROR, Category: "core", Constructors: []string{"NewDuplicateKeyNotice"}, Validators: []string{"core.DuplicateKeyValidator", "entity.PrimaryKeyValidator", "relationship.AttributionValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRow", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: ""}, {Name: "filename", Type: "string"}}},
+	{Code: "duplicate_level_index", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewDuplicateLevelIndexNotice"}, Validators: []string{"accessibility.LevelValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "levelId", Type: "string"}, {Name: "levelIndex", Type: "number"}}},
+	{Code: "duplicate_pathway", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewDuplicatePathwayNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "pathwayId", Type: "string"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "duplicate_route_long_name", Severity: WARNING, Category: "entity", Constructors: []string{"NewDuplicateRouteLongNameNotice"}, Validators: []string{"entity.DuplicateRouteNameValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "firstRouteId", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeLongName", Type: "string"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "duplicate_route_name_combination", Severity: WARNING, Category: "entity", Constructors: []string{"NewDuplicateRouteNameCombinationNotice"}, Validators: []string{"entity.DuplicateRouteNameValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "firstRouteId", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeLongName", Type: "string"}, {Name: "routeShortName", Type: "string"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "duplicate_route_short_name", Severity: WARNING, Category: "entity", Constructors: []string{"NewDuplicateRouteShortNameNotice"}, Validators: []string{"entity.DuplicateRouteNameValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "firstRouteId", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeShortName", Type: "string"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "duplicate_shape_point", Severity: WARNING, Category: "entity", Constructors: []string{"NewDuplicateShapePointNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "previousSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "duplicate_shape_sequence", Severity: ERROR, Category: "entity", Constructors: []string{"NewDuplicateShapeSequenceNotice"}, Validators: []string{"entity.ShapeValidator", "relationship.ShapeDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "shapeId", Type: "string"}, {Name: "shapePtSequence", Type: "integer"}}},
+	{Code: "duplicate_stop_in_trip", Severity: WARNING, Category: "relationship", Constructors: []string{"NewDuplicateStopInTripNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "duplicate_stop_sequence", Severity: ERROR, Category: "entity", Constructors: []string{"NewDuplicateStopSequenceNotice"}, Validators: []string{"entity.TripPatternValidator", "relationship.StopTimeSequenceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "duplicate_transfer", Severity: ERROR, Category: "business", Constructors: []string{"NewDuplicateTransferNotice"}, Validators: []string{"business.TransferTimingValidator", "business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duplicateRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "empty_fare_rule", Severity: WARNING, Category: "fare", Constructors: []string{"NewEmptyFareRuleNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}}},
+	{Code: "empty_file", Severity: WARNING, Category: "core", Constructors: []string{"NewEmptyFileNotice"}, Validators: []string{"core.EmptyFileValidator", "validator.FileStructureValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}}},
+	{Code: "equal_shape_distance", Severity: WARNING, Category: "entity", Constructors: []string{"NewEqualShapeDistanceNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "previousSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "excessive_price_precision", Severity: WARNING, Category: "fare", Constructors: []string{"NewExcessivePricePrecisionNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "decimals", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "price", Type: "string"}}},
+	{Code: "excessive_punctuation_headsign", Severity: WARNING, Category: "entity", Constructors: []string{"NewExcessivePunctuationHeadsignNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headsign", Type: "string"}, {Name: "punctuationCount", Type: "integer"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "excessive_route_pattern_variations", Severity: WARNING, Category: "relationship", Constructors: []string{"NewExcessiveRoutePatternVariationsNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "directionId", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "tripCount", Type: "integer"}, {Name: "variations", Type: "integer"}}},
+	{Code: "excessive_service_variety", Severity: WARNING, Category: "relationship", Constructors: []string{"NewExcessiveServiceVarietyNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceCount", Type: "integer"}}},
+	{Code: "excessive_travel_speed", Severity: WARNING, Category: "business", Constructors: []string{"NewExcessiveTravelSpeedNotice"}, Validators: []string{"business.TravelSpeedValidator"}, Context: []ContextField{{Name: "distance", Type: "number"}, {Name: "fromRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "fromStopSequence", Type: "integer"}, {Name: "routeType", Type: "integer"}, {Name: "speed", Type: "number"}, {Name: "speedLimit", Type: "number"}, {Name: "timeDiff", Type: "integer"}, {Name: "toRowNumber", Type: "integer"}, {Name: "toStopId", Type: "string"}, {Name: "toStopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "excessive_whitespace", Severity: INFO, Category: "core", Constructors: []string{"NewExcessiveWhitespaceNotice"}, Validators: []string{"core.LeadingTrailingWhitespaceValidator"}, Context: []ContextField{{Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "expired_feed", Severity: WARNING, Category: "meta", Constructors: []string{"NewExpiredFeedNotice"}, Validators: []string{"meta.FeedInfoValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endDate", Type: "string"}}},
+	{Code: "expired_service", Severity: WARNING, Category: "entity", Constructors: []string{"NewExpiredServiceNotice"}, Validators: []string{"business.ServiceCalendarValidator", "entity.CalendarConsistencyValidator", "entity.ServiceValidationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "feed_expiration_date_30_days", Severity: WARNING, Category: "business", Constructors: []string{"NewFeedExpirationDate30DaysNotice"}, Validators: []string{"business.FeedExpirationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentDate", Type: "string"}, {Name: "feedEndDate", Type: "string"}, {Name: "suggestedExpirationDate", Type: "string"}}},
+	{Code: "feed_expiration_date_7_days", Severity: WARNING, Category: "business", Constructors: []string{"NewFeedExpirationDate7DaysNotice"}, Validators: []string{"business.FeedExpirationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentDate", Type: "string"}, {Name: "feedEndDate", Type: "string"}, {Name: "suggestedExpirationDate", Type: "string"}}},
+	{Code: "feed_expired", Severity: ERROR, Category: "business", Constructors: []string{"NewFeedExpiredNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysExpired", Type: "integer"}, {Name: "endDate", Type: "string"}}},
+	{Code: "feed_expires_within_30_days", Severity: WARNING, Category: "business", Constructors: []string{"NewFeedExpiresWithin30DaysNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysUntilExpiration", Type: "integer"}, {Name: "endDate", Type: "string"}}},
+	{Code: "feed_expires_within_7_days", Severity: ERROR, Category: "business", Constructors: []string{"NewFeedExpiresWithin7DaysNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysUntilExpiration", Type: "integer"}, {Name: "endDate", Type: "string"}}},
+	{Code: "feed_info_end_date_before_start_date", Severity: ERROR, Category: "meta", Constructors: []string{"NewFeedInfoEndDateBeforeStartDateNotice"}, Validators: []string{"meta.FeedInfoValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "feed_info_end_date_missing", Severity: WARNING, Category: "business", Constructors: []string{"NewFeedInfoEndDateMissingNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}}},
+	{Code: "first_stop_no_pickup", Severity: WARNING, Category: "relationship", Constructors: []string{"NewFirstStopNoPickupNotice"}, Validators: []string{"business.ScheduleConsistencyValidator", "relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "foreign_key_violation", Severity: ERROR, Category: "relationship", Constructors: []string{"NewForeignKeyViolationNotice"}, Validators: []string{"accessibility.PathwayValidator", "business.FrequencyValidator", "business.TransferValidator", "fare.FareValidator", "relationship.AttributionValidator", "relationship.ForeignKeyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "referencedField", Type: "string"}, {Name: "referencedTable", Type: "string"}}},
+	{Code: "fragmented_network", Severity: WARNING, Category: "business", Constructors: []string{"NewFragmentedNetworkNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "componentCount", Type: "integer"}, {Name: "mainComponentSize", Type: "integer"}, {Name: "totalStops", Type: "integer"}}},
+	{Code: "frequency_duration_shorter_than_headway", Severity: ERROR, Category: "business", Constructors: []string{"NewFrequencyDurationShorterThanHeadwayNotice"}, Validators: []string{"business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duration", Type: "integer"}, {Name: "headway", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "frequent_headsign_changes", Severity: WARNING, Category: "entity", Constructors: []string{"NewFrequentHeadsignChangesNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "changeCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "future_feed_start_date", Severity: WARNING, Category: "meta", Constructors: []string{"NewFutureFeedStartDateNotice"}, Validators: []string{"meta.FeedInfoValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "startDate", Type: "string"}}},
+	{Code: "future_service", Severity: WARNING, Category: "entity", Constructors: []string{"NewFutureServiceNotice"}, Validators: []string{"business.ServiceCalendarValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "generic_stop_name", Severity: WARNING, Category: "entity", Constructors: []string{"NewGenericStopNameNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "geospatial_summary", Severity: INFO, Category: "business", Constructors: []string{"NewGeospatialSummaryNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "clusterCount", Type: "integer"}, {Name: "totalShapes", Type: "integer"}, {Name: "totalStops", Type: "integer"}}},
+	{Code: "headsign_change_within_trip", Severity: INFO, Category: "entity", Constructors: []string{"NewHeadsignChangeWithinTripNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentHeadsign", Type: "string"}, {Name: "currentSequence", Type: "integer"}, {Name: "prevHeadsign", Type: "string"}, {Name: "prevSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "high_route_type_diversity", Severity: INFO, Category: "relationship", Constructors: []string{"NewHighRouteTypeDiversityNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "routeCount", Type: "integer"}, {Name: "routeTypes", Type: "integer"}}},
+	{Code: "high_stop_density_area", Severity: INFO, Category: "business", Constructors: []string{"NewHighStopDensityAreaNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "centerLat", Type: "number"}, {Name: "centerLon", Type: "number"}, {Name: "radius", Type: "number"}, {Name: "stopCount", Type: "integer"}}},
+	{Code: "inactive_service_current_month", Severity: WARNING, Category: "business", Constructors: []string{"NewInactiveServiceCurrentMonthNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "serviceId", Type: "string"}}},
+	{Code: "incomplete_shape_distance", Severity: INFO, Category: "relationship", Constructors: []string{"NewIncompleteShapeDistanceNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "missingCount", Type: "integer"}, {Name: "pointsWithDistance", Type: "integer"}, {Name: "shapeId", Type: "string"}, {Name: "totalPoints", Type: "integer"}}},
+	{Code: "inconsistent_bidirectional_pathway", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewInconsistentBidirectionalPathwayNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber1", Type: "integer"}, {Name: "csvRowNumber2", Type: "integer"}, {Name: "pathwayId1", Type: "string"}, {Name: "pathwayId2", Type: "string"}}},
+	{Code: "inconsistent_bidirectional_transfer", Severity: WARNING, Category: "business", Constructors: []string{"NewInconsistentBidirectionalTransferNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}, {Name: "transferType1", Type: "integer"}, {Name: "transferType2", Type: "integer"}}},
+	{Code: "inconsistent_shape_distance", Severity: WARNING, Category: "entity", Constructors: []string{"NewInconsistentShapeDistanceNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "shapeId", Type: "string"}, {Name: "shapePtSequence", Type: "integer"}}},
+	{Code: "inconsistent_stop_time_shape_distance", Severity: WARNING, Category: "relationship", Constructors: []string{"NewInconsistentStopTimeShapeDistanceNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "missingCount", Type: "integer"}, {Name: "totalCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "insufficient_coordinate_precision", Severity: WARNING, Category: "core", Constructors: []string{"NewInsufficientCoordinatePrecisionNotice"}, Validators: []string{"business.GeospatialValidator", "core.CoordinateValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "decimals", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "insufficient_service_next_30_days", Severity: WARNING, Category: "business", Constructors: []string{"NewInsufficientServiceNext30DaysNotice"}, Validators: []string{"business.DateTripsValidator"}, Context: []ContextField{{Name: "daysWithService", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "serviceRatio", Type: "number"}, {Name: "startDate", Type: "string"}, {Name: "totalDays", Type: "integer"}}},
+	{Code: "insufficient_service_next_7_days", Severity: WARNING, Category: "business", Constructors: []string{"NewInsufficientServiceNext7DaysNotice"}, Validators: []string{"business.DateTripsValidator"}, Context: []ContextField{{Name: "daysWithService", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "startDate", Type: "string"}, {Name: "totalDays", Type: "integer"}}},
+	{Code: "insufficient_shape_points", Severity: ERROR, Category: "entity", Constructors: []string{"NewInsufficientShapePointsNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "pointCount", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "insufficient_stop_times", Severity: ERROR, Category: "entity", Constructors: []string{"NewInsufficientStopTimesNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "stopCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_agency_reference", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidAgencyReferenceNotice"}, Validators: []string{"entity.AgencyConsistencyValidator"}, Context: []ContextField{{Name: "agencyId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "routeId", Type: "string"}}},
+	{Code: "invalid_bidirectional", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidBidirectionalNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "isBidirectional", Type: "integer"}, {Name: "pathwayId", Type: "string"}}},
+	{Code: "invalid_bikes_allowed", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidBikesAllowedNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "bikesAllowed", Type: "integer"}, {Name: "rowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_bikes_allowed_value", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidBikesAllowedValueNotice"}, Validators: []string{"entity.BikesAllowanceValidator"}, Context: []ContextField{{Name: "bikesAllowed", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_color", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidColorNotice"}, Validators: []string{"entity.RouteConsistencyValidator"}, Context: []ContextField{{Name: "colorValue", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "routeId", Type: "string"}}},
+	{Code: "invalid_coordinate", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidCoordinateNotice"}, Validators: []string{"core.CoordinateValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "reason", Type: "string"}}},
+	{Code: "invalid_currency_code", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidCurrencyCodeNotice"}, Validators: []string{"core.CurrencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currencyCode", Type: "string"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "reason", Type: "string"}}},
+	{Code: "invalid_date_format", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidDateFormatNotice"}, Validators: []string{"core.DateFormatValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "dateValue", Type: "string"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "invalid_day_value", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidDayValueNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "field", Type: "string"}, {Name: "rowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}, {Name: "value", Type: "string"}}},
+	{Code: "invalid_direction_id", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidDirectionIdNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "directionId", Type: "integer"}, {Name: "rowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_email", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidEmailNotice"}, Validators: []string{"core.FieldFormatValidator", "meta.FeedInfoValidator", "relationship.AttributionValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "invalid_exact_times", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidExactTimesNotice"}, Validators: []string{"business.FrequencyValidator", "core.InvalidRowValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "exactTimes", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_exception_type", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidExceptionTypeNotice"}, Validators: []string{"business.ServiceCalendarValidator", "core.InvalidRowValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "date", Type: "string"}, {Name: "exceptionType", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "invalid_fare_price", Severity: ERROR, Category: "fare", Constructors: []string{"NewInvalidFarePriceNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "price", Type: "string"}, {Name: "reason", Type: "string"}}},
+	{Code: "invalid_field_format", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidFieldFormatNotice"}, Validators: []string{"core.FieldFormatValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "expectedFormat", Type: "string"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "invalid_frequency_time_range", Severity: ERROR, Category: "business", Constructors: []string{"NewInvalidFrequencyTimeRangeNotice"}, Validators: []string{"business.FrequencyValidator", "business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endTime", Type: "string"}, {Name: "startTime", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_headway", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidHeadwayNotice"}, Validators: []string{"business.FrequencyValidator", "core.InvalidRowValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headwaySecs", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_language_code", Severity: WARNING, Category: "meta", Constructors: []string{"NewInvalidLanguageCodeNotice"}, Validators: []string{"meta.FeedInfoValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "languageCode", Type: "string"}}},
+	{Code: "invalid_latitude", Severity: ERROR, Category: "business", Constructors: []string{"NewInvalidLatitudeNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "latitude", Type: "number"}, {Name: "rowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "invalid_location_type", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidLocationTypeNotice"}, Validators: []string{"core.InvalidRowValidator", "entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "locationType", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "invalid_longitude", Severity: ERROR, Category: "business", Constructors: []string{"NewInvalidLongitudeNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "longitude", Type: "number"}, {Name: "rowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "invalid_min_width", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidMinWidthNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "minWidth", Type: "number"}, {Name: "pathwayId", Type: "string"}}},
+	{Code: "invalid_parent_station_reference", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidParentStationReferenceNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "parentStation", Type: "string"}, {Name: "stopId", Type: "string"}}},
+	{Code: "invalid_parent_station_type", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidParentStationTypeNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "parentLocationType", Type: "integer"}, {Name: "parentStation", Type: "string"}, {Name: "stopId", Type: "string"}}},
+	{Code: "invalid_pathway_length", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidPathwayLengthNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "length", Type: "number"}, {Name: "pathwayId", Type: "string"}}},
+	{Code: "invalid_pathway_mode", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidPathwayModeNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pathwayId", Type: "string"}, {Name: "pathwayMode", Type: "integer"}}},
+	{Code: "invalid_payment_method", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidPaymentMethodNotice"}, Validators: []string{"core.InvalidRowValidator", "fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "paymentMethod", Type: "integer"}}},
+	{Code: "invalid_route_type", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidRouteTypeNotice"}, Validators: []string{"core.InvalidRowValidator", "entity.RouteConsistencyValidator", "entity.RouteTypeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "reason", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeType", Type: "string"}}},
+	{Code: "invalid_row", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidRowNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "reason", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "invalid_service_date_range", Severity: ERROR, Category: "entity", Constructors: []string{"NewInvalidServiceDateRangeNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator", "entity.ServiceValidationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "invalid_stair_count", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidStairCountNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pathwayId", Type: "string"}, {Name: "stairCount", Type: "integer"}}},
+	{Code: "invalid_time_format", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidTimeFormatNotice"}, Validators: []string{"core.TimeFormatValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "timeValue", Type: "string"}}},
+	{Code: "invalid_timepoint", Severity: ERROR, Category: "relationship", Constructors: []string{"NewInvalidTimepointNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "timepoint", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "invalid_timezone", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidTimezoneNotice"}, Validators: []string{"core.FieldFormatValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "invalid_transfer_duration", Severity: ERROR, Category: "fare", Constructors: []string{"NewInvalidTransferDurationNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "transferDuration", Type: "integer"}}},
+	{Code: "invalid_transfer_type", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidTransferTypeNotice"}, Validators: []string{"business.TransferTimingValidator", "business.TransferValidator", "core.InvalidRowValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}, {Name: "transferType", Type: "integer"}}},
+	{Code: "invalid_transfers", Severity: WARNING, Category: "core", Constructors: []string{"NewInvalidTransfersNotice"}, Validators: []string{"core.InvalidRowValidator", "fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "transfers", Type: "integer"}}},
+	{Code: "invalid_traversal_time", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewInvalidTraversalTimeNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pathwayId", Type: "string"}, {Name: "traversalTime", Type: "integer"}}},
+	{Code: "invalid_url", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidURLNotice"}, Validators: []string{"core.FieldFormatValidator", "entity.RouteConsistencyValidator", "meta.FeedInfoValidator", "relationship.AttributionValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "invalid_utf8_sequence", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidUTF8SequenceNotice"}, Validators: []string{"core.EncodingValidator"}, Context: []ContextField{{Name: "columnNumber", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "invalidBytes", Type: "string"}}},
+	{Code: "invalid_wheelchair_accessible", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidWheelchairAccessibleNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}, {Name: "wheelchairAccessible", Type: "integer"}}},
+	{Code: "invalid_wheelchair_boarding", Severity: ERROR, Category: "core", Constructors: []string{"NewInvalidWheelchairBoardingNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "wheelchairBoarding", Type: "integer"}}},
+	{Code: "irregular_headway", Severity: WARNING, Category: "unused", Constructors: []string{"NewIrregularHeadwayNotice"}, Validators: nil, Context: []ContextField{{Name: "averageHeadway", Type: "number"}, {Name: "headwayCount", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "variance", Type: "number"}}},
+	{Code: "isolated_stop", Severity: WARNING, Category: "business", Constructors: []string{"NewIsolatedStopNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "stopId", Type: "string"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "large_shape_distance_jump", Severity: WARNING, Category: "relationship", Constructors: []string{"NewLargeShapeDistanceJumpNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "geoDistance", Type: "number"}, {Name: "jump", Type: "number"}, {Name: "prevSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "last_stop_no_drop_off", Severity: WARNING, Category: "relationship", Constructors: []string{"NewLastStopNoDropOffNotice"}, Validators: []string{"business.ScheduleConsistencyValidator", "relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "leading_whitespace", Severity: WARNING, Category: "core", Constructors: []string{"NewLeadingWhitespaceNotice"}, Validators: []string{"core.LeadingTrailingWhitespaceValidator"}, Context: []ContextField{{Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "light_text_on_light_background", Severity: WARNING, Category: "entity", Constructors: []string{"NewLightTextOnLightBackgroundNotice"}, Validators: []string{"entity.RouteColorContrastValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "limited_service_variety", Severity: INFO, Category: "relationship", Constructors: []string{"NewLimitedServiceVarietyNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "long_distance_transfer", Severity: WARNING, Category: "business", Constructors: []string{"NewLongDistanceTransferNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}, {Name: "transferTypeDesc", Type: "string"}}},
+	{Code: "long_service_span", Severity: WARNING, Category: "unused", Constructors: []string{"NewLongServiceSpanNotice"}, Validators: nil, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "serviceSpan", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "long_trip_pattern", Severity: INFO, Category: "entity", Constructors: []string{"NewLongTripPatternNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "patternId", Type: "string"}, {Name: "stopCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "long_zone_id", Severity: WARNING, Category: "entity", Constructors: []string{"NewLongZoneIDNotice"}, Validators: []string{"entity.ZoneValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "length", Type: "integer"}, {Name: "zoneId", Type: "string"}}},
+	{Code: "loop_route", Severity: INFO, Category: "entity", Constructors: []string{"NewLoopRouteNotice"}, Validators: []string{"entity.TripPatternValidator", "relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "firstRowNumber", Type: "integer"}, {Name: "lastRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "low_frequency_service", Severity: INFO, Category: "business", Constructors: []string{"NewLowFrequencyServiceNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "activeDays", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "low_network_connectivity", Severity: WARNING, Category: "business", Constructors: []string{"NewLowNetworkConnectivityNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "avgConnectivity", Type: "number"}, {Name: "totalEdges", Type: "integer"}, {Name: "totalStops", Type: "integer"}}},
+	{Code: "low_route_usage", Severity: WARNING, Category: "relationship", Constructors: []string{"NewLowRouteUsageNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "low_service_usage", Severity: INFO, Category: "business", Constructors: []string{"NewLowServiceUsageNotice"}, Validators: []string{"business.ServiceConsistencyValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "low_stop_clustering", Severity: INFO, Category: "business", Constructors: []string{"NewLowStopClusteringNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "clusterCount", Type: "integer"}, {Name: "totalStops", Type: "integer"}}},
+	{Code: "low_timepoint_coverage", Severity: WARNING, Category: "relationship", Constructors: []string{"NewLowTimepointCoverageNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "coverage", Type: "number"}, {Name: "routeId", Type: "string"}, {Name: "totalTrips", Type: "integer"}, {Name: "tripsWithTimepoints", Type: "integer"}}},
+	{Code: "low_transfer_opportunity", Severity: INFO, Category: "business", Constructors: []string{"NewLowTransferOpportunityNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "totalStops", Type: "integer"}, {Name: "transferRatio", Type: "number"}, {Name: "transferStops", Type: "integer"}}},
+	{Code: "low_trip_volume_next_7_days", Severity: WARNING, Category: "business", Constructors: []string{"NewLowTripVolumeNext7DaysNotice"}, Validators: []string{"business.DateTripsValidator"}, Context: []ContextField{{Name: "avgTripsPerDay", Type: "number"}, {Name: "daysWithService", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "startDate", Type: "string"}, {Name: "totalTrips", Type: "integer"}}},
+	{Code: "major_transfer_point", Severity: INFO, Category: "business", Constructors: []string{"NewMajorTransferPointNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "connectionCount", Type: "integer"}, {Name: "routeCount", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "transferValue", Type: "number"}}},
+	{Code: "missing_agency_id", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingAgencyIdNotice"}, Validators: []string{"entity.AgencyConsistencyValidator"}, Context: []ContextField{{Name: "agencyName", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "message", Type: "string"}}},
+	{Code: "missing_arrival_time", Severity: WARNING, Category: "relationship", Constructors: []string{"NewMissingArrivalTimeNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "missing_attribution_contact", Severity: WARNING, Category: "relationship", Constructors: []string{"NewMissingAttributionContactNotice"}, Validators: []string{"relationship.AttributionValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}}},
+	{Code: "missing_attribution_role", Severity: ERROR, Category: "relationship", Constructors: []string{"NewMissingAttributionRoleNotice"}, Validators: []string{"relationship.AttributionValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}}},
+	{Code: "missing_bikes_allowed_for_ferry", Severity: WARNING, Category: "entity", Constructors: []string{"NewMissingBikesAllowedForFerryNotice"}, Validators: []string{"entity.BikesAllowanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "missing_calendar_and_calendar_date_files", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingCalendarAndCalendarDateFilesNotice"}, Validators: []string{"core.MissingFilesValidator", "entity.CalendarValidator"}, Context: []ContextField{{Name: "message", Type: "string"}}},
+	{Code: "missing_coordinates", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingCoordinatesNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "locationType", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "missing_departure_time", Severity: WARNING, Category: "relationship", Constructors: []string{"NewMissingDepartureTimeNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "missing_fare_attributes", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingFareAttributesNotice"}, Validators: []string{"core.MissingFilesValidator"}, Context: []ContextField{{Name: "message", Type: "string"}}},
+	{Code: "missing_feed_info", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingFeedInfoNotice"}, Validators: []string{"core.MissingFilesValidator"}, Context: []ContextField{{Name: "message", Type: "string"}}},
+	{Code: "missing_levels", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingLevelsNotice"}, Validators: []string{"core.MissingFilesValidator"}, Context: []ContextField{{Name: "message", Type: "string"}}},
+	{Code: "missing_min_transfer_time", Severity: ERROR, Category: "business", Constructors: []string{"NewMissingMinTransferTimeNotice"}, Validators: []string{"business.TransferTimingValidator", "business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "missing_parent_station", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingParentStationNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "locationType", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "missing_recommended_field", Severity: WARNING, Category: "core", Constructors: []string{"NewMissingRecommendedFieldNotice"}, Validators: []string{"accessibility.LevelValidator", "accessibility.PathwayValidator", "core.RequiredFieldValidator", "entity.RouteNameValidator", "entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "missing_required_column", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingRequiredColumnNotice"}, Validators: []string{"core.MissingColumnValidator"}, Context: []ContextField{{Name: "columnName", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "missing_required_field", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingRequiredFieldNotice"}, Validators: []string{"core.RequiredFieldValidator", "meta.FeedInfoValidator", "relationship.AttributionValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "missing_required_file", Severity: ERROR, Category: "core", Constructors: []string{"NewMissingRequiredFileNotice"}, Validators: []string{"core.MissingFilesValidator", "gtfsvalidator.internalValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}}},
+	{Code: "missing_required_stop_name", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingRequiredStopNameNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "locationType", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "missing_route_agency_id", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingRouteAgencyIdNotice"}, Validators: []string{"entity.AgencyConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "message", Type: "string"}, {Name: "routeId", Type: "string"}}},
+	{Code: "missing_route_name", Severity: ERROR, Category: "entity", Constructors: []string{"NewMissingRouteNameNotice"}, Validators: []string{"entity.RouteNameValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "message", Type: "string"}, {Name: "routeId", Type: "string"}}},
+	{Code: "missing_trip_first_time", Severity: ERROR, Category: "relationship", Constructors: []string{"NewMissingTripFirstTimeNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "missing_trip_last_time", Severity: ERROR, Category: "relationship", Constructors: []string{"NewMissingTripLastTimeNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "mostly_calendar_dates_services", Severity: INFO, Category: "business", Constructors: []string{"NewMostlyCalendarDatesServicesNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "calendarDatesCount", Type: "integer"}, {Name: "totalCount", Type: "integer"}}},
+	{Code: "multiple_attribution_scopes", Severity: WARNING, Category: "relationship", Constructors: []string{"NewMultipleAttributionScopesNotice"}, Validators: []string{"relationship.AttributionValidator"}, Context: []ContextField{{Name: "attributionId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}}},
+	{Code: "multiple_feed_info_entries", Severity: ERROR, Category: "meta", Constructors: []string{"NewMultipleFeedInfoEntriesNotice"}, Validators: []string{"meta.FeedInfoValidator"}, Context: []ContextField{{Name: "count", Type: "integer"}}},
+	{Code: "multiple_records_in_single_record_file", Severity: ERROR, Category: "core", Constructors: []string{"NewMultipleRecordsInSingleRecordFileNotice"}, Validators: []string{"core.DuplicateKeyValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}, {Name: "recordCount", Type: "integer"}}},
+	{Code: "negative_min_transfer_time", Severity: ERROR, Category: "business", Constructors: []string{"NewNegativeMinTransferTimeNotice"}, Validators: []string{"business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTransferTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "negative_shape_distance", Severity: ERROR, Category: "core", Constructors: []string{"NewNegativeShapeDistanceNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "shapeDistance", Type: "number"}, {Name: "tripId", Type: "string"}}},
+	{Code: "negative_shape_sequence", Severity: ERROR, Category: "core", Constructors: []string{"NewNegativeShapeSequenceNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "sequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "negative_stop_sequence", Severity: ERROR, Category: "core", Constructors: []string{"NewNegativeStopSequenceNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "network_hub_identified", Severity: INFO, Category: "business", Constructors: []string{"NewNetworkHubIdentifiedNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "connectionCount", Type: "integer"}, {Name: "routeCount", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "totalHubs", Type: "integer"}}},
+	{Code: "network_topology_summary", Severity: INFO, Category: "business", Constructors: []string{"NewNetworkTopologySummaryNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "avgConnectivity", Type: "number"}, {Name: "componentCount", Type: "integer"}, {Name: "maxRouteCount", Type: "integer"}, {Name: "totalEdges", Type: "integer"}, {Name: "totalRoutes", Type: "integer"}, {Name: "totalStops", Type: "integer"}, {Name: "totalTrips", Type: "integer"}, {Name: "transferStops", Type: "integer"}}},
+	{Code: "no_service_date_found", Severity: ERROR, Category: "business", Constructors: []string{"NewNoServiceDateFoundNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{}},
+	{Code: "no_service_defined", Severity: ERROR, Category: "business", Constructors: []string{"NewNoServiceDefinedNotice"}, Validators: []string{"business.DateTripsValidator"}, Context: []ContextField{}},
+	{Code: "no_service_next_7_days", Severity: WARNING, Category: "business", Constructors: []string{"NewNoServiceNext7DaysNotice"}, Validators: []string{"business.DateTripsValidator", "business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "endDate", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "no_trips_next_7_days", Severity: ERROR, Category: "business", Constructors: []string{"NewNoTripsNext7DaysNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "endDate", Type: "string"}, {Name: "serviceCount", Type: "integer"}, {Name: "startDate", Type: "string"}}},
+	{Code: "non_increasing_shape_sequence", Severity: ERROR, Category: "entity", Constructors: []string{"NewNonIncreasingShapeSequenceNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "previousSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "non_increasing_stop_sequence", Severity: ERROR, Category: "entity", Constructors: []string{"NewNonIncreasingStopSequenceNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "currentSeq", Type: "integer"}, {Name: "previousSeq", Type: "integer"}, {Name: "rowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "non_utf8_encoding", Severity: ERROR, Category: "core", Constructors: []string{"NewNonUTF8EncodingNotice"}, Validators: []string{"core.EncodingValidator"}, Context: []ContextField{{Name: "encoding", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "transcoded", Type: "boolean"}}},
+	{Code: "orphaned_station", Severity: WARNING, Category: "entity", Constructors: []string{"NewOrphanedStationNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stationId", Type: "string"}}},
+	{Code: "overlapping_frequency", Severity: ERROR, Category: "business", Constructors: []string{"NewOverlappingFrequencyNotice"}, Validators: []string{"business.FrequencyValidator", "business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "endTime1", Type: "string"}, {Name: "endTime2", Type: "string"}, {Name: "rowNumber1", Type: "integer"}, {Name: "rowNumber2", Type: "integer"}, {Name: "startTime1", Type: "string"}, {Name: "startTime2", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "overlapping_routes", Severity: WARNING, Category: "business", Constructors: []string{"NewOverlappingRoutesNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "routeIds", Type: "array"}, {Name: "stopCount", Type: "integer"}}},
+	{Code: "pathway_to_same_stop", Severity: ERROR, Category: "accessibility", Constructors: []string{"NewPathwayToSameStopNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pathwayId", Type: "string"}, {Name: "stopId", Type: "string"}}},
+	{Code: "poor_color_contrast", Severity: WARNING, Category: "entity", Constructors: []string{"NewPoorColorContrastNotice"}, Validators: []string{"entity.RouteConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "red_green_color_combination", Severity: INFO, Category: "entity", Constructors: []string{"NewRedGreenColorCombinationNotice"}, Validators: []string{"entity.RouteColorContrastValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "route_color_contrast", Severity: ERROR, VariableSeverity: true, Category: "entity", Constructors: []string{"NewRouteColorContrastNotice"}, Validators: []string{"entity.RouteColorContrastValidator"}, Context: []ContextField{{Name: "actualContrast", Type: "number"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "minimumContrast", Type: "number"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "route_id_renamed", Severity: WARNING, Category: "diff", Constructors: []string{"NewRouteIDRenamedNotice"}, Validators: []string{"diff.EntityIDDiffValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "previousRouteId", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeShortName", Type: "string"}}},
+	{Code: "route_long_name_too_long", Severity: WARNING, Category: "entity", Constructors: []string{"NewRouteLongNameTooLongNotice"}, Validators: []string{"entity.RouteNameValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "actualLength", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "maxLength", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "routeLongName", Type: "string"}}},
+	{Code: "route_network_summary", Severity: INFO, Category: "relationship", Constructors: []string{"NewRouteNetworkSummaryNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "agencies", Type: "integer"}, {Name: "avgTripsPerRoute", Type: "number"}, {Name: "routeTypes", Type: "integer"}, {Name: "totalRoutes", Type: "integer"}, {Name: "totalTrips", Type: "integer"}}},
+	{Code: "route_removed", Severity: WARNING, Category: "diff", Constructors: []string{"NewRouteRemovedNotice"}, Validators: []string{"diff.EntityIDDiffValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "routeLongName", Type: "string"}, {Name: "routeShortName", Type: "string"}}},
+	{Code: "route_short_name_too_long", Severity: WARNING, Category: "entity", Constructors: []string{"NewRouteShortNameTooLongNotice"}, Validators: []string{"entity.RouteNameValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "actualLength", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "maxLength", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "routeShortName", Type: "string"}}},
+	{Code: "route_type_name_mismatch", Severity: WARNING, Category: "entity", Constructors: []string{"NewRouteTypeNameMismatchNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "expectedMode", Type: "string"}, {Name: "longName", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeType", Type: "integer"}, {Name: "shortName", Type: "string"}}},
+	{Code: "route_without_trips", Severity: WARNING, Category: "relationship", Constructors: []string{"NewRouteWithoutTripsNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "same_name_and_description", Severity: WARNING, Category: "entity", Constructors: []string{"NewSameNameAndDescriptionNotice"}, Validators: []string{"entity.RouteNameValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName1", Type: "string"}, {Name: "fieldName2", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "routeId", Type: "string"}}},
+	{Code: "same_origin_destination", Severity: WARNING, Category: "fare", Constructors: []string{"NewSameOriginDestinationNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "zoneId", Type: "string"}}},
+	{Code: "schedule_validation_summary", Severity: INFO, Category: "business", Constructors: nil, Validators: []string{"business.ScheduleConsistencyValidator"}},
+	{Code: "scheduling_summary", Severity: INFO, Category: "unused", Constructors: []string{"NewSchedulingSummaryNotice"}, Validators: nil, Context: []ContextField{{Name: "avgServicesPerRoute", Type: "number"}, {Name: "avgTripsPerService", Type: "number"}, {Name: "totalRoutes", Type: "integer"}, {Name: "totalServices", Type: "integer"}, {Name: "totalTrips", Type: "integer"}}},
+	{Code: "service_dates_removed", Severity: WARNING, Category: "diff", Constructors: []string{"NewServiceDatesRemovedNotice"}, Validators: []string{"diff.ServiceDatesDiffValidator"}, Context: []ContextField{{Name: "dayCount", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "previousTripCount", Type: "integer"}, {Name: "startDate", Type: "string"}}},
+	{Code: "service_expired", Severity: ERROR, Category: "business", Constructors: []string{"NewServiceExpiredNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysExpired", Type: "integer"}, {Name: "lastServiceDate", Type: "string"}}},
+	{Code: "service_expires_within_30_days", Severity: WARNING, Category: "business", Constructors: []string{"NewServiceExpiresWithin30DaysNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysUntilExpiration", Type: "integer"}, {Name: "lastServiceDate", Type: "string"}}},
+	{Code: "service_expires_within_7_days", Severity: ERROR, Category: "business", Constructors: []string{"NewServiceExpiresWithin7DaysNotice"}, Validators: []string{"business.FeedExpirationDateValidator"}, Context: []ContextField{{Name: "currentDate", Type: "string"}, {Name: "daysUntilExpiration", Type: "integer"}, {Name: "lastServiceDate", Type: "string"}}},
+	{Code: "service_never_active", Severity: ERROR, Category: "entity", Constructors: []string{"NewServiceNeverActiveNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "service_pattern_summary", Severity: INFO, Category: "business", Constructors: []string{"NewServicePatternSummaryNotice"}, Validators: []string{"business.ServiceConsistencyValidator"}, Context: []ContextField{{Name: "mixedServices", Type: "integer"}, {Name: "totalServices", Type: "integer"}, {Name: "weekdayServices", Type: "integer"}, {Name: "weekendServices", Type: "integer"}}},
+	{Code: "service_without_active_days", Severity: ERROR, Category: "entity", Constructors: []string{"NewServiceWithoutActiveDaysNotice"}, Validators: []string{"entity.ServiceValidationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "service_without_definition", Severity: ERROR, Category: "business", Constructors: []string{"NewServiceWithoutDefinitionNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "serviceId", Type: "string"}}},
+	{Code: "shape_changed_significantly", Severity: INFO, Category: "diff", Constructors: []string{"NewShapeChangedSignificantlyNotice"}, Validators: []string{"diff.ShapeDiffValidator"}, Context: []ContextField{{Name: "currentLength", Type: "number"}, {Name: "maxDeviation", Type: "number"}, {Name: "previousLength", Type: "number"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "shape_distance_decreasing", Severity: ERROR, Category: "relationship", Constructors: []string{"NewShapeDistanceDecreasingNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentDistance", Type: "number"}, {Name: "currentSequence", Type: "integer"}, {Name: "prevDistance", Type: "number"}, {Name: "prevSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "shape_distance_inconsistent_with_geography", Severity: WARNING, Category: "business", Constructors: []string{"NewShapeDistanceInconsistentWithGeographyNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "difference", Type: "number"}, {Name: "geographicDist", Type: "number"}, {Name: "providedDist", Type: "number"}, {Name: "rowNumber", Type: "integer"}, {Name: "sequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "shape_distance_not_increasing", Severity: WARNING, Category: "relationship", Constructors: []string{"NewShapeDistanceNotIncreasingNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "prevSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "shape_distance_not_starting_from_zero", Severity: INFO, Category: "relationship", Constructors: []string{"NewShapeDistanceNotStartingFromZeroNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "firstDistance", Type: "number"}, {Name: "firstSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "shape_point_outside_feed_bounds", Severity: WARNING, Category: "business", Constructors: []string{"NewShapePointOutsideFeedBoundsNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "latitude", Type: "number"}, {Name: "longitude", Type: "number"}, {Name: "rowNumber", Type: "integer"}, {Name: "sequence", Type: "integer"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "short_service_span", Severity: INFO, Category: "unused", Constructors: []string{"NewShortServiceSpanNotice"}, Validators: nil, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "serviceSpan", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "short_trip_pattern", Severity: WARNING, Category: "entity", Constructors: []string{"NewShortTripPatternNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "patternId", Type: "string"}, {Name: "stopCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "similar_colors", Severity: WARNING, Category: "entity", Constructors: []string{"NewSimilarColorsNotice"}, Validators: []string{"entity.RouteColorContrastValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "routeColor", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeTextColor", Type: "string"}}},
+	{Code: "single_day_service", Severity: INFO, Category: "business", Constructors: []string{"NewSingleDayServiceNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "dayName", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "single_route_type_in_feed", Severity: WARNING, Category: "entity", Constructors: []string{"NewSingleRouteTypeInFeedNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "description", Type: "string"}, {Name: "routeCount", Type: "integer"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "single_stop_zone", Severity: WARNING, Category: "entity", Constructors: []string{"NewSingleStopZoneNotice"}, Validators: []string{"entity.ZoneValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "zoneId", Type: "string"}}},
+	{Code: "single_trip_block", Severity: INFO, Category: "entity", Constructors: []string{"NewSingleTripBlockNotice"}, Validators: []string{"entity.TripBlockIdValidator"}, Context: []ContextField{{Name: "blockId", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "single_trip_pattern", Severity: INFO, Category: "entity", Constructors: []string{"NewSingleTripPatternNotice"}, Validators: []string{"entity.TripPatternValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "patternId", Type: "string"}, {Name: "stopCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "single_trip_service", Severity: INFO, Category: "business", Constructors: []string{"NewSingleTripServiceNotice"}, Validators: []string{"business.ServiceConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "small_frequency_gap", Severity: INFO, Category: "business", Constructors: []string{"NewSmallFrequencyGapNotice"}, Validators: []string{"business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "endTime", Type: "string"}, {Name: "gapSeconds", Type: "integer"}, {Name: "startTime", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "small_network_component", Severity: INFO, Category: "business", Constructors: []string{"NewSmallNetworkComponentNotice"}, Validators: []string{"business.NetworkTopologyValidator"}, Context: []ContextField{{Name: "componentRank", Type: "integer"}, {Name: "routeCount", Type: "integer"}, {Name: "stopCount", Type: "integer"}}},
+	{Code: "station_with_parent_station", Severity: ERROR, Category: "entity", Constructors: []string{"NewStationWithParentStationNotice"}, Validators: []string{"entity.StopLocationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "parentStation", Type: "string"}, {Name: "stopId", Type: "string"}}},
+	{Code: "stop_id_renamed", Severity: WARNING, Category: "diff", Constructors: []string{"NewStopIDRenamedNotice"}, Validators: []string{"diff.EntityIDDiffValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "previousStopId", Type: "string"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_moved", Severity: WARNING, Category: "diff", Constructors: []string{"NewStopMovedNotice"}, Validators: []string{"diff.StopMovedDiffValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentLat", Type: "number"}, {Name: "currentLon", Type: "number"}, {Name: "distance", Type: "number"}, {Name: "previousLat", Type: "number"}, {Name: "previousLon", Type: "number"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_all_caps", Severity: INFO, Category: "entity", Constructors: []string{"NewStopNameAllCapsNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_contains_control_character", Severity: WARNING, Category: "entity", Constructors: []string{"NewStopNameContainsControlCharacterNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "charCode", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "position", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_contains_html", Severity: WARNING, Category: "entity", Constructors: []string{"NewStopNameContainsHTMLNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_contains_url", Severity: WARNING, Category: "entity", Constructors: []string{"NewStopNameContainsURLNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_description_duplicate", Severity: INFO, Category: "entity", Constructors: []string{"NewStopNameDescriptionDuplicateNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_missing_but_inherited", Severity: INFO, Category: "entity", Constructors: []string{"NewStopNameMissingButInheritedNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "locationType", Type: "integer"}, {Name: "parentName", Type: "string"}, {Name: "parentStationId", Type: "string"}, {Name: "stopId", Type: "string"}}},
+	{Code: "stop_name_repeated_word", Severity: WARNING, Category: "entity", Constructors: []string{"NewStopNameRepeatedWordNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "repeatedWord", Type: "string"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_name_too_long", Severity: ERROR, VariableSeverity: true, Category: "entity", Constructors: []string{"NewStopNameTooLongNotice"}, Validators: []string{"entity.StopNameValidator"}, Context: []ContextField{{Name: "actualLength", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "maxLength", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_removed", Severity: WARNING, Category: "diff", Constructors: []string{"NewStopRemovedNotice"}, Validators: []string{"diff.EntityIDDiffValidator"}, Context: []ContextField{{Name: "stopId", Type: "string"}, {Name: "stopName", Type: "string"}}},
+	{Code: "stop_sequence_gap", Severity: INFO, Category: "entity", Constructors: []string{"NewStopSequenceGapNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "actualSeq", Type: "integer"}, {Name: "expectedSeq", Type: "integer"}, {Name: "rowNumber", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "stop_time_arrival_after_departure", Severity: ERROR, Category: "relationship", Constructors: []string{"NewStopTimeArrivalAfterDepartureNotice"}, Validators: []string{"business.ScheduleConsistencyValidator", "relationship.StopTimeSequenceTimeValidator"}, Context: []ContextField{{Name: "arrivalTime", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "departureTime", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "stop_time_decreasing_time", Severity: ERROR, Category: "relationship", Constructors: []string{"NewStopTimeDecreasingTimeNotice"}, Validators: []string{"business.ScheduleConsistencyValidator", "relationship.StopTimeSequenceTimeValidator"}, Context: []ContextField{{Name: "arrivalTime", Type: "string"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "prevCsvRowNumber", Type: "integer"}, {Name: "prevDepartureTime", Type: "string"}, {Name: "prevStopSequence", Type: "integer"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "stop_trip_headsign_mismatch", Severity: WARNING, Category: "entity", Constructors: []string{"NewStopTripHeadsignMismatchNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopHeadsign", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripHeadsign", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "stop_without_service", Severity: ERROR, Category: "business", Constructors: []string{"NewStopWithoutServiceNotice"}, Validators: []string{"business.ScheduleConsistencyValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "suspicious_coordinate", Severity: WARNING, Category: "core", Constructors: []string{"NewSuspiciousCoordinateNotice"}, Validators: []string{"business.GeospatialValidator", "core.CoordinateValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "reason", Type: "string"}}},
+	{Code: "suspicious_headsign_pattern", Severity: WARNING, Category: "entity", Constructors: []string{"NewSuspiciousHeadsignPatternNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headsign", Type: "string"}, {Name: "pattern", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "timepoint_without_times", Severity: INFO, Category: "relationship", Constructors: []string{"NewTimepointWithoutTimesNotice"}, Validators: []string{"relationship.StopTimeConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "too_many_headsigns_in_trip", Severity: WARNING, Category: "entity", Constructors: []string{"NewTooManyHeadsignsInTripNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "count", Type: "integer"}, {Name: "headsigns", Type: "array"}, {Name: "tripId", Type: "string"}}},
+	{Code: "trailing_whitespace", Severity: WARNING, Category: "core", Constructors: []string{"NewTrailingWhitespaceNotice"}, Validators: []string{"core.LeadingTrailingWhitespaceValidator"}, Context: []ContextField{{Name: "fieldName", Type: "string"}, {Name: "fieldValue", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "transfer_to_same_stop", Severity: WARNING, Category: "business", Constructors: []string{"NewTransferToSameStopNotice"}, Validators: []string{"business.TransferTimingValidator", "business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopId", Type: "string"}}},
+	{Code: "trip_count_changed", Severity: WARNING, Category: "diff", Constructors: []string{"NewTripCountChangedNotice"}, Validators: []string{"diff.TripCountDiffValidator"}, Context: []ContextField{{Name: "affectedDateCount", Type: "integer"}, {Name: "changePercent", Type: "number"}, {Name: "currentTripCount", Type: "integer"}, {Name: "firstAffectedDate", Type: "string"}, {Name: "previousTripCount", Type: "integer"}, {Name: "routeId", Type: "string"}}},
+	{Code: "trip_pattern_summary", Severity: INFO, Category: "entity", Constructors: []string{"NewTripPatternSummaryNotice"}, Validators: []string{"entity.TripPatternValidator"}, Context: []ContextField{{Name: "avgTripsPerPattern", Type: "number"}, {Name: "totalPatterns", Type: "integer"}, {Name: "totalTrips", Type: "integer"}}},
+	{Code: "trip_usability", Severity: ERROR, Category: "business", Constructors: []string{"NewTripUsabilityNotice"}, Validators: []string{"business.TripUsabilityValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "stopCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "unbalanced_direction_trips", Severity: WARNING, Category: "relationship", Constructors: []string{"NewUnbalancedDirectionTripsNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "direction1", Type: "integer"}, {Name: "direction1Trips", Type: "integer"}, {Name: "direction2", Type: "integer"}, {Name: "direction2Trips", Type: "integer"}, {Name: "routeId", Type: "string"}}},
+	{Code: "uncommon_route_type", Severity: INFO, Category: "entity", Constructors: []string{"NewUncommonRouteTypeNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "description", Type: "string"}, {Name: "routeId", Type: "string"}, {Name: "routeType", Type: "integer"}}},
+	{Code: "undefined_service", Severity: ERROR, Category: "entity", Constructors: []string{"NewUndefinedServiceNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "serviceId", Type: "string"}}},
+	{Code: "undefined_zone", Severity: ERROR, Category: "entity", Constructors: []string{"NewUndefinedZoneNotice"}, Validators: []string{"entity.ZoneValidator"}, Context: []ContextField{{Name: "zoneId", Type: "string"}}},
+	{Code: "unexpected_bidirectional_gate", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewUnexpectedBidirectionalGateNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pathwayId", Type: "string"}, {Name: "pathwayMode", Type: "integer"}}},
+	{Code: "unknown_column", Severity: INFO, Category: "validator", Constructors: []string{"NewUnknownColumnNotice"}, Validators: []string{"validator.FileStructureValidator"}, Context: []ContextField{{Name: "columnIndex", Type: "integer"}, {Name: "columnName", Type: "string"}, {Name: "filename", Type: "string"}}},
+	{Code: "unknown_file", Severity: INFO, Category: "core", Constructors: []string{"NewUnknownFileNotice"}, Validators: []string{"core.UnknownFileValidator"}, Context: []ContextField{{Name: "filename", Type: "string"}}},
+	{Code: "unnecessary_min_transfer_time", Severity: WARNING, Category: "business", Constructors: []string{"NewUnnecessaryMinTransferTimeNotice"}, Validators: []string{"business.TransferTimingValidator", "business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTransferTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "unnecessary_transfer_duration", Severity: WARNING, Category: "fare", Constructors: []string{"NewUnnecessaryTransferDurationNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "transferDuration", Type: "integer"}}},
+	{Code: "unrealistic_shape_distance", Severity: WARNING, Category: "relationship", Constructors: []string{"NewUnrealisticShapeDistanceNotice"}, Validators: []string{"relationship.ShapeIncreasingDistanceValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "currentSequence", Type: "integer"}, {Name: "geoDistance", Type: "number"}, {Name: "prevSequence", Type: "integer"}, {Name: "providedDistance", Type: "number"}, {Name: "ratio", Type: "number"}, {Name: "shapeId", Type: "string"}}},
+	{Code: "unrealistic_transfer_time", Severity: WARNING, Category: "business", Constructors: []string{"NewUnrealisticTransferTimeNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "expectedTime", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "unreasonable_headway", Severity: WARNING, Category: "business", Constructors: []string{"NewUnreasonableHeadwayNotice"}, Validators: []string{"business.FrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headwaySecs", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "unreasonable_level_index", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewUnreasonableLevelIndexNotice"}, Validators: []string{"accessibility.LevelValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "levelId", Type: "string"}, {Name: "levelIndex", Type: "number"}}},
+	{Code: "unreasonable_max_slope", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewUnreasonableMaxSlopeNotice"}, Validators: []string{"accessibility.PathwayValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "maxSlope", Type: "number"}, {Name: "pathwayId", Type: "string"}}},
+	{Code: "unreasonable_min_transfer_time", Severity: WARNING, Category: "business", Constructors: []string{"NewUnreasonableMinTransferTimeNotice"}, Validators: []string{"business.TransferValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTransferTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "unreasonably_long_shape_segment", Severity: WARNING, Category: "entity", Constructors: []string{"NewUnreasonablyLongShapeSegmentNotice"}, Validators: []string{"business.GeospatialValidator", "entity.ShapeValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "distance", Type: "number"}, {Name: "fromSequence", Type: "integer"}, {Name: "shapeId", Type: "string"}, {Name: "toSequence", Type: "integer"}}},
+	{Code: "unused_fare_attribute", Severity: WARNING, Category: "fare", Constructors: []string{"NewUnusedFareAttributeNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}}},
+	{Code: "unused_level", Severity: WARNING, Category: "accessibility", Constructors: []string{"NewUnusedLevelNotice"}, Validators: []string{"accessibility.LevelValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "levelId", Type: "string"}}},
+	{Code: "unused_service", Severity: WARNING, Category: "entity", Constructors: []string{"NewUnusedServiceNotice"}, Validators: []string{"business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator", "entity.ServiceValidationValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "unused_shape", Severity: WARNING, Category: "entity", Constructors: []string{"NewUnusedShapeNotice"}, Validators: []string{"entity.ShapeValidator"}, Context: []ContextField{{Name: "shapeId", Type: "string"}}},
+	{Code: "unused_zone", Severity: WARNING, Category: "entity", Constructors: []string{"NewUnusedZoneNotice"}, Validators: []string{"entity.ZoneValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "zoneId", Type: "string"}}},
+	{Code: "unusual_bike_allowance", Severity: INFO, Category: "entity", Constructors: []string{"NewUnusualBikeAllowanceNotice"}, Validators: []string{"entity.BikesAllowanceValidator"}, Context: []ContextField{{Name: "bikesAllowed", Type: "integer"}, {Name: "csvRowNumber", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "routeType", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "unusual_route_type_combination", Severity: INFO, Category: "entity", Constructors: []string{"NewUnusualRouteTypeCombinationNotice"}, Validators: []string{"entity.RouteTypeValidator"}, Context: []ContextField{{Name: "descriptions", Type: "array"}, {Name: "routeTypes", Type: "array"}}},
+	{Code: "unusual_service_pattern", Severity: INFO, Category: "business", Constructors: []string{"NewUnusualServicePatternNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "pattern", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "unusual_transfer_value", Severity: WARNING, Category: "fare", Constructors: []string{"NewUnusualTransferValueNotice"}, Validators: []string{"fare.FareValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fareId", Type: "string"}, {Name: "transfers", Type: "integer"}}},
+	{Code: "utf16_byte_order_mark", Severity: ERROR, Category: "core", Constructors: []string{"NewUTF16ByteOrderMarkNotice"}, Validators: []string{"core.EncodingValidator"}, Context: []ContextField{{Name: "encoding", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "transcoded", Type: "boolean"}}},
+	{Code: "validation_summary", Severity: INFO, Category: "unused", Constructors: []string{"NewValidationSummaryNotice"}, Validators: nil, Context: []ContextField{{Name: "successRate", Type: "number"}, {Name: "totalValidators", Type: "integer"}, {Name: "validatorsFailed", Type: "integer"}, {Name: "validatorsRun", Type: "integer"}}},
+	{Code: "validator_error", Severity: ERROR, Category: "system", Constructors: []string{"NewValidatorErrorNotice"}, Validators: []string{"gtfsvalidator.internalValidator"}, Context: []ContextField{{Name: "errorMessage", Type: "string"}, {Name: "validatorName", Type: "string"}}},
+	{Code: "very_close_stops", Severity: INFO, Category: "business", Constructors: []string{"NewVeryCloseStopsNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "distance", Type: "number"}, {Name: "rowNumber1", Type: "integer"}, {Name: "rowNumber2", Type: "integer"}, {Name: "stop1Id", Type: "string"}, {Name: "stop2Id", Type: "string"}}},
+	{Code: "very_future_calendar_date", Severity: WARNING, Category: "entity", Constructors: []string{"NewVeryFutureCalendarDateNotice"}, Validators: []string{"business.ServiceCalendarValidator", "business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "date", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "very_future_service", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryFutureServiceNotice"}, Validators: []string{"business.ServiceConsistencyValidator"}, Context: []ContextField{{Name: "rowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "very_large_feed_coverage", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryLargeFeedCoverageNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "latSpan", Type: "number"}, {Name: "lonSpan", Type: "number"}, {Name: "maxLat", Type: "number"}, {Name: "maxLon", Type: "number"}, {Name: "minLat", Type: "number"}, {Name: "minLon", Type: "number"}}},
+	{Code: "very_long_frequency_period", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryLongFrequencyPeriodNotice"}, Validators: []string{"business.OverlappingFrequencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "duration", Type: "integer"}, {Name: "endTime", Type: "string"}, {Name: "startTime", Type: "string"}, {Name: "tripId", Type: "string"}}},
+	{Code: "very_long_headsign", Severity: WARNING, Category: "entity", Constructors: []string{"NewVeryLongHeadsignNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "length", Type: "integer"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "very_long_headway", Severity: INFO, Category: "unused", Constructors: []string{"NewVeryLongHeadwayNotice"}, Validators: nil, Context: []ContextField{{Name: "headway", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "very_long_route", Severity: INFO, Category: "relationship", Constructors: []string{"NewVeryLongRouteNotice"}, Validators: []string{"relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "stopCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "very_long_service_period", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryLongServicePeriodNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "durationDays", Type: "integer"}, {Name: "endDate", Type: "string"}, {Name: "serviceId", Type: "string"}, {Name: "startDate", Type: "string"}}},
+	{Code: "very_long_transfer_time", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryLongTransferTimeNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "very_long_trip", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryLongTripNotice"}, Validators: []string{"business.ScheduleConsistencyValidator"}, Context: []ContextField{{Name: "duration", Type: "integer"}, {Name: "stopCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "very_old_calendar_date", Severity: WARNING, Category: "entity", Constructors: []string{"NewVeryOldCalendarDateNotice"}, Validators: []string{"business.ServiceCalendarValidator", "business.ServiceConsistencyValidator", "entity.CalendarConsistencyValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "date", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "very_old_service", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryOldServiceNotice"}, Validators: []string{"business.ServiceConsistencyValidator"}, Context: []ContextField{{Name: "endDate", Type: "string"}, {Name: "rowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "very_short_headsign", Severity: WARNING, Category: "entity", Constructors: []string{"NewVeryShortHeadsignNotice"}, Validators: []string{"entity.StopTimeHeadsignValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "headsign", Type: "string"}, {Name: "stopSequence", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "very_short_headway", Severity: WARNING, Category: "unused", Constructors: []string{"NewVeryShortHeadwayNotice"}, Validators: nil, Context: []ContextField{{Name: "headway", Type: "integer"}, {Name: "routeId", Type: "string"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "very_short_route", Severity: WARNING, Category: "relationship", Constructors: []string{"NewVeryShortRouteNotice"}, Validators: []string{"business.NetworkTopologyValidator", "relationship.RouteConsistencyValidator"}, Context: []ContextField{{Name: "routeId", Type: "string"}, {Name: "stopCount", Type: "integer"}, {Name: "tripCount", Type: "integer"}}},
+	{Code: "very_short_transfer_time", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryShortTransferTimeNotice"}, Validators: []string{"business.TransferTimingValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "fromStopId", Type: "string"}, {Name: "minTime", Type: "integer"}, {Name: "toStopId", Type: "string"}}},
+	{Code: "very_short_trip", Severity: WARNING, Category: "business", Constructors: []string{"NewVeryShortTripNotice"}, Validators: []string{"business.ScheduleConsistencyValidator"}, Context: []ContextField{{Name: "duration", Type: "integer"}, {Name: "stopCount", Type: "integer"}, {Name: "tripId", Type: "string"}}},
+	{Code: "very_small_feed_coverage", Severity: INFO, Category: "business", Constructors: []string{"NewVerySmallFeedCoverageNotice"}, Validators: []string{"business.GeospatialValidator"}, Context: []ContextField{{Name: "latSpan", Type: "number"}, {Name: "lonSpan", Type: "number"}, {Name: "maxLat", Type: "number"}, {Name: "maxLon", Type: "number"}, {Name: "minLat", Type: "number"}, {Name: "minLon", Type: "number"}}},
+	{Code: "weekend_only_service", Severity: INFO, Category: "business", Constructors: []string{"NewWeekendOnlyServiceNotice"}, Validators: []string{"business.ServiceCalendarValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "serviceId", Type: "string"}}},
+	{Code: "whitespace_only_field", Severity: WARNING, Category: "core", Constructors: []string{"NewWhitespaceOnlyFieldNotice"}, Validators: []string{"core.LeadingTrailingWhitespaceValidator"}, Context: []ContextField{{Name: "fieldName", Type: "string"}, {Name: "filename", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "wrong_number_of_fields", Severity: ERROR, Category: "core", Constructors: []string{"NewWrongNumberOfFieldsNotice"}, Validators: []string{"core.InvalidRowValidator"}, Context: []ContextField{{Name: "actualFields", Type: "integer"}, {Name: "expectedFields", Type: "integer"}, {Name: "filename", Type: "string"}, {Name: "rowNumber", Type: "integer"}}},
+	{Code: "zone_id_same_as_stop_id", Severity: WARNING, Category: "entity", Constructors: []string{"NewZoneIDSameAsStopIDNotice"}, Validators: []string{"entity.ZoneValidator"}, Context: []ContextField{{Name: "csvRowNumber", Type: "integer"}, {Name: "zoneId", Type: "string"}}},
+}
@@ -0,0 +1,113 @@
+package notice
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRulesRegistryUpToDate(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	output := filepath.Join(t.TempDir(), "rules_generated.go")
+	cmd := exec.Command("go", "run", "gen_rules.go", "-root", "..", "-o", output) // #nosec G204 -- Test code with controlled paths
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("Failed to run generator: %v\n%s", err, out)
+	}
+
+	generated, err := os.ReadFile(output) // #nosec G304 -- Test code with controlled paths
+	if err != nil {
+		t.Fatalf("Failed to read generated registry: %v", err)
+	}
+	committed, err := os.ReadFile("rules_generated.go")
+	if err != nil {
+		t.Fatalf("Failed to read committed registry: %v", err)
+	}
+	if !bytes.Equal(generated, committed) {
+		t.Error("rules_generated.go is out of date; run `go generate ./notice`")
+	}
+}
+
+func TestRules(t *testing.T) {
+	rules := Rules()
+	if len(rules) == 0 {
+		t.Fatal("Expected registered rules")
+	}
+
+	for i, rule := range rules {
+		if rule.Code == "" {
+			t.Errorf("Rule %d has empty code", i)
+		}
+		if i > 0 && rules[i-1].Code >= rule.Code {
+			t.Errorf("Rules not sorted or duplicated at %q", rule.Code)
+		}
+		if rule.Category == "" {
+			t.Errorf("Rule %q has empty category", rule.Code)
+		}
+	}
+
+	// Mutating the returned slice must not affect the registry
+	rules[0].Code = "mutated"
+	if Rules()[0].Code == "mutated" {
+		t.Error("Rules() should return a copy of the registry")
+	}
+}
+
+func TestLookupRule(t *testing.T) {
+	tests := []struct {
+		code     string
+		found    bool
+		severity SeverityLevel
+		category string
+	}{
+		{"duplicate_key", true, ERROR, "core"},
+		{"stop_removed", true, WARNING, "diff"},
+		{"validator_error", true, ERROR, "system"},
+		{"not_a_real_code", false, INFO, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			rule, found := LookupRule(tt.code)
+			if found != tt.found {
+				t.Fatalf("Expected found=%v, got %v", tt.found, found)
+			}
+			if !found {
+				return
+			}
+			if rule.Severity != tt.severity {
+				t.Errorf("Expected severity %s, got %s", tt.severity, rule.Severity)
+			}
+			if rule.Category != tt.category {
+				t.Errorf("Expected category %s, got %s", tt.category, rule.Category)
+			}
+			if len(rule.Validators) == 0 {
+				t.Error("Expected at least one emitting validator")
+			}
+		})
+	}
+}
+
+func TestLookupRule_MatchesConstructor(t *testing.T) {
+	notices := []Notice{
+		NewDuplicateKeyNotice("stops.txt", "stop_id", "S1", 2, 3),
+		NewMissingRequiredFieldNotice("stops.txt", "stop_name", 2),
+		NewStopRemovedNotice("S1", "Main St"),
+		NewShapeChangedSignificantlyNotice("SH1", 250, 1000, 1200),
+	}
+
+	for _, n := range notices {
+		rule, found := LookupRule(n.Code())
+		if !found {
+			t.Errorf("Code %q not registered", n.Code())
+			continue
+		}
+		if rule.Severity != n.Severity() {
+			t.Errorf("Code %q: registry severity %s, constructor severity %s", n.Code(), rule.Severity, n.Severity())
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package notice
 
+import (
+	"fmt"
+	"strings"
+)
+
 // SeverityLevel represents the severity of a validation notice
 type SeverityLevel int
 
@@ -25,3 +30,17 @@ func (s SeverityLevel) String() string {
 		return "UNKNOWN"
 	}
 }
+
+// ParseSeverity parses a severity name (ERROR, WARNING or INFO, case-insensitive)
+func ParseSeverity(name string) (SeverityLevel, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "ERROR":
+		return ERROR, nil
+	case "WARNING":
+		return WARNING, nil
+	case "INFO":
+		return INFO, nil
+	default:
+		return INFO, fmt.Errorf("unknown severity %q: expected ERROR, WARNING or INFO", name)
+	}
+}
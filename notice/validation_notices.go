@@ -1,5 +1,10 @@
 package notice
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // Common validation notices that can occur during GTFS validation
 
 // DuplicateKeyNotice is generated when a duplicate primary key is found
@@ -15,8 +20,15 @@ func NewDuplicateKeyNotice(filename string, fieldName string, fieldValue interfa
 		"csvRowNumber": rowNumber,
 		"duplicateRow": duplicateRow,
 	}
+	location := NoticeLocation{
+		File:            filename,
+		RowNumber:       rowNumber,
+		FieldName:       fieldName,
+		PrimaryKey:      map[string]string{fieldName: fmt.Sprint(fieldValue)},
+		OtherRowNumbers: []int{duplicateRow},
+	}
 	return &DuplicateKeyNotice{
-		BaseNotice: NewBaseNotice("duplicate_key", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_key", ERROR, context, location),
 	}
 }
 
@@ -31,8 +43,13 @@ func NewMissingRequiredFieldNotice(filename string, fieldName string, rowNumber
 		"fieldName":    fieldName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &MissingRequiredFieldNotice{
-		BaseNotice: NewBaseNotice("missing_required_field", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_required_field", ERROR, context, location),
 	}
 }
 
@@ -49,8 +66,13 @@ func NewInvalidFieldFormatNotice(filename string, fieldName string, fieldValue s
 		"csvRowNumber":   rowNumber,
 		"expectedFormat": expectedFormat,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidFieldFormatNotice{
-		BaseNotice: NewBaseNotice("invalid_field_format", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_field_format", ERROR, context, location),
 	}
 }
 
@@ -68,8 +90,13 @@ func NewForeignKeyViolationNotice(filename string, fieldName string, fieldValue
 		"referencedTable": referencedTable,
 		"referencedField": referencedField,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &ForeignKeyViolationNotice{
-		BaseNotice: NewBaseNotice("foreign_key_violation", ERROR, context),
+		BaseNotice: newLocatedNotice("foreign_key_violation", ERROR, context, location),
 	}
 }
 
@@ -82,8 +109,11 @@ func NewEmptyFileNotice(filename string) *EmptyFileNotice {
 	context := map[string]interface{}{
 		"filename": filename,
 	}
+	location := NoticeLocation{
+		File: filename,
+	}
 	return &EmptyFileNotice{
-		BaseNotice: NewBaseNotice("empty_file", WARNING, context),
+		BaseNotice: newLocatedNotice("empty_file", WARNING, context, location),
 	}
 }
 
@@ -98,8 +128,12 @@ func NewUnknownColumnNotice(filename string, columnName string, columnIndex int)
 		"columnName":  columnName,
 		"columnIndex": columnIndex,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		FieldName: columnName,
+	}
 	return &UnknownColumnNotice{
-		BaseNotice: NewBaseNotice("unknown_column", INFO, context),
+		BaseNotice: newLocatedNotice("unknown_column", INFO, context, location),
 	}
 }
 
@@ -115,8 +149,13 @@ func NewInvalidURLNotice(filename string, fieldName string, fieldValue string, r
 		"fieldValue":   fieldValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidURLNotice{
-		BaseNotice: NewBaseNotice("invalid_url", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_url", ERROR, context, location),
 	}
 }
 
@@ -132,8 +171,13 @@ func NewInvalidEmailNotice(filename string, fieldName string, fieldValue string,
 		"fieldValue":   fieldValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidEmailNotice{
-		BaseNotice: NewBaseNotice("invalid_email", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_email", ERROR, context, location),
 	}
 }
 
@@ -149,8 +193,13 @@ func NewInvalidTimezoneNotice(filename string, fieldName string, fieldValue stri
 		"fieldValue":   fieldValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidTimezoneNotice{
-		BaseNotice: NewBaseNotice("invalid_timezone", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_timezone", ERROR, context, location),
 	}
 }
 
@@ -163,8 +212,11 @@ func NewMissingRequiredFileNotice(filename string) *MissingRequiredFileNotice {
 	context := map[string]interface{}{
 		"filename": filename,
 	}
+	location := NoticeLocation{
+		File: filename,
+	}
 	return &MissingRequiredFileNotice{
-		BaseNotice: NewBaseNotice("missing_required_file", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_required_file", ERROR, context, location),
 	}
 }
 
@@ -179,8 +231,13 @@ func NewMissingRecommendedFieldNotice(filename string, fieldName string, rowNumb
 		"fieldName":    fieldName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &MissingRecommendedFieldNotice{
-		BaseNotice: NewBaseNotice("missing_recommended_field", WARNING, context),
+		BaseNotice: newLocatedNotice("missing_recommended_field", WARNING, context, location),
 	}
 }
 
@@ -211,8 +268,14 @@ func NewDuplicateStopSequenceNotice(tripID string, stopSequence int, stopID stri
 		"csvRowNumber":       rowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "stop_times.txt",
+		RowNumber:       rowNumber,
+		PrimaryKey:      map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+		OtherRowNumbers: []int{duplicateRowNumber},
+	}
 	return &DuplicateStopSequenceNotice{
-		BaseNotice: NewBaseNotice("duplicate_stop_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_stop_sequence", ERROR, context, location),
 	}
 }
 
@@ -232,8 +295,15 @@ func NewDecreasingOrEqualStopTimeDistanceNotice(tripID string, stopID string, ro
 		"prevShapeDistTraveled": prevShapeDistTraveled,
 		"prevStopSequence":      prevStopSequence,
 	}
+	location := NoticeLocation{
+		File:            "stop_times.txt",
+		RowNumber:       rowNumber,
+		FieldName:       "shape_dist_traveled",
+		PrimaryKey:      map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+		OtherRowNumbers: []int{prevRowNumber},
+	}
 	return &DecreasingOrEqualStopTimeDistanceNotice{
-		BaseNotice: NewBaseNotice("decreasing_or_equal_stop_time_distance", ERROR, context),
+		BaseNotice: newLocatedNotice("decreasing_or_equal_stop_time_distance", ERROR, context, location),
 	}
 }
 
@@ -249,8 +319,13 @@ func NewFeedExpirationDate7DaysNotice(rowNumber int, currentDate string, feedEnd
 		"feedEndDate":             feedEndDate,
 		"suggestedExpirationDate": suggestedExpirationDate,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_end_date",
+	}
 	return &FeedExpirationDate7DaysNotice{
-		BaseNotice: NewBaseNotice("feed_expiration_date_7_days", WARNING, context),
+		BaseNotice: newLocatedNotice("feed_expiration_date_7_days", WARNING, context, location),
 	}
 }
 
@@ -266,8 +341,13 @@ func NewFeedExpirationDate30DaysNotice(rowNumber int, currentDate string, feedEn
 		"feedEndDate":             feedEndDate,
 		"suggestedExpirationDate": suggestedExpirationDate,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_end_date",
+	}
 	return &FeedExpirationDate30DaysNotice{
-		BaseNotice: NewBaseNotice("feed_expiration_date_30_days", WARNING, context),
+		BaseNotice: newLocatedNotice("feed_expiration_date_30_days", WARNING, context, location),
 	}
 }
 
@@ -282,8 +362,13 @@ func NewMissingRouteNameNotice(routeID string, rowNumber int) *MissingRouteNameN
 		"csvRowNumber": rowNumber,
 		"message":      "Either route_short_name or route_long_name must be provided",
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &MissingRouteNameNotice{
-		BaseNotice: NewBaseNotice("missing_route_name", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_route_name", ERROR, context, location),
 	}
 }
 
@@ -300,8 +385,14 @@ func NewSameNameAndDescriptionNotice(routeID string, fieldName1 string, fieldNam
 		"fieldValue":   fieldValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  fieldName2,
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &SameNameAndDescriptionNotice{
-		BaseNotice: NewBaseNotice("same_name_and_description", WARNING, context),
+		BaseNotice: newLocatedNotice("same_name_and_description", WARNING, context, location),
 	}
 }
 
@@ -318,8 +409,14 @@ func NewRouteShortNameTooLongNotice(routeID string, routeShortName string, actua
 		"maxLength":      maxLength,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_short_name",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RouteShortNameTooLongNotice{
-		BaseNotice: NewBaseNotice("route_short_name_too_long", WARNING, context),
+		BaseNotice: newLocatedNotice("route_short_name_too_long", WARNING, context, location),
 	}
 }
 
@@ -336,8 +433,14 @@ func NewRouteLongNameTooLongNotice(routeID string, routeLongName string, actualL
 		"maxLength":     maxLength,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_long_name",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RouteLongNameTooLongNotice{
-		BaseNotice: NewBaseNotice("route_long_name_too_long", WARNING, context),
+		BaseNotice: newLocatedNotice("route_long_name_too_long", WARNING, context, location),
 	}
 }
 
@@ -352,8 +455,12 @@ func NewTripUsabilityNotice(tripID string, stopCount int, rowNumber int) *TripUs
 		"stopCount":    stopCount,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+	}
 	return &TripUsabilityNotice{
-		BaseNotice: NewBaseNotice("trip_usability", ERROR, context),
+		BaseNotice: newLocatedNotice("trip_usability", ERROR, context, location),
 	}
 }
 
@@ -370,8 +477,13 @@ func NewStopTimeArrivalAfterDepartureNotice(tripID string, stopSequence int, arr
 		"departureTime": departureTime,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &StopTimeArrivalAfterDepartureNotice{
-		BaseNotice: NewBaseNotice("stop_time_arrival_after_departure", ERROR, context),
+		BaseNotice: newLocatedNotice("stop_time_arrival_after_departure", ERROR, context, location),
 	}
 }
 
@@ -390,8 +502,15 @@ func NewStopTimeDecreasingTimeNotice(tripID string, stopSequence int, arrivalTim
 		"prevDepartureTime": prevDepartureTime,
 		"prevCsvRowNumber":  prevRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "stop_times.txt",
+		RowNumber:       rowNumber,
+		FieldName:       "arrival_time",
+		PrimaryKey:      map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+		OtherRowNumbers: []int{prevRowNumber},
+	}
 	return &StopTimeDecreasingTimeNotice{
-		BaseNotice: NewBaseNotice("stop_time_decreasing_time", ERROR, context),
+		BaseNotice: newLocatedNotice("stop_time_decreasing_time", ERROR, context, location),
 	}
 }
 
@@ -407,8 +526,14 @@ func NewDuplicateShapeSequenceNotice(shapeID string, shapePtSequence int, rowNum
 		"csvRowNumber":       rowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "shapes.txt",
+		RowNumber:       rowNumber,
+		PrimaryKey:      map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(shapePtSequence)},
+		OtherRowNumbers: []int{duplicateRowNumber},
+	}
 	return &DuplicateShapeSequenceNotice{
-		BaseNotice: NewBaseNotice("duplicate_shape_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_shape_sequence", ERROR, context, location),
 	}
 }
 
@@ -427,8 +552,15 @@ func NewDecreasingOrEqualShapeDistanceNotice(shapeID string, shapePtSequence int
 		"prevCsvRowNumber":      prevRowNumber,
 		"prevShapeDistTraveled": prevShapeDistTraveled,
 	}
+	location := NoticeLocation{
+		File:            "shapes.txt",
+		RowNumber:       rowNumber,
+		FieldName:       "shape_dist_traveled",
+		PrimaryKey:      map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(shapePtSequence)},
+		OtherRowNumbers: []int{prevRowNumber},
+	}
 	return &DecreasingOrEqualShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("decreasing_or_equal_shape_distance", ERROR, context),
+		BaseNotice: newLocatedNotice("decreasing_or_equal_shape_distance", ERROR, context, location),
 	}
 }
 
@@ -452,8 +584,14 @@ func NewExcessiveTravelSpeedNotice(tripID string, fromStopID string, toStopID st
 		"fromRowNumber":    fromRowNumber,
 		"toRowNumber":      toRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "stop_times.txt",
+		RowNumber:       toRowNumber,
+		PrimaryKey:      map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(toStopSequence)},
+		OtherRowNumbers: []int{fromRowNumber},
+	}
 	return &ExcessiveTravelSpeedNotice{
-		BaseNotice: NewBaseNotice("excessive_travel_speed", WARNING, context),
+		BaseNotice: newLocatedNotice("excessive_travel_speed", WARNING, context, location),
 	}
 }
 
@@ -476,8 +614,14 @@ func NewBlockTripsOverlapNotice(blockID string, trip1ID string, trip2ID string,
 		"trip1RowNumber": trip1RowNumber,
 		"trip2RowNumber": trip2RowNumber,
 	}
+	location := NoticeLocation{
+		File:            "trips.txt",
+		RowNumber:       trip2RowNumber,
+		PrimaryKey:      map[string]string{"trip_id": trip2ID},
+		OtherRowNumbers: []int{trip1RowNumber},
+	}
 	return &BlockTripsOverlapNotice{
-		BaseNotice: NewBaseNotice("block_trips_overlap", ERROR, context),
+		BaseNotice: newLocatedNotice("block_trips_overlap", ERROR, context, location),
 	}
 }
 
@@ -490,8 +634,11 @@ func NewMissingFeedInfoNotice() *MissingFeedInfoNotice {
 	context := map[string]interface{}{
 		"message": "feed_info.txt is required when translations.txt is present",
 	}
+	location := NoticeLocation{
+		File: "feed_info.txt",
+	}
 	return &MissingFeedInfoNotice{
-		BaseNotice: NewBaseNotice("missing_feed_info", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_feed_info", ERROR, context, location),
 	}
 }
 
@@ -504,8 +651,11 @@ func NewMissingFareAttributesNotice() *MissingFareAttributesNotice {
 	context := map[string]interface{}{
 		"message": "fare_attributes.txt is required when fare_rules.txt is present",
 	}
+	location := NoticeLocation{
+		File: "fare_attributes.txt",
+	}
 	return &MissingFareAttributesNotice{
-		BaseNotice: NewBaseNotice("missing_fare_attributes", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_fare_attributes", ERROR, context, location),
 	}
 }
 
@@ -518,8 +668,11 @@ func NewMissingLevelsNotice() *MissingLevelsNotice {
 	context := map[string]interface{}{
 		"message": "levels.txt is required when pathways.txt is present",
 	}
+	location := NoticeLocation{
+		File: "levels.txt",
+	}
 	return &MissingLevelsNotice{
-		BaseNotice: NewBaseNotice("missing_levels", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_levels", ERROR, context, location),
 	}
 }
 
@@ -532,8 +685,11 @@ func NewUnknownFileNotice(filename string) *UnknownFileNotice {
 	context := map[string]interface{}{
 		"filename": filename,
 	}
+	location := NoticeLocation{
+		File: filename,
+	}
 	return &UnknownFileNotice{
-		BaseNotice: NewBaseNotice("unknown_file", INFO, context),
+		BaseNotice: newLocatedNotice("unknown_file", INFO, context, location),
 	}
 }
 
@@ -548,8 +704,12 @@ func NewDuplicateHeaderNotice(filename string, headerName string, positions []in
 		"headerName": headerName,
 		"positions":  positions,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		FieldName: headerName,
+	}
 	return &DuplicateHeaderNotice{
-		BaseNotice: NewBaseNotice("duplicate_header", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_header", ERROR, context, location),
 	}
 }
 
@@ -563,8 +723,12 @@ func NewMissingRequiredColumnNotice(filename string, columnName string) *Missing
 		"filename":   filename,
 		"columnName": columnName,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		FieldName: columnName,
+	}
 	return &MissingRequiredColumnNotice{
-		BaseNotice: NewBaseNotice("missing_required_column", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_required_column", ERROR, context, location),
 	}
 }
 
@@ -580,8 +744,13 @@ func NewInvalidTimeFormatNotice(filename string, fieldName string, timeValue str
 		"timeValue":    timeValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidTimeFormatNotice{
-		BaseNotice: NewBaseNotice("invalid_time_format", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_time_format", ERROR, context, location),
 	}
 }
 
@@ -597,8 +766,13 @@ func NewInvalidDateFormatNotice(filename string, fieldName string, dateValue str
 		"dateValue":    dateValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidDateFormatNotice{
-		BaseNotice: NewBaseNotice("invalid_date_format", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_date_format", ERROR, context, location),
 	}
 }
 
@@ -615,8 +789,13 @@ func NewInvalidCoordinateNotice(filename string, fieldName string, coordValue st
 		"csvRowNumber": rowNumber,
 		"reason":       reason,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidCoordinateNotice{
-		BaseNotice: NewBaseNotice("invalid_coordinate", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_coordinate", ERROR, context, location),
 	}
 }
 
@@ -633,8 +812,13 @@ func NewSuspiciousCoordinateNotice(filename string, fieldName string, coordValue
 		"csvRowNumber": rowNumber,
 		"reason":       reason,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &SuspiciousCoordinateNotice{
-		BaseNotice: NewBaseNotice("suspicious_coordinate", WARNING, context),
+		BaseNotice: newLocatedNotice("suspicious_coordinate", WARNING, context, location),
 	}
 }
 
@@ -651,8 +835,13 @@ func NewInsufficientCoordinatePrecisionNotice(filename string, fieldName string,
 		"csvRowNumber": rowNumber,
 		"decimals":     decimals,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InsufficientCoordinatePrecisionNotice{
-		BaseNotice: NewBaseNotice("insufficient_coordinate_precision", WARNING, context),
+		BaseNotice: newLocatedNotice("insufficient_coordinate_precision", WARNING, context, location),
 	}
 }
 
@@ -669,8 +858,13 @@ func NewInvalidCurrencyCodeNotice(filename string, fieldName string, currencyCod
 		"csvRowNumber": rowNumber,
 		"reason":       reason,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidCurrencyCodeNotice{
-		BaseNotice: NewBaseNotice("invalid_currency_code", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_currency_code", ERROR, context, location),
 	}
 }
 
@@ -685,8 +879,13 @@ func NewMissingAgencyIdNotice(agencyName string, rowNumber int) *MissingAgencyId
 		"csvRowNumber": rowNumber,
 		"message":      "agency_id is required when multiple agencies exist",
 	}
+	location := NoticeLocation{
+		File:      "agency.txt",
+		RowNumber: rowNumber,
+		FieldName: "agency_id",
+	}
 	return &MissingAgencyIdNotice{
-		BaseNotice: NewBaseNotice("missing_agency_id", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_agency_id", ERROR, context, location),
 	}
 }
 
@@ -701,8 +900,14 @@ func NewInvalidAgencyReferenceNotice(routeID string, agencyID string, rowNumber
 		"agencyId":     agencyID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "agency_id",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &InvalidAgencyReferenceNotice{
-		BaseNotice: NewBaseNotice("invalid_agency_reference", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_agency_reference", ERROR, context, location),
 	}
 }
 
@@ -717,8 +922,14 @@ func NewMissingRouteAgencyIdNotice(routeID string, rowNumber int) *MissingRouteA
 		"csvRowNumber": rowNumber,
 		"message":      "agency_id is required for routes when multiple agencies exist",
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "agency_id",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &MissingRouteAgencyIdNotice{
-		BaseNotice: NewBaseNotice("missing_route_agency_id", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_route_agency_id", ERROR, context, location),
 	}
 }
 
@@ -734,8 +945,14 @@ func NewInvalidRouteTypeNotice(routeID string, routeType string, rowNumber int,
 		"csvRowNumber": rowNumber,
 		"reason":       reason,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_type",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &InvalidRouteTypeNotice{
-		BaseNotice: NewBaseNotice("invalid_route_type", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_route_type", ERROR, context, location),
 	}
 }
 
@@ -751,8 +968,14 @@ func NewInvalidColorNotice(routeID string, fieldName string, colorValue string,
 		"colorValue":   colorValue,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  fieldName,
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &InvalidColorNotice{
-		BaseNotice: NewBaseNotice("invalid_color", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_color", ERROR, context, location),
 	}
 }
 
@@ -768,8 +991,14 @@ func NewPoorColorContrastNotice(routeID string, routeColor string, routeTextColo
 		"routeTextColor": routeTextColor,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &PoorColorContrastNotice{
-		BaseNotice: NewBaseNotice("poor_color_contrast", WARNING, context),
+		BaseNotice: newLocatedNotice("poor_color_contrast", WARNING, context, location),
 	}
 }
 
@@ -783,8 +1012,13 @@ func NewServiceWithoutActiveDaysNotice(serviceID string, rowNumber int) *Service
 		"serviceId":    serviceID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &ServiceWithoutActiveDaysNotice{
-		BaseNotice: NewBaseNotice("service_without_active_days", ERROR, context),
+		BaseNotice: newLocatedNotice("service_without_active_days", ERROR, context, location),
 	}
 }
 
@@ -800,8 +1034,14 @@ func NewInvalidServiceDateRangeNotice(serviceID string, startDate string, endDat
 		"endDate":      endDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "end_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &InvalidServiceDateRangeNotice{
-		BaseNotice: NewBaseNotice("invalid_service_date_range", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_service_date_range", ERROR, context, location),
 	}
 }
 
@@ -816,8 +1056,14 @@ func NewExpiredServiceNotice(serviceID string, endDate string, rowNumber int) *E
 		"endDate":      endDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "end_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &ExpiredServiceNotice{
-		BaseNotice: NewBaseNotice("expired_service", WARNING, context),
+		BaseNotice: newLocatedNotice("expired_service", WARNING, context, location),
 	}
 }
 
@@ -832,8 +1078,13 @@ func NewUnusedServiceNotice(serviceID string, filename string, rowNumber int) *U
 		"filename":     filename,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       filename,
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &UnusedServiceNotice{
-		BaseNotice: NewBaseNotice("unused_service", WARNING, context),
+		BaseNotice: newLocatedNotice("unused_service", WARNING, context, location),
 	}
 }
 
@@ -848,8 +1099,14 @@ func NewInvalidLocationTypeNotice(stopID string, locationType int, rowNumber int
 		"locationType": locationType,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "location_type",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidLocationTypeNotice{
-		BaseNotice: NewBaseNotice("invalid_location_type", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_location_type", ERROR, context, location),
 	}
 }
 
@@ -864,8 +1121,13 @@ func NewMissingCoordinatesNotice(stopID string, locationType int, rowNumber int)
 		"locationType": locationType,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &MissingCoordinatesNotice{
-		BaseNotice: NewBaseNotice("missing_coordinates", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_coordinates", ERROR, context, location),
 	}
 }
 
@@ -880,8 +1142,14 @@ func NewInvalidParentStationReferenceNotice(stopID string, parentStation string,
 		"parentStation": parentStation,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "parent_station",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidParentStationReferenceNotice{
-		BaseNotice: NewBaseNotice("invalid_parent_station_reference", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_parent_station_reference", ERROR, context, location),
 	}
 }
 
@@ -897,8 +1165,14 @@ func NewInvalidParentStationTypeNotice(stopID string, parentStation string, pare
 		"parentLocationType": parentLocationType,
 		"csvRowNumber":       rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "parent_station",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidParentStationTypeNotice{
-		BaseNotice: NewBaseNotice("invalid_parent_station_type", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_parent_station_type", ERROR, context, location),
 	}
 }
 
@@ -913,8 +1187,14 @@ func NewStationWithParentStationNotice(stopID string, parentStation string, rowN
 		"parentStation": parentStation,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "parent_station",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StationWithParentStationNotice{
-		BaseNotice: NewBaseNotice("station_with_parent_station", ERROR, context),
+		BaseNotice: newLocatedNotice("station_with_parent_station", ERROR, context, location),
 	}
 }
 
@@ -929,8 +1209,14 @@ func NewMissingParentStationNotice(stopID string, locationType int, rowNumber in
 		"locationType": locationType,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "parent_station",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &MissingParentStationNotice{
-		BaseNotice: NewBaseNotice("missing_parent_station", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_parent_station", ERROR, context, location),
 	}
 }
 
@@ -944,8 +1230,14 @@ func NewCircularStationReferenceNotice(stopID string, rowNumber int) *CircularSt
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "parent_station",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &CircularStationReferenceNotice{
-		BaseNotice: NewBaseNotice("circular_station_reference", ERROR, context),
+		BaseNotice: newLocatedNotice("circular_station_reference", ERROR, context, location),
 	}
 }
 
@@ -959,8 +1251,13 @@ func NewOrphanedStationNotice(stationID string, rowNumber int) *OrphanedStationN
 		"stationId":    stationID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"stop_id": stationID},
+	}
 	return &OrphanedStationNotice{
-		BaseNotice: NewBaseNotice("orphaned_station", WARNING, context),
+		BaseNotice: newLocatedNotice("orphaned_station", WARNING, context, location),
 	}
 }
 
@@ -976,8 +1273,14 @@ func NewInvalidFrequencyTimeRangeNotice(tripID string, startTime string, endTime
 		"endTime":      endTime,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "frequencies.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "end_time",
+		PrimaryKey: map[string]string{"trip_id": tripID, "start_time": startTime},
+	}
 	return &InvalidFrequencyTimeRangeNotice{
-		BaseNotice: NewBaseNotice("invalid_frequency_time_range", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_frequency_time_range", ERROR, context, location),
 	}
 }
 
@@ -992,8 +1295,13 @@ func NewInvalidHeadwayNotice(tripID string, headwaySecs int, rowNumber int) *Inv
 		"headwaySecs":  headwaySecs,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "frequencies.txt",
+		RowNumber: rowNumber,
+		FieldName: "headway_secs",
+	}
 	return &InvalidHeadwayNotice{
-		BaseNotice: NewBaseNotice("invalid_headway", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_headway", ERROR, context, location),
 	}
 }
 
@@ -1008,8 +1316,13 @@ func NewUnreasonableHeadwayNotice(tripID string, headwaySecs int, rowNumber int)
 		"headwaySecs":  headwaySecs,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "frequencies.txt",
+		RowNumber: rowNumber,
+		FieldName: "headway_secs",
+	}
 	return &UnreasonableHeadwayNotice{
-		BaseNotice: NewBaseNotice("unreasonable_headway", WARNING, context),
+		BaseNotice: newLocatedNotice("unreasonable_headway", WARNING, context, location),
 	}
 }
 
@@ -1024,8 +1337,13 @@ func NewInvalidExactTimesNotice(tripID string, exactTimes int, rowNumber int) *I
 		"exactTimes":   exactTimes,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "frequencies.txt",
+		RowNumber: rowNumber,
+		FieldName: "exact_times",
+	}
 	return &InvalidExactTimesNotice{
-		BaseNotice: NewBaseNotice("invalid_exact_times", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_exact_times", ERROR, context, location),
 	}
 }
 
@@ -1044,8 +1362,14 @@ func NewOverlappingFrequencyNotice(tripID string, startTime1 string, endTime1 st
 		"endTime2":   endTime2,
 		"rowNumber2": rowNumber2,
 	}
+	location := NoticeLocation{
+		File:            "frequencies.txt",
+		RowNumber:       rowNumber2,
+		PrimaryKey:      map[string]string{"trip_id": tripID, "start_time": startTime2},
+		OtherRowNumbers: []int{rowNumber1},
+	}
 	return &OverlappingFrequencyNotice{
-		BaseNotice: NewBaseNotice("overlapping_frequency", ERROR, context),
+		BaseNotice: newLocatedNotice("overlapping_frequency", ERROR, context, location),
 	}
 }
 
@@ -1061,8 +1385,14 @@ func NewInvalidTransferTypeNotice(fromStopID string, toStopID string, transferTy
 		"transferType": transferType,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfer_type",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &InvalidTransferTypeNotice{
-		BaseNotice: NewBaseNotice("invalid_transfer_type", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_transfer_type", ERROR, context, location),
 	}
 }
 
@@ -1076,8 +1406,13 @@ func NewTransferToSameStopNotice(stopID string, rowNumber int) *TransferToSameSt
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"from_stop_id": stopID, "to_stop_id": stopID},
+	}
 	return &TransferToSameStopNotice{
-		BaseNotice: NewBaseNotice("transfer_to_same_stop", WARNING, context),
+		BaseNotice: newLocatedNotice("transfer_to_same_stop", WARNING, context, location),
 	}
 }
 
@@ -1092,8 +1427,14 @@ func NewMissingMinTransferTimeNotice(fromStopID string, toStopID string, rowNumb
 		"toStopId":     toStopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &MissingMinTransferTimeNotice{
-		BaseNotice: NewBaseNotice("missing_min_transfer_time", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_min_transfer_time", ERROR, context, location),
 	}
 }
 
@@ -1109,8 +1450,14 @@ func NewUnnecessaryMinTransferTimeNotice(fromStopID string, toStopID string, min
 		"minTransferTime": minTransferTime,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &UnnecessaryMinTransferTimeNotice{
-		BaseNotice: NewBaseNotice("unnecessary_min_transfer_time", WARNING, context),
+		BaseNotice: newLocatedNotice("unnecessary_min_transfer_time", WARNING, context, location),
 	}
 }
 
@@ -1126,8 +1473,14 @@ func NewNegativeMinTransferTimeNotice(fromStopID string, toStopID string, minTra
 		"minTransferTime": minTransferTime,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &NegativeMinTransferTimeNotice{
-		BaseNotice: NewBaseNotice("negative_min_transfer_time", ERROR, context),
+		BaseNotice: newLocatedNotice("negative_min_transfer_time", ERROR, context, location),
 	}
 }
 
@@ -1143,8 +1496,14 @@ func NewUnreasonableMinTransferTimeNotice(fromStopID string, toStopID string, mi
 		"minTransferTime": minTransferTime,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &UnreasonableMinTransferTimeNotice{
-		BaseNotice: NewBaseNotice("unreasonable_min_transfer_time", WARNING, context),
+		BaseNotice: newLocatedNotice("unreasonable_min_transfer_time", WARNING, context, location),
 	}
 }
 
@@ -1160,8 +1519,14 @@ func NewDuplicateTransferNotice(fromStopID string, toStopID string, rowNumber in
 		"csvRowNumber":       rowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "transfers.txt",
+		RowNumber:       rowNumber,
+		PrimaryKey:      map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+		OtherRowNumbers: []int{duplicateRowNumber},
+	}
 	return &DuplicateTransferNotice{
-		BaseNotice: NewBaseNotice("duplicate_transfer", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_transfer", ERROR, context, location),
 	}
 }
 
@@ -1178,8 +1543,14 @@ func NewInvalidPathwayModeNotice(pathwayID string, pathwayMode int, rowNumber in
 		"pathwayMode":  pathwayMode,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "pathway_mode",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidPathwayModeNotice{
-		BaseNotice: NewBaseNotice("invalid_pathway_mode", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_pathway_mode", ERROR, context, location),
 	}
 }
 
@@ -1194,8 +1565,14 @@ func NewInvalidBidirectionalNotice(pathwayID string, isBidirectional int, rowNum
 		"isBidirectional": isBidirectional,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "is_bidirectional",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidBidirectionalNotice{
-		BaseNotice: NewBaseNotice("invalid_bidirectional", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_bidirectional", ERROR, context, location),
 	}
 }
 
@@ -1210,8 +1587,13 @@ func NewPathwayToSameStopNotice(pathwayID string, stopID string, rowNumber int)
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &PathwayToSameStopNotice{
-		BaseNotice: NewBaseNotice("pathway_to_same_stop", ERROR, context),
+		BaseNotice: newLocatedNotice("pathway_to_same_stop", ERROR, context, location),
 	}
 }
 
@@ -1226,8 +1608,14 @@ func NewInvalidStairCountNotice(pathwayID string, stairCount int, rowNumber int)
 		"stairCount":   stairCount,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stair_count",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidStairCountNotice{
-		BaseNotice: NewBaseNotice("invalid_stair_count", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_stair_count", ERROR, context, location),
 	}
 }
 
@@ -1242,8 +1630,14 @@ func NewUnexpectedBidirectionalGateNotice(pathwayID string, pathwayMode int, row
 		"pathwayMode":  pathwayMode,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "is_bidirectional",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &UnexpectedBidirectionalGateNotice{
-		BaseNotice: NewBaseNotice("unexpected_bidirectional_gate", WARNING, context),
+		BaseNotice: newLocatedNotice("unexpected_bidirectional_gate", WARNING, context, location),
 	}
 }
 
@@ -1258,8 +1652,14 @@ func NewInvalidPathwayLengthNotice(pathwayID string, length float64, rowNumber i
 		"length":       length,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "length",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidPathwayLengthNotice{
-		BaseNotice: NewBaseNotice("invalid_pathway_length", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_pathway_length", ERROR, context, location),
 	}
 }
 
@@ -1274,8 +1674,14 @@ func NewInvalidTraversalTimeNotice(pathwayID string, traversalTime int, rowNumbe
 		"traversalTime": traversalTime,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "traversal_time",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidTraversalTimeNotice{
-		BaseNotice: NewBaseNotice("invalid_traversal_time", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_traversal_time", ERROR, context, location),
 	}
 }
 
@@ -1290,8 +1696,14 @@ func NewUnreasonableMaxSlopeNotice(pathwayID string, maxSlope float64, rowNumber
 		"maxSlope":     maxSlope,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "max_slope",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &UnreasonableMaxSlopeNotice{
-		BaseNotice: NewBaseNotice("unreasonable_max_slope", WARNING, context),
+		BaseNotice: newLocatedNotice("unreasonable_max_slope", WARNING, context, location),
 	}
 }
 
@@ -1306,8 +1718,14 @@ func NewInvalidMinWidthNotice(pathwayID string, minWidth float64, rowNumber int)
 		"minWidth":     minWidth,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "pathways.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_width",
+		PrimaryKey: map[string]string{"pathway_id": pathwayID},
+	}
 	return &InvalidMinWidthNotice{
-		BaseNotice: NewBaseNotice("invalid_min_width", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_min_width", ERROR, context, location),
 	}
 }
 
@@ -1324,8 +1742,14 @@ func NewDuplicatePathwayNotice(pathwayID string, fromStopID string, toStopID str
 		"csvRowNumber":       rowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "pathways.txt",
+		RowNumber:       rowNumber,
+		PrimaryKey:      map[string]string{"pathway_id": pathwayID},
+		OtherRowNumbers: []int{duplicateRowNumber},
+	}
 	return &DuplicatePathwayNotice{
-		BaseNotice: NewBaseNotice("duplicate_pathway", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_pathway", WARNING, context, location),
 	}
 }
 
@@ -1341,8 +1765,14 @@ func NewInconsistentBidirectionalPathwayNotice(pathwayID1 string, pathwayID2 str
 		"csvRowNumber1": rowNumber1,
 		"csvRowNumber2": rowNumber2,
 	}
+	location := NoticeLocation{
+		File:            "pathways.txt",
+		RowNumber:       rowNumber2,
+		PrimaryKey:      map[string]string{"pathway_id": pathwayID2},
+		OtherRowNumbers: []int{rowNumber1},
+	}
 	return &InconsistentBidirectionalPathwayNotice{
-		BaseNotice: NewBaseNotice("inconsistent_bidirectional_pathway", WARNING, context),
+		BaseNotice: newLocatedNotice("inconsistent_bidirectional_pathway", WARNING, context, location),
 	}
 }
 
@@ -1359,8 +1789,14 @@ func NewInvalidPaymentMethodNotice(fareID string, paymentMethod int, rowNumber i
 		"paymentMethod": paymentMethod,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "payment_method",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &InvalidPaymentMethodNotice{
-		BaseNotice: NewBaseNotice("invalid_payment_method", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_payment_method", ERROR, context, location),
 	}
 }
 
@@ -1375,8 +1811,14 @@ func NewInvalidTransfersNotice(fareID string, transfers int, rowNumber int) *Inv
 		"transfers":    transfers,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfers",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &InvalidTransfersNotice{
-		BaseNotice: NewBaseNotice("invalid_transfers", WARNING, context),
+		BaseNotice: newLocatedNotice("invalid_transfers", WARNING, context, location),
 	}
 }
 
@@ -1391,8 +1833,14 @@ func NewUnusualTransferValueNotice(fareID string, transfers int, rowNumber int)
 		"transfers":    transfers,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfers",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &UnusualTransferValueNotice{
-		BaseNotice: NewBaseNotice("unusual_transfer_value", WARNING, context),
+		BaseNotice: newLocatedNotice("unusual_transfer_value", WARNING, context, location),
 	}
 }
 
@@ -1407,8 +1855,14 @@ func NewInvalidTransferDurationNotice(fareID string, transferDuration int, rowNu
 		"transferDuration": transferDuration,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfer_duration",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &InvalidTransferDurationNotice{
-		BaseNotice: NewBaseNotice("invalid_transfer_duration", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_transfer_duration", ERROR, context, location),
 	}
 }
 
@@ -1423,8 +1877,14 @@ func NewUnnecessaryTransferDurationNotice(fareID string, transferDuration int, r
 		"transferDuration": transferDuration,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfer_duration",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &UnnecessaryTransferDurationNotice{
-		BaseNotice: NewBaseNotice("unnecessary_transfer_duration", WARNING, context),
+		BaseNotice: newLocatedNotice("unnecessary_transfer_duration", WARNING, context, location),
 	}
 }
 
@@ -1440,8 +1900,14 @@ func NewInvalidFarePriceNotice(fareID string, price string, rowNumber int, reaso
 		"csvRowNumber": rowNumber,
 		"reason":       reason,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "price",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &InvalidFarePriceNotice{
-		BaseNotice: NewBaseNotice("invalid_fare_price", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_fare_price", ERROR, context, location),
 	}
 }
 
@@ -1457,8 +1923,14 @@ func NewExcessivePricePrecisionNotice(fareID string, price string, decimals int,
 		"decimals":     decimals,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "price",
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &ExcessivePricePrecisionNotice{
-		BaseNotice: NewBaseNotice("excessive_price_precision", WARNING, context),
+		BaseNotice: newLocatedNotice("excessive_price_precision", WARNING, context, location),
 	}
 }
 
@@ -1472,8 +1944,12 @@ func NewEmptyFareRuleNotice(fareID string, rowNumber int) *EmptyFareRuleNotice {
 		"fareId":       fareID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "fare_rules.txt",
+		RowNumber: rowNumber,
+	}
 	return &EmptyFareRuleNotice{
-		BaseNotice: NewBaseNotice("empty_fare_rule", WARNING, context),
+		BaseNotice: newLocatedNotice("empty_fare_rule", WARNING, context, location),
 	}
 }
 
@@ -1488,8 +1964,13 @@ func NewSameOriginDestinationNotice(fareID string, zoneID string, rowNumber int)
 		"zoneId":       zoneID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "fare_rules.txt",
+		RowNumber: rowNumber,
+		FieldName: "destination_id",
+	}
 	return &SameOriginDestinationNotice{
-		BaseNotice: NewBaseNotice("same_origin_destination", WARNING, context),
+		BaseNotice: newLocatedNotice("same_origin_destination", WARNING, context, location),
 	}
 }
 
@@ -1503,8 +1984,12 @@ func NewConflictingFareRuleFieldsNotice(fareID string, rowNumber int) *Conflicti
 		"fareId":       fareID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "fare_rules.txt",
+		RowNumber: rowNumber,
+	}
 	return &ConflictingFareRuleFieldsNotice{
-		BaseNotice: NewBaseNotice("conflicting_fare_rule_fields", WARNING, context),
+		BaseNotice: newLocatedNotice("conflicting_fare_rule_fields", WARNING, context, location),
 	}
 }
 
@@ -1518,8 +2003,13 @@ func NewUnusedFareAttributeNotice(fareID string, rowNumber int) *UnusedFareAttri
 		"fareId":       fareID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "fare_attributes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"fare_id": fareID},
+	}
 	return &UnusedFareAttributeNotice{
-		BaseNotice: NewBaseNotice("unused_fare_attribute", WARNING, context),
+		BaseNotice: newLocatedNotice("unused_fare_attribute", WARNING, context, location),
 	}
 }
 
@@ -1536,8 +2026,14 @@ func NewUnreasonableLevelIndexNotice(levelID string, levelIndex float64, rowNumb
 		"levelIndex":   levelIndex,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "levels.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "level_index",
+		PrimaryKey: map[string]string{"level_id": levelID},
+	}
 	return &UnreasonableLevelIndexNotice{
-		BaseNotice: NewBaseNotice("unreasonable_level_index", WARNING, context),
+		BaseNotice: newLocatedNotice("unreasonable_level_index", WARNING, context, location),
 	}
 }
 
@@ -1553,8 +2049,15 @@ func NewDuplicateLevelIndexNotice(levelID string, levelIndex float64, rowNumber
 		"csvRowNumber":       rowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "levels.txt",
+		RowNumber:       rowNumber,
+		FieldName:       "level_index",
+		PrimaryKey:      map[string]string{"level_id": levelID},
+		OtherRowNumbers: []int{duplicateRowNumber},
+	}
 	return &DuplicateLevelIndexNotice{
-		BaseNotice: NewBaseNotice("duplicate_level_index", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_level_index", ERROR, context, location),
 	}
 }
 
@@ -1568,8 +2071,13 @@ func NewUnusedLevelNotice(levelID string, rowNumber int) *UnusedLevelNotice {
 		"levelId":      levelID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "levels.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"level_id": levelID},
+	}
 	return &UnusedLevelNotice{
-		BaseNotice: NewBaseNotice("unused_level", WARNING, context),
+		BaseNotice: newLocatedNotice("unused_level", WARNING, context, location),
 	}
 }
 
@@ -1585,8 +2093,11 @@ func NewInsufficientShapePointsNotice(shapeID string, pointCount int) *Insuffici
 		"shapeId":    shapeID,
 		"pointCount": pointCount,
 	}
+	location := NoticeLocation{
+		File: "shapes.txt",
+	}
 	return &InsufficientShapePointsNotice{
-		BaseNotice: NewBaseNotice("insufficient_shape_points", ERROR, context),
+		BaseNotice: newLocatedNotice("insufficient_shape_points", ERROR, context, location),
 	}
 }
 
@@ -1602,8 +2113,14 @@ func NewNonIncreasingShapeSequenceNotice(shapeID string, currentSequence int, pr
 		"previousSequence": previousSequence,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_pt_sequence",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &NonIncreasingShapeSequenceNotice{
-		BaseNotice: NewBaseNotice("non_increasing_shape_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("non_increasing_shape_sequence", ERROR, context, location),
 	}
 }
 
@@ -1618,8 +2135,14 @@ func NewInconsistentShapeDistanceNotice(shapeID string, sequence int, rowNumber
 		"shapePtSequence": sequence,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(sequence)},
+	}
 	return &InconsistentShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("inconsistent_shape_distance", WARNING, context),
+		BaseNotice: newLocatedNotice("inconsistent_shape_distance", WARNING, context, location),
 	}
 }
 
@@ -1636,8 +2159,14 @@ func NewDecreasingShapeDistanceNotice(shapeID string, sequence int, currentDista
 		"previousDistance": previousDistance,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(sequence)},
+	}
 	return &DecreasingShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("decreasing_shape_distance", ERROR, context),
+		BaseNotice: newLocatedNotice("decreasing_shape_distance", ERROR, context, location),
 	}
 }
 
@@ -1654,8 +2183,14 @@ func NewEqualShapeDistanceNotice(shapeID string, currentSequence int, previousSe
 		"distance":         distance,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &EqualShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("equal_shape_distance", WARNING, context),
+		BaseNotice: newLocatedNotice("equal_shape_distance", WARNING, context, location),
 	}
 }
 
@@ -1671,8 +2206,13 @@ func NewDuplicateShapePointNotice(shapeID string, currentSequence int, previousS
 		"previousSequence": previousSequence,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &DuplicateShapePointNotice{
-		BaseNotice: NewBaseNotice("duplicate_shape_point", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_shape_point", WARNING, context, location),
 	}
 }
 
@@ -1689,8 +2229,13 @@ func NewUnreasonablyLongShapeSegmentNotice(shapeID string, fromSequence int, toS
 		"distance":     distance,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(toSequence)},
+	}
 	return &UnreasonablyLongShapeSegmentNotice{
-		BaseNotice: NewBaseNotice("unreasonably_long_shape_segment", WARNING, context),
+		BaseNotice: newLocatedNotice("unreasonably_long_shape_segment", WARNING, context, location),
 	}
 }
 
@@ -1703,8 +2248,11 @@ func NewUnusedShapeNotice(shapeID string) *UnusedShapeNotice {
 	context := map[string]interface{}{
 		"shapeId": shapeID,
 	}
+	location := NoticeLocation{
+		File: "shapes.txt",
+	}
 	return &UnusedShapeNotice{
-		BaseNotice: NewBaseNotice("unused_shape", WARNING, context),
+		BaseNotice: newLocatedNotice("unused_shape", WARNING, context, location),
 	}
 }
 
@@ -1720,8 +2268,13 @@ func NewServiceNeverActiveNotice(serviceID string, rowNumber int) *ServiceNeverA
 		"serviceId":    serviceID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &ServiceNeverActiveNotice{
-		BaseNotice: NewBaseNotice("service_never_active", ERROR, context),
+		BaseNotice: newLocatedNotice("service_never_active", ERROR, context, location),
 	}
 }
 
@@ -1736,8 +2289,14 @@ func NewFutureServiceNotice(serviceID string, startDate string, rowNumber int) *
 		"startDate":    startDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "start_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &FutureServiceNotice{
-		BaseNotice: NewBaseNotice("future_service", WARNING, context),
+		BaseNotice: newLocatedNotice("future_service", WARNING, context, location),
 	}
 }
 
@@ -1753,8 +2312,14 @@ func NewInvalidExceptionTypeNotice(serviceID string, date string, exceptionType
 		"exceptionType": exceptionType,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar_dates.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "exception_type",
+		PrimaryKey: map[string]string{"service_id": serviceID, "date": date},
+	}
 	return &InvalidExceptionTypeNotice{
-		BaseNotice: NewBaseNotice("invalid_exception_type", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_exception_type", ERROR, context, location),
 	}
 }
 
@@ -1769,8 +2334,14 @@ func NewVeryOldCalendarDateNotice(serviceID string, date string, rowNumber int)
 		"date":         date,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar_dates.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "date",
+		PrimaryKey: map[string]string{"service_id": serviceID, "date": date},
+	}
 	return &VeryOldCalendarDateNotice{
-		BaseNotice: NewBaseNotice("very_old_calendar_date", WARNING, context),
+		BaseNotice: newLocatedNotice("very_old_calendar_date", WARNING, context, location),
 	}
 }
 
@@ -1785,8 +2356,14 @@ func NewVeryFutureCalendarDateNotice(serviceID string, date string, rowNumber in
 		"date":         date,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar_dates.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "date",
+		PrimaryKey: map[string]string{"service_id": serviceID, "date": date},
+	}
 	return &VeryFutureCalendarDateNotice{
-		BaseNotice: NewBaseNotice("very_future_calendar_date", WARNING, context),
+		BaseNotice: newLocatedNotice("very_future_calendar_date", WARNING, context, location),
 	}
 }
 
@@ -1816,8 +2393,14 @@ func NewConflictingCalendarExceptionNotice(serviceID string, date string, rowNum
 		"csvRowNumber1": rowNumber1,
 		"csvRowNumber2": rowNumber2,
 	}
+	location := NoticeLocation{
+		File:            "calendar_dates.txt",
+		RowNumber:       rowNumber2,
+		PrimaryKey:      map[string]string{"service_id": serviceID, "date": date},
+		OtherRowNumbers: []int{rowNumber1},
+	}
 	return &ConflictingCalendarExceptionNotice{
-		BaseNotice: NewBaseNotice("conflicting_calendar_exception", ERROR, context),
+		BaseNotice: newLocatedNotice("conflicting_calendar_exception", ERROR, context, location),
 	}
 }
 
@@ -1833,8 +2416,14 @@ func NewDuplicateCalendarExceptionNotice(serviceID string, date string, rowNumbe
 		"csvRowNumber1": rowNumber1,
 		"csvRowNumber2": rowNumber2,
 	}
+	location := NoticeLocation{
+		File:            "calendar_dates.txt",
+		RowNumber:       rowNumber2,
+		PrimaryKey:      map[string]string{"service_id": serviceID, "date": date},
+		OtherRowNumbers: []int{rowNumber1},
+	}
 	return &DuplicateCalendarExceptionNotice{
-		BaseNotice: NewBaseNotice("duplicate_calendar_exception", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_calendar_exception", WARNING, context, location),
 	}
 }
 
@@ -1850,8 +2439,13 @@ func NewMissingAttributionRoleNotice(attributionID string, rowNumber int) *Missi
 		"attributionId": attributionID,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &MissingAttributionRoleNotice{
-		BaseNotice: NewBaseNotice("missing_attribution_role", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_attribution_role", ERROR, context, location),
 	}
 }
 
@@ -1865,8 +2459,13 @@ func NewMultipleAttributionScopesNotice(attributionID string, rowNumber int) *Mu
 		"attributionId": attributionID,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &MultipleAttributionScopesNotice{
-		BaseNotice: NewBaseNotice("multiple_attribution_scopes", WARNING, context),
+		BaseNotice: newLocatedNotice("multiple_attribution_scopes", WARNING, context, location),
 	}
 }
 
@@ -1880,8 +2479,13 @@ func NewConflictingAttributionScopeNotice(attributionID string, rowNumber int) *
 		"attributionId": attributionID,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &ConflictingAttributionScopeNotice{
-		BaseNotice: NewBaseNotice("conflicting_attribution_scope", ERROR, context),
+		BaseNotice: newLocatedNotice("conflicting_attribution_scope", ERROR, context, location),
 	}
 }
 
@@ -1895,8 +2499,13 @@ func NewMissingAttributionContactNotice(attributionID string, rowNumber int) *Mi
 		"attributionId": attributionID,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &MissingAttributionContactNotice{
-		BaseNotice: NewBaseNotice("missing_attribution_contact", WARNING, context),
+		BaseNotice: newLocatedNotice("missing_attribution_contact", WARNING, context, location),
 	}
 }
 
@@ -1913,8 +2522,14 @@ func NewDuplicateAttributionScopeNotice(attributionID1 string, attributionID2 st
 		"csvRowNumber1":  rowNumber1,
 		"csvRowNumber2":  rowNumber2,
 	}
+	location := NoticeLocation{
+		File:            "attributions.txt",
+		RowNumber:       rowNumber2,
+		PrimaryKey:      map[string]string{"attribution_id": attributionID2},
+		OtherRowNumbers: []int{rowNumber1},
+	}
 	return &DuplicateAttributionScopeNotice{
-		BaseNotice: NewBaseNotice("duplicate_attribution_scope", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_attribution_scope", WARNING, context, location),
 	}
 }
 
@@ -1929,8 +2544,11 @@ func NewMultipleFeedInfoEntriesNotice(count int) *MultipleFeedInfoEntriesNotice
 	context := map[string]interface{}{
 		"count": count,
 	}
+	location := NoticeLocation{
+		File: "feed_info.txt",
+	}
 	return &MultipleFeedInfoEntriesNotice{
-		BaseNotice: NewBaseNotice("multiple_feed_info_entries", ERROR, context),
+		BaseNotice: newLocatedNotice("multiple_feed_info_entries", ERROR, context, location),
 	}
 }
 
@@ -1946,8 +2564,13 @@ func NewInvalidLanguageCodeNotice(filename string, fieldName string, languageCod
 		"languageCode": languageCode,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &InvalidLanguageCodeNotice{
-		BaseNotice: NewBaseNotice("invalid_language_code", WARNING, context),
+		BaseNotice: newLocatedNotice("invalid_language_code", WARNING, context, location),
 	}
 }
 
@@ -1962,8 +2585,13 @@ func NewFeedInfoEndDateBeforeStartDateNotice(startDate string, endDate string, r
 		"endDate":      endDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_end_date",
+	}
 	return &FeedInfoEndDateBeforeStartDateNotice{
-		BaseNotice: NewBaseNotice("feed_info_end_date_before_start_date", ERROR, context),
+		BaseNotice: newLocatedNotice("feed_info_end_date_before_start_date", ERROR, context, location),
 	}
 }
 
@@ -1977,8 +2605,13 @@ func NewExpiredFeedNotice(endDate string, rowNumber int) *ExpiredFeedNotice {
 		"endDate":      endDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_end_date",
+	}
 	return &ExpiredFeedNotice{
-		BaseNotice: NewBaseNotice("expired_feed", WARNING, context),
+		BaseNotice: newLocatedNotice("expired_feed", WARNING, context, location),
 	}
 }
 
@@ -1992,8 +2625,13 @@ func NewFutureFeedStartDateNotice(startDate string, rowNumber int) *FutureFeedSt
 		"startDate":    startDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_start_date",
+	}
 	return &FutureFeedStartDateNotice{
-		BaseNotice: NewBaseNotice("future_feed_start_date", WARNING, context),
+		BaseNotice: newLocatedNotice("future_feed_start_date", WARNING, context, location),
 	}
 }
 
@@ -2010,8 +2648,14 @@ func NewSingleStopZoneNotice(zoneID string, stopID string, rowNumber int) *Singl
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "zone_id",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &SingleStopZoneNotice{
-		BaseNotice: NewBaseNotice("single_stop_zone", WARNING, context),
+		BaseNotice: newLocatedNotice("single_stop_zone", WARNING, context, location),
 	}
 }
 
@@ -2025,8 +2669,13 @@ func NewUnusedZoneNotice(zoneID string, rowNumber int) *UnusedZoneNotice {
 		"zoneId":       zoneID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stops.txt",
+		RowNumber: rowNumber,
+		FieldName: "zone_id",
+	}
 	return &UnusedZoneNotice{
-		BaseNotice: NewBaseNotice("unused_zone", WARNING, context),
+		BaseNotice: newLocatedNotice("unused_zone", WARNING, context, location),
 	}
 }
 
@@ -2055,8 +2704,13 @@ func NewLongZoneIDNotice(zoneID string, length int, rowNumber int) *LongZoneIDNo
 		"length":       length,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stops.txt",
+		RowNumber: rowNumber,
+		FieldName: "zone_id",
+	}
 	return &LongZoneIDNotice{
-		BaseNotice: NewBaseNotice("long_zone_id", WARNING, context),
+		BaseNotice: newLocatedNotice("long_zone_id", WARNING, context, location),
 	}
 }
 
@@ -2070,8 +2724,14 @@ func NewZoneIDSameAsStopIDNotice(zoneID string, rowNumber int) *ZoneIDSameAsStop
 		"zoneId":       zoneID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "zone_id",
+		PrimaryKey: map[string]string{"stop_id": zoneID},
+	}
 	return &ZoneIDSameAsStopIDNotice{
-		BaseNotice: NewBaseNotice("zone_id_same_as_stop_id", WARNING, context),
+		BaseNotice: newLocatedNotice("zone_id_same_as_stop_id", WARNING, context, location),
 	}
 }
 
@@ -2088,8 +2748,12 @@ func NewMissingTripFirstTimeNotice(tripID string, stopID string, rowNumber int)
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+	}
 	return &MissingTripFirstTimeNotice{
-		BaseNotice: NewBaseNotice("missing_trip_first_time", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_trip_first_time", ERROR, context, location),
 	}
 }
 
@@ -2104,8 +2768,12 @@ func NewMissingTripLastTimeNotice(tripID string, stopID string, rowNumber int) *
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+	}
 	return &MissingTripLastTimeNotice{
-		BaseNotice: NewBaseNotice("missing_trip_last_time", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_trip_last_time", ERROR, context, location),
 	}
 }
 
@@ -2121,8 +2789,13 @@ func NewLoopRouteNotice(tripID string, stopID string, firstRowNumber int, lastRo
 		"firstRowNumber": firstRowNumber,
 		"lastRowNumber":  lastRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "stop_times.txt",
+		RowNumber:       lastRowNumber,
+		OtherRowNumbers: []int{firstRowNumber},
+	}
 	return &LoopRouteNotice{
-		BaseNotice: NewBaseNotice("loop_route", INFO, context),
+		BaseNotice: newLocatedNotice("loop_route", INFO, context, location),
 	}
 }
 
@@ -2138,8 +2811,13 @@ func NewDuplicateStopInTripNotice(tripID string, stopID string, stopSequence int
 		"stopSequence": stopSequence,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &DuplicateStopInTripNotice{
-		BaseNotice: NewBaseNotice("duplicate_stop_in_trip", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_stop_in_trip", WARNING, context, location),
 	}
 }
 
@@ -2155,8 +2833,14 @@ func NewMissingArrivalTimeNotice(tripID string, stopID string, stopSequence int,
 		"stopSequence": stopSequence,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "arrival_time",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &MissingArrivalTimeNotice{
-		BaseNotice: NewBaseNotice("missing_arrival_time", WARNING, context),
+		BaseNotice: newLocatedNotice("missing_arrival_time", WARNING, context, location),
 	}
 }
 
@@ -2172,8 +2856,14 @@ func NewMissingDepartureTimeNotice(tripID string, stopID string, stopSequence in
 		"stopSequence": stopSequence,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "departure_time",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &MissingDepartureTimeNotice{
-		BaseNotice: NewBaseNotice("missing_departure_time", WARNING, context),
+		BaseNotice: newLocatedNotice("missing_departure_time", WARNING, context, location),
 	}
 }
 
@@ -2189,8 +2879,13 @@ func NewInvalidTimepointNotice(tripID string, stopID string, timepoint int, rowN
 		"timepoint":    timepoint,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+		FieldName: "timepoint",
+	}
 	return &InvalidTimepointNotice{
-		BaseNotice: NewBaseNotice("invalid_timepoint", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_timepoint", ERROR, context, location),
 	}
 }
 
@@ -2206,8 +2901,13 @@ func NewTimepointWithoutTimesNotice(tripID string, stopID string, stopSequence i
 		"stopSequence": stopSequence,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &TimepointWithoutTimesNotice{
-		BaseNotice: NewBaseNotice("timepoint_without_times", INFO, context),
+		BaseNotice: newLocatedNotice("timepoint_without_times", INFO, context, location),
 	}
 }
 
@@ -2222,8 +2922,12 @@ func NewFirstStopNoPickupNotice(tripID string, stopID string, rowNumber int) *Fi
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+	}
 	return &FirstStopNoPickupNotice{
-		BaseNotice: NewBaseNotice("first_stop_no_pickup", WARNING, context),
+		BaseNotice: newLocatedNotice("first_stop_no_pickup", WARNING, context, location),
 	}
 }
 
@@ -2238,8 +2942,12 @@ func NewLastStopNoDropOffNotice(tripID string, stopID string, rowNumber int) *La
 		"stopId":       stopID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+	}
 	return &LastStopNoDropOffNotice{
-		BaseNotice: NewBaseNotice("last_stop_no_drop_off", WARNING, context),
+		BaseNotice: newLocatedNotice("last_stop_no_drop_off", WARNING, context, location),
 	}
 }
 
@@ -2252,8 +2960,12 @@ func NewAllStopsNoPickupNotice(tripID string) *AllStopsNoPickupNotice {
 	context := map[string]interface{}{
 		"tripId": tripID,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &AllStopsNoPickupNotice{
-		BaseNotice: NewBaseNotice("all_stops_no_pickup", ERROR, context),
+		BaseNotice: newLocatedNotice("all_stops_no_pickup", ERROR, context, location),
 	}
 }
 
@@ -2266,8 +2978,12 @@ func NewAllStopsNoDropOffNotice(tripID string) *AllStopsNoDropOffNotice {
 	context := map[string]interface{}{
 		"tripId": tripID,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &AllStopsNoDropOffNotice{
-		BaseNotice: NewBaseNotice("all_stops_no_drop_off", ERROR, context),
+		BaseNotice: newLocatedNotice("all_stops_no_drop_off", ERROR, context, location),
 	}
 }
 
@@ -2282,8 +2998,12 @@ func NewInconsistentStopTimeShapeDistanceNotice(tripID string, missingCount int,
 		"missingCount": missingCount,
 		"totalCount":   totalCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InconsistentStopTimeShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("inconsistent_stop_time_shape_distance", WARNING, context),
+		BaseNotice: newLocatedNotice("inconsistent_stop_time_shape_distance", WARNING, context, location),
 	}
 }
 
@@ -2300,8 +3020,13 @@ func NewDuplicateCompositeKeyNotice(filename, keyFields, keyValue string, firstR
 		"firstRow":     firstRow,
 		"duplicateRow": duplicateRow,
 	}
+	location := NoticeLocation{
+		File:            filename,
+		RowNumber:       duplicateRow,
+		OtherRowNumbers: []int{firstRow},
+	}
 	return &DuplicateCompositeKeyNotice{
-		BaseNotice: NewBaseNotice("duplicate_composite_key", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_composite_key", ERROR, context, location),
 	}
 }
 
@@ -2315,8 +3040,11 @@ func NewMultipleRecordsInSingleRecordFileNotice(filename string, recordCount int
 		"filename":    filename,
 		"recordCount": recordCount,
 	}
+	location := NoticeLocation{
+		File: filename,
+	}
 	return &MultipleRecordsInSingleRecordFileNotice{
-		BaseNotice: NewBaseNotice("multiple_records_in_single_record_file", ERROR, context),
+		BaseNotice: newLocatedNotice("multiple_records_in_single_record_file", ERROR, context, location),
 	}
 }
 
@@ -2331,8 +3059,12 @@ func NewInvalidRowNotice(filename string, rowNumber int, reason string) *Invalid
 		"rowNumber": rowNumber,
 		"reason":    reason,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+	}
 	return &InvalidRowNotice{
-		BaseNotice: NewBaseNotice("invalid_row", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_row", ERROR, context, location),
 	}
 }
 
@@ -2348,8 +3080,34 @@ func NewWrongNumberOfFieldsNotice(filename string, rowNumber, expectedFields, ac
 		"expectedFields": expectedFields,
 		"actualFields":   actualFields,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+	}
 	return &WrongNumberOfFieldsNotice{
-		BaseNotice: NewBaseNotice("wrong_number_of_fields", ERROR, context),
+		BaseNotice: newLocatedNotice("wrong_number_of_fields", ERROR, context, location),
+	}
+}
+
+// CSVParsingFailedNotice represents a malformed row skipped by the CSV parser
+type CSVParsingFailedNotice struct {
+	*BaseNotice
+}
+
+func NewCSVParsingFailedNotice(filename string, csvRowNumber, lineNumber int, rawText, message string) *CSVParsingFailedNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"lineNumber":   lineNumber,
+		"rawText":      rawText,
+		"error":        message,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+	}
+	return &CSVParsingFailedNotice{
+		BaseNotice: newLocatedNotice("csv_parsing_failed", ERROR, context, location),
 	}
 }
 
@@ -2364,8 +3122,14 @@ func NewNegativeStopSequenceNotice(tripID string, stopSequence, rowNumber int) *
 		"stopSequence": stopSequence,
 		"rowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_sequence",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &NegativeStopSequenceNotice{
-		BaseNotice: NewBaseNotice("negative_stop_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("negative_stop_sequence", ERROR, context, location),
 	}
 }
 
@@ -2380,8 +3144,13 @@ func NewNegativeShapeDistanceNotice(tripID string, shapeDistance float64, rowNum
 		"shapeDistance": shapeDistance,
 		"rowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "stop_times.txt",
+		RowNumber: rowNumber,
+		FieldName: "shape_dist_traveled",
+	}
 	return &NegativeShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("negative_shape_distance", ERROR, context),
+		BaseNotice: newLocatedNotice("negative_shape_distance", ERROR, context, location),
 	}
 }
 
@@ -2396,8 +3165,14 @@ func NewInvalidWheelchairBoardingNotice(stopID string, wheelchairBoarding, rowNu
 		"wheelchairBoarding": wheelchairBoarding,
 		"rowNumber":          rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "wheelchair_boarding",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidWheelchairBoardingNotice{
-		BaseNotice: NewBaseNotice("invalid_wheelchair_boarding", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_wheelchair_boarding", ERROR, context, location),
 	}
 }
 
@@ -2412,8 +3187,14 @@ func NewInvalidDirectionIdNotice(tripID string, directionId, rowNumber int) *Inv
 		"directionId": directionId,
 		"rowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "direction_id",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InvalidDirectionIdNotice{
-		BaseNotice: NewBaseNotice("invalid_direction_id", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_direction_id", ERROR, context, location),
 	}
 }
 
@@ -2428,8 +3209,14 @@ func NewInvalidWheelchairAccessibleNotice(tripID string, wheelchairAccessible, r
 		"wheelchairAccessible": wheelchairAccessible,
 		"rowNumber":            rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "wheelchair_accessible",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InvalidWheelchairAccessibleNotice{
-		BaseNotice: NewBaseNotice("invalid_wheelchair_accessible", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_wheelchair_accessible", ERROR, context, location),
 	}
 }
 
@@ -2444,8 +3231,14 @@ func NewInvalidBikesAllowedNotice(tripID string, bikesAllowed, rowNumber int) *I
 		"bikesAllowed": bikesAllowed,
 		"rowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "bikes_allowed",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InvalidBikesAllowedNotice{
-		BaseNotice: NewBaseNotice("invalid_bikes_allowed", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_bikes_allowed", ERROR, context, location),
 	}
 }
 
@@ -2461,8 +3254,14 @@ func NewInvalidDayValueNotice(serviceID, field, value string, rowNumber int) *In
 		"value":     value,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  field,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &InvalidDayValueNotice{
-		BaseNotice: NewBaseNotice("invalid_day_value", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_day_value", ERROR, context, location),
 	}
 }
 
@@ -2477,8 +3276,13 @@ func NewNegativeShapeSequenceNotice(shapeID string, sequence, rowNumber int) *Ne
 		"sequence":  sequence,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "shapes.txt",
+		RowNumber: rowNumber,
+		FieldName: "shape_pt_sequence",
+	}
 	return &NegativeShapeSequenceNotice{
-		BaseNotice: NewBaseNotice("negative_shape_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("negative_shape_sequence", ERROR, context, location),
 	}
 }
 
@@ -2494,8 +3298,13 @@ func NewLeadingWhitespaceNotice(filename, fieldName, fieldValue string, rowNumbe
 		"fieldValue": fieldValue,
 		"rowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &LeadingWhitespaceNotice{
-		BaseNotice: NewBaseNotice("leading_whitespace", WARNING, context),
+		BaseNotice: newLocatedNotice("leading_whitespace", WARNING, context, location),
 	}
 }
 
@@ -2511,8 +3320,13 @@ func NewTrailingWhitespaceNotice(filename, fieldName, fieldValue string, rowNumb
 		"fieldValue": fieldValue,
 		"rowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &TrailingWhitespaceNotice{
-		BaseNotice: NewBaseNotice("trailing_whitespace", WARNING, context),
+		BaseNotice: newLocatedNotice("trailing_whitespace", WARNING, context, location),
 	}
 }
 
@@ -2527,8 +3341,13 @@ func NewWhitespaceOnlyFieldNotice(filename, fieldName string, rowNumber int) *Wh
 		"fieldName": fieldName,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &WhitespaceOnlyFieldNotice{
-		BaseNotice: NewBaseNotice("whitespace_only_field", WARNING, context),
+		BaseNotice: newLocatedNotice("whitespace_only_field", WARNING, context, location),
 	}
 }
 
@@ -2544,8 +3363,13 @@ func NewExcessiveWhitespaceNotice(filename, fieldName, fieldValue string, rowNum
 		"fieldValue": fieldValue,
 		"rowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: rowNumber,
+		FieldName: fieldName,
+	}
 	return &ExcessiveWhitespaceNotice{
-		BaseNotice: NewBaseNotice("excessive_whitespace", INFO, context),
+		BaseNotice: newLocatedNotice("excessive_whitespace", INFO, context, location),
 	}
 }
 
@@ -2559,8 +3383,12 @@ func NewInsufficientStopTimesNotice(tripID string, stopCount int) *InsufficientS
 		"tripId":    tripID,
 		"stopCount": stopCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InsufficientStopTimesNotice{
-		BaseNotice: NewBaseNotice("insufficient_stop_times", ERROR, context),
+		BaseNotice: newLocatedNotice("insufficient_stop_times", ERROR, context, location),
 	}
 }
 
@@ -2576,8 +3404,13 @@ func NewNonIncreasingStopSequenceNotice(tripID string, currentSeq, previousSeq,
 		"previousSeq": previousSeq,
 		"rowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(currentSeq)},
+	}
 	return &NonIncreasingStopSequenceNotice{
-		BaseNotice: NewBaseNotice("non_increasing_stop_sequence", ERROR, context),
+		BaseNotice: newLocatedNotice("non_increasing_stop_sequence", ERROR, context, location),
 	}
 }
 
@@ -2593,8 +3426,13 @@ func NewStopSequenceGapNotice(tripID string, expectedSeq, actualSeq, rowNumber i
 		"actualSeq":   actualSeq,
 		"rowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(actualSeq)},
+	}
 	return &StopSequenceGapNotice{
-		BaseNotice: NewBaseNotice("stop_sequence_gap", INFO, context),
+		BaseNotice: newLocatedNotice("stop_sequence_gap", INFO, context, location),
 	}
 }
 
@@ -2611,8 +3449,13 @@ func NewConsecutiveDuplicateStopsNotice(tripID, stopID string, seq1, seq2, rowNu
 		"sequence2": seq2,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(seq2)},
+	}
 	return &ConsecutiveDuplicateStopsNotice{
-		BaseNotice: NewBaseNotice("consecutive_duplicate_stops", WARNING, context),
+		BaseNotice: newLocatedNotice("consecutive_duplicate_stops", WARNING, context, location),
 	}
 }
 
@@ -2627,8 +3470,12 @@ func NewSingleTripPatternNotice(patternID, tripID string, stopCount int) *Single
 		"tripId":    tripID,
 		"stopCount": stopCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &SingleTripPatternNotice{
-		BaseNotice: NewBaseNotice("single_trip_pattern", INFO, context),
+		BaseNotice: newLocatedNotice("single_trip_pattern", INFO, context, location),
 	}
 }
 
@@ -2691,8 +3538,14 @@ func NewVeryOldServiceNotice(serviceID, endDate string, rowNumber int) *VeryOldS
 		"endDate":   endDate,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "end_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &VeryOldServiceNotice{
-		BaseNotice: NewBaseNotice("very_old_service", WARNING, context),
+		BaseNotice: newLocatedNotice("very_old_service", WARNING, context, location),
 	}
 }
 
@@ -2707,8 +3560,14 @@ func NewVeryFutureServiceNotice(serviceID, startDate string, rowNumber int) *Ver
 		"startDate": startDate,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "start_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &VeryFutureServiceNotice{
-		BaseNotice: NewBaseNotice("very_future_service", WARNING, context),
+		BaseNotice: newLocatedNotice("very_future_service", WARNING, context, location),
 	}
 }
 
@@ -2723,8 +3582,13 @@ func NewLowServiceUsageNotice(serviceID string, tripCount, rowNumber int) *LowSe
 		"tripCount": tripCount,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &LowServiceUsageNotice{
-		BaseNotice: NewBaseNotice("low_service_usage", INFO, context),
+		BaseNotice: newLocatedNotice("low_service_usage", INFO, context, location),
 	}
 }
 
@@ -2738,8 +3602,12 @@ func NewExcessiveServiceVarietyNotice(routeID string, serviceCount int) *Excessi
 		"routeId":      routeID,
 		"serviceCount": serviceCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &ExcessiveServiceVarietyNotice{
-		BaseNotice: NewBaseNotice("excessive_service_variety", WARNING, context),
+		BaseNotice: newLocatedNotice("excessive_service_variety", WARNING, context, location),
 	}
 }
 
@@ -2754,8 +3622,12 @@ func NewSingleTripServiceNotice(routeID, serviceID string, tripCount int) *Singl
 		"serviceId": serviceID,
 		"tripCount": tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &SingleTripServiceNotice{
-		BaseNotice: NewBaseNotice("single_trip_service", INFO, context),
+		BaseNotice: newLocatedNotice("single_trip_service", INFO, context, location),
 	}
 }
 
@@ -2787,8 +3659,12 @@ func NewVeryShortTripNotice(tripID string, duration, stopCount int) *VeryShortTr
 		"duration":  duration,
 		"stopCount": stopCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &VeryShortTripNotice{
-		BaseNotice: NewBaseNotice("very_short_trip", WARNING, context),
+		BaseNotice: newLocatedNotice("very_short_trip", WARNING, context, location),
 	}
 }
 
@@ -2803,8 +3679,12 @@ func NewVeryLongTripNotice(tripID string, duration, stopCount int) *VeryLongTrip
 		"duration":  duration,
 		"stopCount": stopCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &VeryLongTripNotice{
-		BaseNotice: NewBaseNotice("very_long_trip", WARNING, context),
+		BaseNotice: newLocatedNotice("very_long_trip", WARNING, context, location),
 	}
 }
 
@@ -2820,8 +3700,13 @@ func NewStopWithoutServiceNotice(tripID, stopID string, stopSequence, rowNumber
 		"stopSequence": stopSequence,
 		"rowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &StopWithoutServiceNotice{
-		BaseNotice: NewBaseNotice("stop_without_service", ERROR, context),
+		BaseNotice: newLocatedNotice("stop_without_service", ERROR, context, location),
 	}
 }
 
@@ -2837,8 +3722,12 @@ func NewShortServiceSpanNotice(routeID, serviceID string, serviceSpan, tripCount
 		"serviceSpan": serviceSpan,
 		"tripCount":   tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &ShortServiceSpanNotice{
-		BaseNotice: NewBaseNotice("short_service_span", INFO, context),
+		BaseNotice: newLocatedNotice("short_service_span", INFO, context, location),
 	}
 }
 
@@ -2854,8 +3743,12 @@ func NewLongServiceSpanNotice(routeID, serviceID string, serviceSpan, tripCount
 		"serviceSpan": serviceSpan,
 		"tripCount":   tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &LongServiceSpanNotice{
-		BaseNotice: NewBaseNotice("long_service_span", WARNING, context),
+		BaseNotice: newLocatedNotice("long_service_span", WARNING, context, location),
 	}
 }
 
@@ -2872,8 +3765,12 @@ func NewIrregularHeadwayNotice(routeID, serviceID string, averageHeadway, varian
 		"variance":       variance,
 		"headwayCount":   headwayCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &IrregularHeadwayNotice{
-		BaseNotice: NewBaseNotice("irregular_headway", WARNING, context),
+		BaseNotice: newLocatedNotice("irregular_headway", WARNING, context, location),
 	}
 }
 
@@ -2888,8 +3785,12 @@ func NewVeryShortHeadwayNotice(routeID, serviceID string, headway int) *VeryShor
 		"serviceId": serviceID,
 		"headway":   headway,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &VeryShortHeadwayNotice{
-		BaseNotice: NewBaseNotice("very_short_headway", WARNING, context),
+		BaseNotice: newLocatedNotice("very_short_headway", WARNING, context, location),
 	}
 }
 
@@ -2904,8 +3805,12 @@ func NewVeryLongHeadwayNotice(routeID, serviceID string, headway int) *VeryLongH
 		"serviceId": serviceID,
 		"headway":   headway,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &VeryLongHeadwayNotice{
-		BaseNotice: NewBaseNotice("very_long_headway", INFO, context),
+		BaseNotice: newLocatedNotice("very_long_headway", INFO, context, location),
 	}
 }
 
@@ -2937,8 +3842,13 @@ func NewRouteWithoutTripsNotice(routeID string, rowNumber int) *RouteWithoutTrip
 		"routeId":   routeID,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RouteWithoutTripsNotice{
-		BaseNotice: NewBaseNotice("route_without_trips", WARNING, context),
+		BaseNotice: newLocatedNotice("route_without_trips", WARNING, context, location),
 	}
 }
 
@@ -2954,8 +3864,12 @@ func NewExcessiveRoutePatternVariationsNotice(routeID string, directionID, varia
 		"variations":  variations,
 		"tripCount":   tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &ExcessiveRoutePatternVariationsNotice{
-		BaseNotice: NewBaseNotice("excessive_route_pattern_variations", WARNING, context),
+		BaseNotice: newLocatedNotice("excessive_route_pattern_variations", WARNING, context, location),
 	}
 }
 
@@ -2972,8 +3886,12 @@ func NewUnbalancedDirectionTripsNotice(routeID string, dir1, trips1, dir2, trips
 		"direction2":      dir2,
 		"direction2Trips": trips2,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &UnbalancedDirectionTripsNotice{
-		BaseNotice: NewBaseNotice("unbalanced_direction_trips", WARNING, context),
+		BaseNotice: newLocatedNotice("unbalanced_direction_trips", WARNING, context, location),
 	}
 }
 
@@ -2988,8 +3906,12 @@ func NewLimitedServiceVarietyNotice(routeID string, serviceCount, tripCount int)
 		"serviceCount": serviceCount,
 		"tripCount":    tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &LimitedServiceVarietyNotice{
-		BaseNotice: NewBaseNotice("limited_service_variety", INFO, context),
+		BaseNotice: newLocatedNotice("limited_service_variety", INFO, context, location),
 	}
 }
 
@@ -3004,8 +3926,12 @@ func NewLowRouteUsageNotice(routeID string, tripCount, serviceCount int) *LowRou
 		"tripCount":    tripCount,
 		"serviceCount": serviceCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &LowRouteUsageNotice{
-		BaseNotice: NewBaseNotice("low_route_usage", WARNING, context),
+		BaseNotice: newLocatedNotice("low_route_usage", WARNING, context, location),
 	}
 }
 
@@ -3020,8 +3946,12 @@ func NewVeryLongRouteNotice(routeID string, stopCount, tripCount int) *VeryLongR
 		"stopCount": stopCount,
 		"tripCount": tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &VeryLongRouteNotice{
-		BaseNotice: NewBaseNotice("very_long_route", INFO, context),
+		BaseNotice: newLocatedNotice("very_long_route", INFO, context, location),
 	}
 }
 
@@ -3036,8 +3966,12 @@ func NewVeryShortRouteNotice(routeID string, stopCount, tripCount int) *VeryShor
 		"stopCount": stopCount,
 		"tripCount": tripCount,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &VeryShortRouteNotice{
-		BaseNotice: NewBaseNotice("very_short_route", WARNING, context),
+		BaseNotice: newLocatedNotice("very_short_route", WARNING, context, location),
 	}
 }
 
@@ -3053,8 +3987,12 @@ func NewLowTimepointCoverageNotice(routeID string, tripsWithTimepoints, totalTri
 		"totalTrips":          totalTrips,
 		"coverage":            coverage,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &LowTimepointCoverageNotice{
-		BaseNotice: NewBaseNotice("low_timepoint_coverage", WARNING, context),
+		BaseNotice: newLocatedNotice("low_timepoint_coverage", WARNING, context, location),
 	}
 }
 
@@ -3087,8 +4025,12 @@ func NewHighRouteTypeDiversityNotice(agencyID string, routeTypes, routeCount int
 		"routeTypes": routeTypes,
 		"routeCount": routeCount,
 	}
+	location := NoticeLocation{
+		File:       "agency.txt",
+		PrimaryKey: map[string]string{"agency_id": agencyID},
+	}
 	return &HighRouteTypeDiversityNotice{
-		BaseNotice: NewBaseNotice("high_route_type_diversity", INFO, context),
+		BaseNotice: newLocatedNotice("high_route_type_diversity", INFO, context, location),
 	}
 }
 
@@ -3103,8 +4045,14 @@ func NewInvalidLatitudeNotice(stopID string, latitude float64, rowNumber int) *I
 		"latitude":  latitude,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_lat",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidLatitudeNotice{
-		BaseNotice: NewBaseNotice("invalid_latitude", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_latitude", ERROR, context, location),
 	}
 }
 
@@ -3119,8 +4067,14 @@ func NewInvalidLongitudeNotice(stopID string, longitude float64, rowNumber int)
 		"longitude": longitude,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_lon",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &InvalidLongitudeNotice{
-		BaseNotice: NewBaseNotice("invalid_longitude", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_longitude", ERROR, context, location),
 	}
 }
 
@@ -3174,8 +4128,13 @@ func NewChildStationTooFarFromParentNotice(childID, parentID string, distance fl
 		"distance":  distance,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"stop_id": childID},
+	}
 	return &ChildStationTooFarFromParentNotice{
-		BaseNotice: NewBaseNotice("child_station_too_far_from_parent", WARNING, context),
+		BaseNotice: newLocatedNotice("child_station_too_far_from_parent", WARNING, context, location),
 	}
 }
 
@@ -3192,8 +4151,14 @@ func NewVeryCloseStopsNotice(stop1ID, stop2ID string, distance float64, row1, ro
 		"rowNumber1": row1,
 		"rowNumber2": row2,
 	}
+	location := NoticeLocation{
+		File:            "stops.txt",
+		RowNumber:       row2,
+		PrimaryKey:      map[string]string{"stop_id": stop2ID},
+		OtherRowNumbers: []int{row1},
+	}
 	return &VeryCloseStopsNotice{
-		BaseNotice: NewBaseNotice("very_close_stops", INFO, context),
+		BaseNotice: newLocatedNotice("very_close_stops", INFO, context, location),
 	}
 }
 
@@ -3210,8 +4175,13 @@ func NewShapePointOutsideFeedBoundsNotice(shapeID string, sequence int, lat, lon
 		"longitude": lon,
 		"rowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(sequence)},
+	}
 	return &ShapePointOutsideFeedBoundsNotice{
-		BaseNotice: NewBaseNotice("shape_point_outside_feed_bounds", WARNING, context),
+		BaseNotice: newLocatedNotice("shape_point_outside_feed_bounds", WARNING, context, location),
 	}
 }
 
@@ -3229,8 +4199,14 @@ func NewShapeDistanceInconsistentWithGeographyNotice(shapeID string, sequence in
 		"difference":     difference,
 		"rowNumber":      rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(sequence)},
+	}
 	return &ShapeDistanceInconsistentWithGeographyNotice{
-		BaseNotice: NewBaseNotice("shape_distance_inconsistent_with_geography", WARNING, context),
+		BaseNotice: newLocatedNotice("shape_distance_inconsistent_with_geography", WARNING, context, location),
 	}
 }
 
@@ -3294,8 +4270,12 @@ func NewIsolatedStopNotice(stopID string, tripCount int) *IsolatedStopNotice {
 		"stopId":    stopID,
 		"tripCount": tripCount,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &IsolatedStopNotice{
-		BaseNotice: NewBaseNotice("isolated_stop", WARNING, context),
+		BaseNotice: newLocatedNotice("isolated_stop", WARNING, context, location),
 	}
 }
 
@@ -3375,8 +4355,12 @@ func NewNetworkHubIdentifiedNotice(stopID string, routeCount, connectionCount, t
 		"connectionCount": connectionCount,
 		"totalHubs":       totalHubs,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &NetworkHubIdentifiedNotice{
-		BaseNotice: NewBaseNotice("network_hub_identified", INFO, context),
+		BaseNotice: newLocatedNotice("network_hub_identified", INFO, context, location),
 	}
 }
 
@@ -3392,8 +4376,12 @@ func NewMajorTransferPointNotice(stopID string, routeCount, connectionCount int,
 		"connectionCount": connectionCount,
 		"transferValue":   transferValue,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &MajorTransferPointNotice{
-		BaseNotice: NewBaseNotice("major_transfer_point", INFO, context),
+		BaseNotice: newLocatedNotice("major_transfer_point", INFO, context, location),
 	}
 }
 
@@ -3444,8 +4432,13 @@ func NewFeedInfoEndDateMissingNotice(rowNumber int) *FeedInfoEndDateMissingNotic
 	context := map[string]interface{}{
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		RowNumber: rowNumber,
+		FieldName: "feed_end_date",
+	}
 	return &FeedInfoEndDateMissingNotice{
-		BaseNotice: NewBaseNotice("feed_info_end_date_missing", WARNING, context),
+		BaseNotice: newLocatedNotice("feed_info_end_date_missing", WARNING, context, location),
 	}
 }
 
@@ -3460,8 +4453,12 @@ func NewFeedExpiredNotice(endDate, currentDate string, daysExpired int) *FeedExp
 		"currentDate": currentDate,
 		"daysExpired": daysExpired,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		FieldName: "feed_end_date",
+	}
 	return &FeedExpiredNotice{
-		BaseNotice: NewBaseNotice("feed_expired", ERROR, context),
+		BaseNotice: newLocatedNotice("feed_expired", ERROR, context, location),
 	}
 }
 
@@ -3476,8 +4473,12 @@ func NewFeedExpiresWithin7DaysNotice(endDate, currentDate string, daysUntilExpir
 		"currentDate":         currentDate,
 		"daysUntilExpiration": daysUntilExpiration,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		FieldName: "feed_end_date",
+	}
 	return &FeedExpiresWithin7DaysNotice{
-		BaseNotice: NewBaseNotice("feed_expires_within_7_days", ERROR, context),
+		BaseNotice: newLocatedNotice("feed_expires_within_7_days", ERROR, context, location),
 	}
 }
 
@@ -3492,8 +4493,12 @@ func NewFeedExpiresWithin30DaysNotice(endDate, currentDate string, daysUntilExpi
 		"currentDate":         currentDate,
 		"daysUntilExpiration": daysUntilExpiration,
 	}
+	location := NoticeLocation{
+		File:      "feed_info.txt",
+		FieldName: "feed_end_date",
+	}
 	return &FeedExpiresWithin30DaysNotice{
-		BaseNotice: NewBaseNotice("feed_expires_within_30_days", WARNING, context),
+		BaseNotice: newLocatedNotice("feed_expires_within_30_days", WARNING, context, location),
 	}
 }
 
@@ -3603,8 +4608,14 @@ func NewDuplicateRouteLongNameNotice(routeID, routeLongName, firstRouteID, agenc
 		"routeType":     routeType,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_long_name",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &DuplicateRouteLongNameNotice{
-		BaseNotice: NewBaseNotice("duplicate_route_long_name", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_route_long_name", WARNING, context, location),
 	}
 }
 
@@ -3622,8 +4633,14 @@ func NewDuplicateRouteShortNameNotice(routeID, routeShortName, firstRouteID, age
 		"routeType":      routeType,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_short_name",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &DuplicateRouteShortNameNotice{
-		BaseNotice: NewBaseNotice("duplicate_route_short_name", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_route_short_name", WARNING, context, location),
 	}
 }
 
@@ -3642,8 +4659,13 @@ func NewDuplicateRouteNameCombinationNotice(routeID, routeLongName, routeShortNa
 		"routeType":      routeType,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &DuplicateRouteNameCombinationNotice{
-		BaseNotice: NewBaseNotice("duplicate_route_name_combination", WARNING, context),
+		BaseNotice: newLocatedNotice("duplicate_route_name_combination", WARNING, context, location),
 	}
 }
 
@@ -3663,8 +4685,14 @@ func NewRouteColorContrastNotice(routeID, routeColor, routeTextColor string, act
 		"minimumContrast": minimumContrast,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RouteColorContrastNotice{
-		BaseNotice: NewBaseNotice("route_color_contrast", severity, context),
+		BaseNotice: newLocatedNotice("route_color_contrast", severity, context, location),
 	}
 }
 
@@ -3680,8 +4708,14 @@ func NewLightTextOnLightBackgroundNotice(routeID, routeColor, routeTextColor str
 		"routeTextColor": routeTextColor,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &LightTextOnLightBackgroundNotice{
-		BaseNotice: NewBaseNotice("light_text_on_light_background", WARNING, context),
+		BaseNotice: newLocatedNotice("light_text_on_light_background", WARNING, context, location),
 	}
 }
 
@@ -3697,8 +4731,14 @@ func NewDarkTextOnDarkBackgroundNotice(routeID, routeColor, routeTextColor strin
 		"routeTextColor": routeTextColor,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &DarkTextOnDarkBackgroundNotice{
-		BaseNotice: NewBaseNotice("dark_text_on_dark_background", WARNING, context),
+		BaseNotice: newLocatedNotice("dark_text_on_dark_background", WARNING, context, location),
 	}
 }
 
@@ -3714,8 +4754,14 @@ func NewSimilarColorsNotice(routeID, routeColor, routeTextColor string, rowNumbe
 		"routeTextColor": routeTextColor,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &SimilarColorsNotice{
-		BaseNotice: NewBaseNotice("similar_colors", WARNING, context),
+		BaseNotice: newLocatedNotice("similar_colors", WARNING, context, location),
 	}
 }
 
@@ -3731,8 +4777,14 @@ func NewRedGreenColorCombinationNotice(routeID, routeColor, routeTextColor strin
 		"routeTextColor": routeTextColor,
 		"csvRowNumber":   rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_text_color",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RedGreenColorCombinationNotice{
-		BaseNotice: NewBaseNotice("red_green_color_combination", INFO, context),
+		BaseNotice: newLocatedNotice("red_green_color_combination", INFO, context, location),
 	}
 }
 
@@ -3749,8 +4801,14 @@ func NewMissingRequiredStopNameNotice(stopID string, locationType, rowNumber int
 		"locationType": locationType,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &MissingRequiredStopNameNotice{
-		BaseNotice: NewBaseNotice("missing_required_stop_name", ERROR, context),
+		BaseNotice: newLocatedNotice("missing_required_stop_name", ERROR, context, location),
 	}
 }
 
@@ -3767,8 +4825,14 @@ func NewStopNameMissingButInheritedNotice(stopID, parentStationID, parentName st
 		"locationType":    locationType,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameMissingButInheritedNotice{
-		BaseNotice: NewBaseNotice("stop_name_missing_but_inherited", INFO, context),
+		BaseNotice: newLocatedNotice("stop_name_missing_but_inherited", INFO, context, location),
 	}
 }
 
@@ -3783,8 +4847,14 @@ func NewGenericStopNameNotice(stopID, stopName string, rowNumber int) *GenericSt
 		"stopName":     stopName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &GenericStopNameNotice{
-		BaseNotice: NewBaseNotice("generic_stop_name", WARNING, context),
+		BaseNotice: newLocatedNotice("generic_stop_name", WARNING, context, location),
 	}
 }
 
@@ -3801,8 +4871,14 @@ func NewStopNameTooLongNotice(stopID, stopName string, actualLength, maxLength,
 		"maxLength":    maxLength,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameTooLongNotice{
-		BaseNotice: NewBaseNotice("stop_name_too_long", severity, context),
+		BaseNotice: newLocatedNotice("stop_name_too_long", severity, context, location),
 	}
 }
 
@@ -3819,8 +4895,14 @@ func NewStopNameContainsControlCharacterNotice(stopID, stopName string, position
 		"charCode":     charCode,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameContainsControlCharacterNotice{
-		BaseNotice: NewBaseNotice("stop_name_contains_control_character", WARNING, context),
+		BaseNotice: newLocatedNotice("stop_name_contains_control_character", WARNING, context, location),
 	}
 }
 
@@ -3835,8 +4917,14 @@ func NewStopNameContainsHTMLNotice(stopID, stopName string, rowNumber int) *Stop
 		"stopName":     stopName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameContainsHTMLNotice{
-		BaseNotice: NewBaseNotice("stop_name_contains_html", WARNING, context),
+		BaseNotice: newLocatedNotice("stop_name_contains_html", WARNING, context, location),
 	}
 }
 
@@ -3851,8 +4939,14 @@ func NewStopNameContainsURLNotice(stopID, stopName string, rowNumber int) *StopN
 		"stopName":     stopName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameContainsURLNotice{
-		BaseNotice: NewBaseNotice("stop_name_contains_url", WARNING, context),
+		BaseNotice: newLocatedNotice("stop_name_contains_url", WARNING, context, location),
 	}
 }
 
@@ -3867,8 +4961,14 @@ func NewStopNameDescriptionDuplicateNotice(stopID, stopName string, rowNumber in
 		"stopName":     stopName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameDescriptionDuplicateNotice{
-		BaseNotice: NewBaseNotice("stop_name_description_duplicate", INFO, context),
+		BaseNotice: newLocatedNotice("stop_name_description_duplicate", INFO, context, location),
 	}
 }
 
@@ -3883,8 +4983,14 @@ func NewStopNameAllCapsNotice(stopID, stopName string, rowNumber int) *StopNameA
 		"stopName":     stopName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameAllCapsNotice{
-		BaseNotice: NewBaseNotice("stop_name_all_caps", INFO, context),
+		BaseNotice: newLocatedNotice("stop_name_all_caps", INFO, context, location),
 	}
 }
 
@@ -3900,8 +5006,14 @@ func NewStopNameRepeatedWordNotice(stopID, stopName, repeatedWord string, rowNum
 		"repeatedWord": repeatedWord,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_name",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
 	return &StopNameRepeatedWordNotice{
-		BaseNotice: NewBaseNotice("stop_name_repeated_word", WARNING, context),
+		BaseNotice: newLocatedNotice("stop_name_repeated_word", WARNING, context, location),
 	}
 }
 
@@ -3984,8 +5096,14 @@ func NewMissingBikesAllowedForFerryNotice(tripID, routeID string, rowNumber int)
 		"routeId":      routeID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "bikes_allowed",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &MissingBikesAllowedForFerryNotice{
-		BaseNotice: NewBaseNotice("missing_bikes_allowed_for_ferry", WARNING, context),
+		BaseNotice: newLocatedNotice("missing_bikes_allowed_for_ferry", WARNING, context, location),
 	}
 }
 
@@ -4000,8 +5118,14 @@ func NewInvalidBikesAllowedValueNotice(tripID string, bikesAllowed, rowNumber in
 		"bikesAllowed": bikesAllowed,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "bikes_allowed",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &InvalidBikesAllowedValueNotice{
-		BaseNotice: NewBaseNotice("invalid_bikes_allowed_value", ERROR, context),
+		BaseNotice: newLocatedNotice("invalid_bikes_allowed_value", ERROR, context, location),
 	}
 }
 
@@ -4018,8 +5142,13 @@ func NewBikeWheelchairAccessibilityMismatchNotice(tripID, routeID string, bikesA
 		"wheelchairAccessible": wheelchairAccessible,
 		"csvRowNumber":         rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &BikeWheelchairAccessibilityMismatchNotice{
-		BaseNotice: NewBaseNotice("bike_wheelchair_accessibility_mismatch", INFO, context),
+		BaseNotice: newLocatedNotice("bike_wheelchair_accessibility_mismatch", INFO, context, location),
 	}
 }
 
@@ -4036,8 +5165,14 @@ func NewUnusualBikeAllowanceNotice(tripID, routeID string, routeType, bikesAllow
 		"bikesAllowed": bikesAllowed,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "bikes_allowed",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &UnusualBikeAllowanceNotice{
-		BaseNotice: NewBaseNotice("unusual_bike_allowance", INFO, context),
+		BaseNotice: newLocatedNotice("unusual_bike_allowance", INFO, context, location),
 	}
 }
 
@@ -4057,8 +5192,14 @@ func NewShapeDistanceDecreasingNotice(shapeID string, prevSequence, currentSeque
 		"currentDistance": currentDistance,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &ShapeDistanceDecreasingNotice{
-		BaseNotice: NewBaseNotice("shape_distance_decreasing", ERROR, context),
+		BaseNotice: newLocatedNotice("shape_distance_decreasing", ERROR, context, location),
 	}
 }
 
@@ -4075,8 +5216,14 @@ func NewShapeDistanceNotIncreasingNotice(shapeID string, prevSequence, currentSe
 		"distance":        distance,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &ShapeDistanceNotIncreasingNotice{
-		BaseNotice: NewBaseNotice("shape_distance_not_increasing", WARNING, context),
+		BaseNotice: newLocatedNotice("shape_distance_not_increasing", WARNING, context, location),
 	}
 }
 
@@ -4095,8 +5242,14 @@ func NewUnrealisticShapeDistanceNotice(shapeID string, prevSequence, currentSequ
 		"ratio":            ratio,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &UnrealisticShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("unrealistic_shape_distance", WARNING, context),
+		BaseNotice: newLocatedNotice("unrealistic_shape_distance", WARNING, context, location),
 	}
 }
 
@@ -4112,8 +5265,11 @@ func NewIncompleteShapeDistanceNotice(shapeID string, pointsWithDistance, totalP
 		"totalPoints":        totalPoints,
 		"missingCount":       missingCount,
 	}
+	location := NoticeLocation{
+		File: "shapes.txt",
+	}
 	return &IncompleteShapeDistanceNotice{
-		BaseNotice: NewBaseNotice("incomplete_shape_distance", INFO, context),
+		BaseNotice: newLocatedNotice("incomplete_shape_distance", INFO, context, location),
 	}
 }
 
@@ -4129,8 +5285,14 @@ func NewShapeDistanceNotStartingFromZeroNotice(shapeID string, firstSequence int
 		"firstDistance": firstDistance,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(firstSequence)},
+	}
 	return &ShapeDistanceNotStartingFromZeroNotice{
-		BaseNotice: NewBaseNotice("shape_distance_not_starting_from_zero", INFO, context),
+		BaseNotice: newLocatedNotice("shape_distance_not_starting_from_zero", INFO, context, location),
 	}
 }
 
@@ -4148,8 +5310,14 @@ func NewLargeShapeDistanceJumpNotice(shapeID string, prevSequence, currentSequen
 		"geoDistance":     geoDistance,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "shapes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "shape_dist_traveled",
+		PrimaryKey: map[string]string{"shape_id": shapeID, "shape_pt_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &LargeShapeDistanceJumpNotice{
-		BaseNotice: NewBaseNotice("large_shape_distance_jump", WARNING, context),
+		BaseNotice: newLocatedNotice("large_shape_distance_jump", WARNING, context, location),
 	}
 }
 
@@ -4168,8 +5336,13 @@ func NewSmallFrequencyGapNotice(tripID, endTime, startTime string, gapSeconds, r
 		"gapSeconds":   gapSeconds,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "frequencies.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "start_time": startTime},
+	}
 	return &SmallFrequencyGapNotice{
-		BaseNotice: NewBaseNotice("small_frequency_gap", INFO, context),
+		BaseNotice: newLocatedNotice("small_frequency_gap", INFO, context, location),
 	}
 }
 
@@ -4185,8 +5358,13 @@ func NewFrequencyDurationShorterThanHeadwayNotice(tripID string, duration, headw
 		"headway":      headway,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:      "frequencies.txt",
+		RowNumber: rowNumber,
+		FieldName: "headway_secs",
+	}
 	return &FrequencyDurationShorterThanHeadwayNotice{
-		BaseNotice: NewBaseNotice("frequency_duration_shorter_than_headway", ERROR, context),
+		BaseNotice: newLocatedNotice("frequency_duration_shorter_than_headway", ERROR, context, location),
 	}
 }
 
@@ -4203,8 +5381,13 @@ func NewVeryLongFrequencyPeriodNotice(tripID, startTime, endTime string, duratio
 		"duration":     duration,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "frequencies.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": tripID, "start_time": startTime},
+	}
 	return &VeryLongFrequencyPeriodNotice{
-		BaseNotice: NewBaseNotice("very_long_frequency_period", WARNING, context),
+		BaseNotice: newLocatedNotice("very_long_frequency_period", WARNING, context, location),
 	}
 }
 
@@ -4225,8 +5408,13 @@ func NewCrossTripFrequencyOverlapNotice(trip1ID, trip2ID, routeID, serviceID, st
 		"end2":         end2,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "frequencies.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"trip_id": trip1ID, "start_time": start1},
+	}
 	return &CrossTripFrequencyOverlapNotice{
-		BaseNotice: NewBaseNotice("cross_trip_frequency_overlap", WARNING, context),
+		BaseNotice: newLocatedNotice("cross_trip_frequency_overlap", WARNING, context, location),
 	}
 }
 
@@ -4243,8 +5431,13 @@ func NewAttributionWithoutRoleNotice(attributionID, organizationName string, row
 		"organizationName": organizationName,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &AttributionWithoutRoleNotice{
-		BaseNotice: NewBaseNotice("attribution_without_role", ERROR, context),
+		BaseNotice: newLocatedNotice("attribution_without_role", ERROR, context, location),
 	}
 }
 
@@ -4259,8 +5452,13 @@ func NewAttributionAllRolesNotice(attributionID, organizationName string, rowNum
 		"organizationName": organizationName,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &AttributionAllRolesNotice{
-		BaseNotice: NewBaseNotice("attribution_all_roles", INFO, context),
+		BaseNotice: newLocatedNotice("attribution_all_roles", INFO, context, location),
 	}
 }
 
@@ -4276,8 +5474,13 @@ func NewAttributionRoleNameMismatchNotice(attributionID, organizationName, expec
 		"expectedRole":     expectedRole,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "attributions.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"attribution_id": attributionID},
+	}
 	return &AttributionRoleNameMismatchNotice{
-		BaseNotice: NewBaseNotice("attribution_role_name_mismatch", INFO, context),
+		BaseNotice: newLocatedNotice("attribution_role_name_mismatch", INFO, context, location),
 	}
 }
 
@@ -4294,8 +5497,14 @@ func NewSingleTripBlockNotice(blockID, tripID string, rowNumber int) *SingleTrip
 		"tripId":       tripID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "block_id",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &SingleTripBlockNotice{
-		BaseNotice: NewBaseNotice("single_trip_block", INFO, context),
+		BaseNotice: newLocatedNotice("single_trip_block", INFO, context, location),
 	}
 }
 
@@ -4313,8 +5522,14 @@ func NewBlockServiceMismatchNotice(blockID, trip1ID, service1ID, trip2ID, servic
 		"service2Id":   service2ID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "service_id",
+		PrimaryKey: map[string]string{"trip_id": trip2ID},
+	}
 	return &BlockServiceMismatchNotice{
-		BaseNotice: NewBaseNotice("block_service_mismatch", ERROR, context),
+		BaseNotice: newLocatedNotice("block_service_mismatch", ERROR, context, location),
 	}
 }
 
@@ -4362,8 +5577,12 @@ func NewTooManyHeadsignsInTripNotice(tripID string, count int, headsigns []strin
 		"count":     count,
 		"headsigns": headsigns,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &TooManyHeadsignsInTripNotice{
-		BaseNotice: NewBaseNotice("too_many_headsigns_in_trip", WARNING, context),
+		BaseNotice: newLocatedNotice("too_many_headsigns_in_trip", WARNING, context, location),
 	}
 }
 
@@ -4381,8 +5600,14 @@ func NewHeadsignChangeWithinTripNotice(tripID string, prevSequence, currentSeque
 		"currentHeadsign": currentHeadsign,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(currentSequence)},
+	}
 	return &HeadsignChangeWithinTripNotice{
-		BaseNotice: NewBaseNotice("headsign_change_within_trip", INFO, context),
+		BaseNotice: newLocatedNotice("headsign_change_within_trip", INFO, context, location),
 	}
 }
 
@@ -4396,8 +5621,12 @@ func NewFrequentHeadsignChangesNotice(tripID string, changeCount int) *FrequentH
 		"tripId":      tripID,
 		"changeCount": changeCount,
 	}
+	location := NoticeLocation{
+		File:       "trips.txt",
+		PrimaryKey: map[string]string{"trip_id": tripID},
+	}
 	return &FrequentHeadsignChangesNotice{
-		BaseNotice: NewBaseNotice("frequent_headsign_changes", WARNING, context),
+		BaseNotice: newLocatedNotice("frequent_headsign_changes", WARNING, context, location),
 	}
 }
 
@@ -4414,8 +5643,14 @@ func NewStopTripHeadsignMismatchNotice(tripID string, stopSequence int, stopHead
 		"tripHeadsign": tripHeadsign,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &StopTripHeadsignMismatchNotice{
-		BaseNotice: NewBaseNotice("stop_trip_headsign_mismatch", WARNING, context),
+		BaseNotice: newLocatedNotice("stop_trip_headsign_mismatch", WARNING, context, location),
 	}
 }
 
@@ -4431,8 +5666,14 @@ func NewVeryShortHeadsignNotice(tripID string, stopSequence int, headsign string
 		"headsign":     headsign,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &VeryShortHeadsignNotice{
-		BaseNotice: NewBaseNotice("very_short_headsign", WARNING, context),
+		BaseNotice: newLocatedNotice("very_short_headsign", WARNING, context, location),
 	}
 }
 
@@ -4448,8 +5689,14 @@ func NewVeryLongHeadsignNotice(tripID string, stopSequence, length, rowNumber in
 		"length":       length,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &VeryLongHeadsignNotice{
-		BaseNotice: NewBaseNotice("very_long_headsign", WARNING, context),
+		BaseNotice: newLocatedNotice("very_long_headsign", WARNING, context, location),
 	}
 }
 
@@ -4465,8 +5712,14 @@ func NewAllCapsHeadsignNotice(tripID string, stopSequence int, headsign string,
 		"headsign":     headsign,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &AllCapsHeadsignNotice{
-		BaseNotice: NewBaseNotice("all_caps_headsign", INFO, context),
+		BaseNotice: newLocatedNotice("all_caps_headsign", INFO, context, location),
 	}
 }
 
@@ -4483,8 +5736,14 @@ func NewExcessivePunctuationHeadsignNotice(tripID string, stopSequence int, head
 		"punctuationCount": punctuationCount,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &ExcessivePunctuationHeadsignNotice{
-		BaseNotice: NewBaseNotice("excessive_punctuation_headsign", WARNING, context),
+		BaseNotice: newLocatedNotice("excessive_punctuation_headsign", WARNING, context, location),
 	}
 }
 
@@ -4501,8 +5760,14 @@ func NewSuspiciousHeadsignPatternNotice(tripID string, stopSequence int, headsig
 		"pattern":      pattern,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "stop_times.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_headsign",
+		PrimaryKey: map[string]string{"trip_id": tripID, "stop_sequence": strconv.Itoa(stopSequence)},
+	}
 	return &SuspiciousHeadsignPatternNotice{
-		BaseNotice: NewBaseNotice("suspicious_headsign_pattern", WARNING, context),
+		BaseNotice: newLocatedNotice("suspicious_headsign_pattern", WARNING, context, location),
 	}
 }
 
@@ -4520,8 +5785,14 @@ func NewDeprecatedRouteTypeNotice(routeID string, routeType, recommendedType, ro
 		"recommendedType": recommendedType,
 		"csvRowNumber":    rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_type",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &DeprecatedRouteTypeNotice{
-		BaseNotice: NewBaseNotice("deprecated_route_type", WARNING, context),
+		BaseNotice: newLocatedNotice("deprecated_route_type", WARNING, context, location),
 	}
 }
 
@@ -4537,8 +5808,14 @@ func NewUncommonRouteTypeNotice(routeID string, routeType int, description strin
 		"description":  description,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_type",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &UncommonRouteTypeNotice{
-		BaseNotice: NewBaseNotice("uncommon_route_type", INFO, context),
+		BaseNotice: newLocatedNotice("uncommon_route_type", INFO, context, location),
 	}
 }
 
@@ -4556,8 +5833,14 @@ func NewRouteTypeNameMismatchNotice(routeID string, routeType int, expectedMode,
 		"longName":     longName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_type",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
 	return &RouteTypeNameMismatchNotice{
-		BaseNotice: NewBaseNotice("route_type_name_mismatch", WARNING, context),
+		BaseNotice: newLocatedNotice("route_type_name_mismatch", WARNING, context, location),
 	}
 }
 
@@ -4572,8 +5855,12 @@ func NewAgencyMixedRouteTypesNotice(agencyID string, typeCount int, routeTypes [
 		"typeCount":  typeCount,
 		"routeTypes": routeTypes,
 	}
+	location := NoticeLocation{
+		File:       "agency.txt",
+		PrimaryKey: map[string]string{"agency_id": agencyID},
+	}
 	return &AgencyMixedRouteTypesNotice{
-		BaseNotice: NewBaseNotice("agency_mixed_route_types", INFO, context),
+		BaseNotice: newLocatedNotice("agency_mixed_route_types", INFO, context, location),
 	}
 }
 
@@ -4623,8 +5910,13 @@ func NewLongDistanceTransferNotice(fromStopID, toStopID string, distance float64
 		"transferTypeDesc": transferTypeDesc,
 		"csvRowNumber":     rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &LongDistanceTransferNotice{
-		BaseNotice: NewBaseNotice("long_distance_transfer", WARNING, context),
+		BaseNotice: newLocatedNotice("long_distance_transfer", WARNING, context, location),
 	}
 }
 
@@ -4642,8 +5934,14 @@ func NewUnrealisticTransferTimeNotice(fromStopID, toStopID string, minTime, expe
 		"distance":     distance,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &UnrealisticTransferTimeNotice{
-		BaseNotice: NewBaseNotice("unrealistic_transfer_time", WARNING, context),
+		BaseNotice: newLocatedNotice("unrealistic_transfer_time", WARNING, context, location),
 	}
 }
 
@@ -4659,8 +5957,14 @@ func NewVeryLongTransferTimeNotice(fromStopID, toStopID string, minTime, rowNumb
 		"minTime":      minTime,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &VeryLongTransferTimeNotice{
-		BaseNotice: NewBaseNotice("very_long_transfer_time", WARNING, context),
+		BaseNotice: newLocatedNotice("very_long_transfer_time", WARNING, context, location),
 	}
 }
 
@@ -4676,8 +5980,14 @@ func NewVeryShortTransferTimeNotice(fromStopID, toStopID string, minTime, rowNum
 		"minTime":      minTime,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "min_transfer_time",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &VeryShortTransferTimeNotice{
-		BaseNotice: NewBaseNotice("very_short_transfer_time", WARNING, context),
+		BaseNotice: newLocatedNotice("very_short_transfer_time", WARNING, context, location),
 	}
 }
 
@@ -4693,8 +6003,14 @@ func NewCloseStopsNotPossibleTransferNotice(fromStopID, toStopID string, distanc
 		"distance":     distance,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfer_type",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &CloseStopsNotPossibleTransferNotice{
-		BaseNotice: NewBaseNotice("close_stops_not_possible_transfer", WARNING, context),
+		BaseNotice: newLocatedNotice("close_stops_not_possible_transfer", WARNING, context, location),
 	}
 }
 
@@ -4711,8 +6027,14 @@ func NewInconsistentBidirectionalTransferNotice(fromStopID, toStopID string, tra
 		"transferType2": transferType2,
 		"csvRowNumber":  rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "transfers.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "transfer_type",
+		PrimaryKey: map[string]string{"from_stop_id": fromStopID, "to_stop_id": toStopID},
+	}
 	return &InconsistentBidirectionalTransferNotice{
-		BaseNotice: NewBaseNotice("inconsistent_bidirectional_transfer", WARNING, context),
+		BaseNotice: newLocatedNotice("inconsistent_bidirectional_transfer", WARNING, context, location),
 	}
 }
 
@@ -4742,8 +6064,13 @@ func NewCalendarNoDaysSelectedNotice(serviceID string, rowNumber int) *CalendarN
 		"serviceId":    serviceID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &CalendarNoDaysSelectedNotice{
-		BaseNotice: NewBaseNotice("calendar_no_days_selected", ERROR, context),
+		BaseNotice: newLocatedNotice("calendar_no_days_selected", ERROR, context, location),
 	}
 }
 
@@ -4759,8 +6086,14 @@ func NewCalendarEndBeforeStartNotice(serviceID, startDate, endDate string, rowNu
 		"endDate":      endDate,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "end_date",
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &CalendarEndBeforeStartNotice{
-		BaseNotice: NewBaseNotice("calendar_end_before_start", ERROR, context),
+		BaseNotice: newLocatedNotice("calendar_end_before_start", ERROR, context, location),
 	}
 }
 
@@ -4777,8 +6110,13 @@ func NewVeryLongServicePeriodNotice(serviceID, startDate, endDate string, durati
 		"durationDays": durationDays,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &VeryLongServicePeriodNotice{
-		BaseNotice: NewBaseNotice("very_long_service_period", WARNING, context),
+		BaseNotice: newLocatedNotice("very_long_service_period", WARNING, context, location),
 	}
 }
 
@@ -4794,8 +6132,14 @@ func NewDuplicateCalendarDateNotice(serviceID, date string, firstRowNumber, dupl
 		"firstRowNumber":     firstRowNumber,
 		"duplicateRowNumber": duplicateRowNumber,
 	}
+	location := NoticeLocation{
+		File:            "calendar_dates.txt",
+		RowNumber:       duplicateRowNumber,
+		PrimaryKey:      map[string]string{"service_id": serviceID, "date": date},
+		OtherRowNumbers: []int{firstRowNumber},
+	}
 	return &DuplicateCalendarDateNotice{
-		BaseNotice: NewBaseNotice("duplicate_calendar_date", ERROR, context),
+		BaseNotice: newLocatedNotice("duplicate_calendar_date", ERROR, context, location),
 	}
 }
 
@@ -4838,8 +6182,13 @@ func NewWeekendOnlyServiceNotice(serviceID string, rowNumber int) *WeekendOnlySe
 		"serviceId":    serviceID,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &WeekendOnlyServiceNotice{
-		BaseNotice: NewBaseNotice("weekend_only_service", INFO, context),
+		BaseNotice: newLocatedNotice("weekend_only_service", INFO, context, location),
 	}
 }
 
@@ -4854,8 +6203,13 @@ func NewSingleDayServiceNotice(serviceID, dayName string, rowNumber int) *Single
 		"dayName":      dayName,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &SingleDayServiceNotice{
-		BaseNotice: NewBaseNotice("single_day_service", INFO, context),
+		BaseNotice: newLocatedNotice("single_day_service", INFO, context, location),
 	}
 }
 
@@ -4870,8 +6224,13 @@ func NewUnusualServicePatternNotice(serviceID, pattern string, rowNumber int) *U
 		"pattern":      pattern,
 		"csvRowNumber": rowNumber,
 	}
+	location := NoticeLocation{
+		File:       "calendar.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"service_id": serviceID},
+	}
 	return &UnusualServicePatternNotice{
-		BaseNotice: NewBaseNotice("unusual_service_pattern", INFO, context),
+		BaseNotice: newLocatedNotice("unusual_service_pattern", INFO, context, location),
 	}
 }
 
@@ -4890,6 +6249,542 @@ func NewMostlyCalendarDatesServicesNotice(calendarDatesCount, totalCount int) *M
 	}
 }
 
+// === FEED DIFF NOTICES ===
+
+// StopRemovedNotice is generated when a stop_id of the previous feed version no longer exists
+type StopRemovedNotice struct {
+	*BaseNotice
+}
+
+func NewStopRemovedNotice(stopID string, stopName string) *StopRemovedNotice {
+	context := map[string]interface{}{
+		"stopId":   stopID,
+		"stopName": stopName,
+	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
+	return &StopRemovedNotice{
+		BaseNotice: newLocatedNotice("stop_removed", WARNING, context, location),
+	}
+}
+
+// StopIDRenamedNotice is generated when a stop appears to have been kept under a new stop_id
+type StopIDRenamedNotice struct {
+	*BaseNotice
+}
+
+func NewStopIDRenamedNotice(previousStopID string, stopID string, stopName string, rowNumber int) *StopIDRenamedNotice {
+	context := map[string]interface{}{
+		"previousStopId": previousStopID,
+		"stopId":         stopID,
+		"stopName":       stopName,
+		"csvRowNumber":   rowNumber,
+	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "stop_id",
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
+	return &StopIDRenamedNotice{
+		BaseNotice: newLocatedNotice("stop_id_renamed", WARNING, context, location),
+	}
+}
+
+// RouteRemovedNotice is generated when a route_id of the previous feed version no longer exists
+type RouteRemovedNotice struct {
+	*BaseNotice
+}
+
+func NewRouteRemovedNotice(routeID string, routeShortName string, routeLongName string) *RouteRemovedNotice {
+	context := map[string]interface{}{
+		"routeId":        routeID,
+		"routeShortName": routeShortName,
+		"routeLongName":  routeLongName,
+	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
+	return &RouteRemovedNotice{
+		BaseNotice: newLocatedNotice("route_removed", WARNING, context, location),
+	}
+}
+
+// RouteIDRenamedNotice is generated when a route appears to have been kept under a new route_id
+type RouteIDRenamedNotice struct {
+	*BaseNotice
+}
+
+func NewRouteIDRenamedNotice(previousRouteID string, routeID string, routeShortName string, rowNumber int) *RouteIDRenamedNotice {
+	context := map[string]interface{}{
+		"previousRouteId": previousRouteID,
+		"routeId":         routeID,
+		"routeShortName":  routeShortName,
+		"csvRowNumber":    rowNumber,
+	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		RowNumber:  rowNumber,
+		FieldName:  "route_id",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
+	return &RouteIDRenamedNotice{
+		BaseNotice: newLocatedNotice("route_id_renamed", WARNING, context, location),
+	}
+}
+
+// TripCountChangedNotice is generated when the number of trips of a route changes by more than the threshold
+type TripCountChangedNotice struct {
+	*BaseNotice
+}
+
+func NewTripCountChangedNotice(routeID string, firstAffectedDate string, affectedDateCount int, previousTripCount int, currentTripCount int, changePercent float64) *TripCountChangedNotice {
+	context := map[string]interface{}{
+		"routeId":           routeID,
+		"firstAffectedDate": firstAffectedDate,
+		"affectedDateCount": affectedDateCount,
+		"previousTripCount": previousTripCount,
+		"currentTripCount":  currentTripCount,
+		"changePercent":     changePercent,
+	}
+	location := NoticeLocation{
+		File:       "routes.txt",
+		PrimaryKey: map[string]string{"route_id": routeID},
+	}
+	return &TripCountChangedNotice{
+		BaseNotice: newLocatedNotice("trip_count_changed", WARNING, context, location),
+	}
+}
+
+// StopMovedNotice is generated when a stop moved further than the threshold between feed versions
+type StopMovedNotice struct {
+	*BaseNotice
+}
+
+func NewStopMovedNotice(stopID string, stopName string, previousLat, previousLon, currentLat, currentLon float64, distance float64, rowNumber int) *StopMovedNotice {
+	context := map[string]interface{}{
+		"stopId":       stopID,
+		"stopName":     stopName,
+		"previousLat":  previousLat,
+		"previousLon":  previousLon,
+		"currentLat":   currentLat,
+		"currentLon":   currentLon,
+		"distance":     distance,
+		"csvRowNumber": rowNumber,
+	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
+	return &StopMovedNotice{
+		BaseNotice: newLocatedNotice("stop_moved", WARNING, context, location),
+	}
+}
+
+// ServiceDatesRemovedNotice is generated when dates served by the previous feed version have no service anymore
+type ServiceDatesRemovedNotice struct {
+	*BaseNotice
+}
+
+func NewServiceDatesRemovedNotice(startDate string, endDate string, dayCount int, previousTripCount int) *ServiceDatesRemovedNotice {
+	context := map[string]interface{}{
+		"startDate":         startDate,
+		"endDate":           endDate,
+		"dayCount":          dayCount,
+		"previousTripCount": previousTripCount,
+	}
+	return &ServiceDatesRemovedNotice{
+		BaseNotice: NewBaseNotice("service_dates_removed", WARNING, context),
+	}
+}
+
+// ShapeChangedSignificantlyNotice is generated when a shape deviates from its previous version by more than the threshold
+type ShapeChangedSignificantlyNotice struct {
+	*BaseNotice
+}
+
+func NewShapeChangedSignificantlyNotice(shapeID string, maxDeviation float64, previousLength float64, currentLength float64) *ShapeChangedSignificantlyNotice {
+	context := map[string]interface{}{
+		"shapeId":        shapeID,
+		"maxDeviation":   maxDeviation,
+		"previousLength": previousLength,
+		"currentLength":  currentLength,
+	}
+	location := NoticeLocation{
+		File: "shapes.txt",
+	}
+	return &ShapeChangedSignificantlyNotice{
+		BaseNotice: newLocatedNotice("shape_changed_significantly", INFO, context, location),
+	}
+}
+
+// === ARCHIVE LAYOUT NOTICES ===
+
+// ArchiveFileInSubfolderNotice is generated when a GTFS file is stored in a subfolder of the ZIP archive
+type ArchiveFileInSubfolderNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveFileInSubfolderNotice(filename string, path string, used bool) *ArchiveFileInSubfolderNotice {
+	context := map[string]interface{}{
+		"filename": filename,
+		"path":     path,
+		"used":     used,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &ArchiveFileInSubfolderNotice{
+		BaseNotice: newLocatedNotice("archive_file_in_subfolder", ERROR, context, location),
+	}
+}
+
+// ArchiveDuplicateFileNameNotice is generated when several ZIP entries have the same GTFS file name
+type ArchiveDuplicateFileNameNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveDuplicateFileNameNotice(filename string, usedPath string, ignoredPaths []string) *ArchiveDuplicateFileNameNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"usedPath":     usedPath,
+		"ignoredPaths": ignoredPaths,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &ArchiveDuplicateFileNameNotice{
+		BaseNotice: newLocatedNotice("archive_duplicate_file_name", ERROR, context, location),
+	}
+}
+
+// ArchiveNestedZipNotice is generated when the feed archive contains another ZIP file
+type ArchiveNestedZipNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveNestedZipNotice(path string) *ArchiveNestedZipNotice {
+	context := map[string]interface{}{
+		"path": path,
+	}
+	return &ArchiveNestedZipNotice{
+		BaseNotice: NewBaseNotice("archive_nested_zip", WARNING, context),
+	}
+}
+
+// ArchiveOSMetadataNotice is generated for operating system metadata entries such as __MACOSX/ or .DS_Store
+type ArchiveOSMetadataNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveOSMetadataNotice(path string) *ArchiveOSMetadataNotice {
+	context := map[string]interface{}{
+		"path": path,
+	}
+	return &ArchiveOSMetadataNotice{
+		BaseNotice: NewBaseNotice("archive_os_metadata", WARNING, context),
+	}
+}
+
+// ArchiveNonUTF8FileNameNotice is generated when a ZIP entry name is not valid UTF-8
+type ArchiveNonUTF8FileNameNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveNonUTF8FileNameNotice(path string) *ArchiveNonUTF8FileNameNotice {
+	context := map[string]interface{}{
+		"path": path,
+	}
+	return &ArchiveNonUTF8FileNameNotice{
+		BaseNotice: NewBaseNotice("archive_non_utf8_file_name", WARNING, context),
+	}
+}
+
+// ArchiveLimitExceededNotice is generated when a ZIP archive exceeds a configured size, entry or compression limit
+type ArchiveLimitExceededNotice struct {
+	*BaseNotice
+}
+
+func NewArchiveLimitExceededNotice(limit string, path string, actual int64, max int64) *ArchiveLimitExceededNotice {
+	context := map[string]interface{}{
+		"limit":  limit,
+		"path":   path,
+		"actual": actual,
+		"max":    max,
+	}
+	return &ArchiveLimitExceededNotice{
+		BaseNotice: NewBaseNotice("archive_limit_exceeded", ERROR, context),
+	}
+}
+
+// NonUTF8EncodingNotice is generated when a file is not encoded as UTF-8
+type NonUTF8EncodingNotice struct {
+	*BaseNotice
+}
+
+func NewNonUTF8EncodingNotice(filename string, encoding string, transcoded bool) *NonUTF8EncodingNotice {
+	context := map[string]interface{}{
+		"filename":   filename,
+		"encoding":   encoding,
+		"transcoded": transcoded,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &NonUTF8EncodingNotice{
+		BaseNotice: newLocatedNotice("non_utf8_encoding", ERROR, context, location),
+	}
+}
+
+// UTF16ByteOrderMarkNotice is generated when a file starts with a UTF-16 byte order mark
+type UTF16ByteOrderMarkNotice struct {
+	*BaseNotice
+}
+
+func NewUTF16ByteOrderMarkNotice(filename string, encoding string, transcoded bool) *UTF16ByteOrderMarkNotice {
+	context := map[string]interface{}{
+		"filename":   filename,
+		"encoding":   encoding,
+		"transcoded": transcoded,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &UTF16ByteOrderMarkNotice{
+		BaseNotice: newLocatedNotice("utf16_byte_order_mark", ERROR, context, location),
+	}
+}
+
+// InvalidUTF8SequenceNotice is generated when a field of a UTF-8 file contains an invalid byte sequence
+type InvalidUTF8SequenceNotice struct {
+	*BaseNotice
+}
+
+func NewInvalidUTF8SequenceNotice(filename string, csvRowNumber int, fieldName string, columnNumber int, fieldValue string, invalidBytes string) *InvalidUTF8SequenceNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"fieldName":    fieldName,
+		"columnNumber": columnNumber,
+		"fieldValue":   fieldValue,
+		"invalidBytes": invalidBytes,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+		FieldName: fieldName,
+	}
+	return &InvalidUTF8SequenceNotice{
+		BaseNotice: newLocatedNotice("invalid_utf8_sequence", ERROR, context, location),
+	}
+}
+
+// CSVBareQuoteNotice is generated when a quote appears inside an unquoted field
+type CSVBareQuoteNotice struct {
+	*BaseNotice
+}
+
+func NewCSVBareQuoteNotice(filename string, csvRowNumber, lineNumber, column int) *CSVBareQuoteNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"lineNumber":   lineNumber,
+		"column":       column,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+	}
+	return &CSVBareQuoteNotice{
+		BaseNotice: newLocatedNotice("csv_bare_quote", WARNING, context, location),
+	}
+}
+
+// CSVInvalidQuoteNotice is generated when a closing quote is followed by something other than a comma or line break
+type CSVInvalidQuoteNotice struct {
+	*BaseNotice
+}
+
+func NewCSVInvalidQuoteNotice(filename string, csvRowNumber, lineNumber, column int) *CSVInvalidQuoteNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"lineNumber":   lineNumber,
+		"column":       column,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+	}
+	return &CSVInvalidQuoteNotice{
+		BaseNotice: newLocatedNotice("csv_invalid_quote", WARNING, context, location),
+	}
+}
+
+// CSVUnterminatedQuoteNotice is generated when a quoted field is never closed
+type CSVUnterminatedQuoteNotice struct {
+	*BaseNotice
+}
+
+func NewCSVUnterminatedQuoteNotice(filename string, csvRowNumber, lineNumber, column int) *CSVUnterminatedQuoteNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"lineNumber":   lineNumber,
+		"column":       column,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+	}
+	return &CSVUnterminatedQuoteNotice{
+		BaseNotice: newLocatedNotice("csv_unterminated_quote", ERROR, context, location),
+	}
+}
+
+// CSVNULByteNotice is generated when a file contains a NUL byte
+type CSVNULByteNotice struct {
+	*BaseNotice
+}
+
+func NewCSVNULByteNotice(filename string, csvRowNumber, lineNumber, column int) *CSVNULByteNotice {
+	context := map[string]interface{}{
+		"filename":     filename,
+		"csvRowNumber": csvRowNumber,
+		"lineNumber":   lineNumber,
+		"column":       column,
+	}
+	location := NoticeLocation{
+		File:      filename,
+		RowNumber: csvRowNumber,
+	}
+	return &CSVNULByteNotice{
+		BaseNotice: newLocatedNotice("csv_nul_byte", ERROR, context, location),
+	}
+}
+
+// CSVMixedLineEndingsNotice is generated when a file uses both CRLF and LF line breaks
+type CSVMixedLineEndingsNotice struct {
+	*BaseNotice
+}
+
+func NewCSVMixedLineEndingsNotice(filename string, lineNumber, crlfLines, lfLines int) *CSVMixedLineEndingsNotice {
+	context := map[string]interface{}{
+		"filename":   filename,
+		"lineNumber": lineNumber,
+		"crlfLines":  crlfLines,
+		"lfLines":    lfLines,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &CSVMixedLineEndingsNotice{
+		BaseNotice: newLocatedNotice("csv_mixed_line_endings", WARNING, context, location),
+	}
+}
+
+// CSVMissingTrailingNewlineNotice is generated when the last line of a file has no line break
+type CSVMissingTrailingNewlineNotice struct {
+	*BaseNotice
+}
+
+func NewCSVMissingTrailingNewlineNotice(filename string, lineNumber int) *CSVMissingTrailingNewlineNotice {
+	context := map[string]interface{}{
+		"filename":   filename,
+		"lineNumber": lineNumber,
+	}
+	location := NoticeLocation{
+		File: filename,
+	}
+	return &CSVMissingTrailingNewlineNotice{
+		BaseNotice: newLocatedNotice("csv_missing_trailing_newline", INFO, context, location),
+	}
+}
+
+// CrossFeedIDCollisionNotice is generated when an ID is used by more than one of the feeds being merged
+type CrossFeedIDCollisionNotice struct {
+	*BaseNotice
+}
+
+func NewCrossFeedIDCollisionNotice(filename, fieldName, fieldValue, feedName string, rowNumber int, otherFeedName string, otherRowNumber int) *CrossFeedIDCollisionNotice {
+	context := map[string]interface{}{
+		"filename":          filename,
+		"fieldName":         fieldName,
+		"fieldValue":        fieldValue,
+		"feedName":          feedName,
+		"csvRowNumber":      rowNumber,
+		"otherFeedName":     otherFeedName,
+		"otherCsvRowNumber": otherRowNumber,
+	}
+	location := NoticeLocation{
+		File:       filename,
+		RowNumber:  rowNumber,
+		FieldName:  fieldName,
+		PrimaryKey: map[string]string{fieldName: fieldValue},
+	}
+	return &CrossFeedIDCollisionNotice{
+		BaseNotice: newLocatedNotice("cross_feed_id_collision", ERROR, context, location),
+	}
+}
+
+// CrossFeedNearbyStopsNotice is generated when stops of different feeds are close enough to need a transfer
+type CrossFeedNearbyStopsNotice struct {
+	*BaseNotice
+}
+
+func NewCrossFeedNearbyStopsNotice(stopID, stopName, feedName string, rowNumber int, otherStopID, otherStopName, otherFeedName string, otherRowNumber int, distance float64) *CrossFeedNearbyStopsNotice {
+	context := map[string]interface{}{
+		"filename":          "stops.txt",
+		"stopId":            stopID,
+		"stopName":          stopName,
+		"feedName":          feedName,
+		"csvRowNumber":      rowNumber,
+		"otherStopId":       otherStopID,
+		"otherStopName":     otherStopName,
+		"otherFeedName":     otherFeedName,
+		"otherCsvRowNumber": otherRowNumber,
+		"distance":          distance,
+	}
+	location := NoticeLocation{
+		File:       "stops.txt",
+		RowNumber:  rowNumber,
+		PrimaryKey: map[string]string{"stop_id": stopID},
+	}
+	return &CrossFeedNearbyStopsNotice{
+		BaseNotice: newLocatedNotice("cross_feed_nearby_stops", WARNING, context, location),
+	}
+}
+
+// CrossFeedInconsistentTimezoneNotice is generated when feeds serving the same region declare different timezones
+type CrossFeedInconsistentTimezoneNotice struct {
+	*BaseNotice
+}
+
+func NewCrossFeedInconsistentTimezoneNotice(feedName, timezone string, rowNumber int, otherFeedName, otherTimezone string, otherRowNumber int) *CrossFeedInconsistentTimezoneNotice {
+	context := map[string]interface{}{
+		"filename":          "agency.txt",
+		"fieldName":         "agency_timezone",
+		"feedName":          feedName,
+		"agencyTimezone":    timezone,
+		"csvRowNumber":      rowNumber,
+		"otherFeedName":     otherFeedName,
+		"otherTimezone":     otherTimezone,
+		"otherCsvRowNumber": otherRowNumber,
+	}
+	location := NoticeLocation{
+		File:      "agency.txt",
+		RowNumber: rowNumber,
+		FieldName: "agency_timezone",
+	}
+	return &CrossFeedInconsistentTimezoneNotice{
+		BaseNotice: newLocatedNotice("cross_feed_inconsistent_timezone", WARNING, context, location),
+	}
+}
+
 // === VALIDATOR SYSTEM NOTICES ===
 
 // ValidatorErrorNotice is generated when a validator encounters an error
@@ -1,6 +1,7 @@
 package gtfsvalidator
 
 import (
+	"fmt"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -67,373 +68,570 @@ func getNoticeDescription(code string) string {
 	return enhanced.Description
 }
 
-// GetEnhancedNoticeDescription returns detailed notice information including GTFS references
-func GetEnhancedNoticeDescription(code string) NoticeDescription {
-	descriptions := map[string]NoticeDescription{
-		// === CORE VALIDATION ERRORS ===
-		"missing_required_file": {
-			Description:   "A required GTFS file is missing from the feed. This file is essential for GTFS compliance and must be present.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
-			AffectedFiles: []string{"agency.txt", "stops.txt", "routes.txt", "trips.txt", "stop_times.txt"},
-			Impact:        "Feed will not be accepted by GTFS consumers and transit apps",
-			ExampleFix:    "Create the missing file with required headers and data. For example, agency.txt must contain: agency_id,agency_name,agency_url,agency_timezone",
-		},
-		"missing_required_field": {
-			Description:   "A required field is missing from a GTFS file. This field is mandatory according to the GTFS specification.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
-			Impact:        "Data integrity issues, potential feed rejection by transit applications",
-			ExampleFix:    "Add the missing field to the file header and provide values for all rows. For example, add 'stop_name' column to stops.txt",
-		},
-		"empty_file": {
-			Description:   "A GTFS file is completely empty (no data rows). Empty files may indicate data export issues or missing content.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
-			Impact:        "File parsing errors, incomplete feed information",
-			ExampleFix:    "Remove the empty file if not needed, or add proper header and data rows",
-		},
-		"invalid_date_format": {
-			Description:    "Date field contains invalid format. GTFS requires dates in YYYYMMDD format.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
-			AffectedFiles:  []string{"calendar.txt", "calendar_dates.txt", "feed_info.txt"},
-			AffectedFields: []string{"start_date", "end_date", "date", "feed_start_date", "feed_end_date"},
-			Impact:         "Date parsing errors, service scheduling issues",
-			ExampleFix:     "Change '2023-12-25' to '20231225' or '25/12/2023' to '20231225'",
-		},
-		"invalid_date": {
-			Description:    "A date field contains an invalid date format. Dates must be in YYYYMMDD format.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
-			AffectedFiles:  []string{"calendar.txt", "calendar_dates.txt", "feed_info.txt"},
-			AffectedFields: []string{"start_date", "end_date", "date", "feed_start_date", "feed_end_date"},
-			Impact:         "Date parsing errors, service scheduling issues",
-			ExampleFix:     "Change '2023-12-25' to '20231225' or '25/12/2023' to '20231225'",
-		},
-		"invalid_time_format": {
-			Description:    "Time field contains invalid format. GTFS requires times in HH:MM:SS format (24-hour clock).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
-			AffectedFiles:  []string{"stop_times.txt", "frequencies.txt"},
-			AffectedFields: []string{"arrival_time", "departure_time", "start_time", "end_time"},
-			Impact:         "Time parsing errors, trip scheduling issues",
-			ExampleFix:     "Change '2:30 PM' to '14:30:00' or '9:15' to '09:15:00'. Use '25:30:00' for next-day service.",
-		},
-		"invalid_coordinate": {
-			Description:    "Coordinates are outside valid ranges. Latitude must be between -90 and 90, longitude between -180 and 180.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
-			AffectedFiles:  []string{"stops.txt", "shapes.txt"},
-			AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
-			Impact:         "Mapping errors, location display issues",
-			ExampleFix:     "Ensure latitude is between -90 and 90 (e.g., 40.748817) and longitude is between -180 and 180 (e.g., -73.985428)",
-		},
-		"invalid_route_type": {
-			Description:    "Route type must be a valid GTFS route type code (0-12 for basic types, 100-1799 for extended types).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
-			AffectedFiles:  []string{"routes.txt"},
-			AffectedFields: []string{"route_type"},
-			Impact:         "Route classification errors, consumer confusion",
-			ExampleFix:     "Use valid codes: 0=Tram, 1=Subway, 2=Rail, 3=Bus, 4=Ferry, 5=Cable, 6=Gondola, 7=Funicular, 11=Trolleybus, 12=Monorail",
-		},
-		"duplicate_key": {
-			Description:   "A record has a duplicate primary key. Each record must have a unique identifier to maintain data integrity.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
-			Impact:        "Data conflicts, potential feed rejection",
-			ExampleFix:    "Ensure each record has a unique primary key value. For stops.txt, each stop_id must be unique.",
-		},
+// Descriptions of codes missing from noticeDescriptions, also used as message keys
+const (
+	genericNoticeDescription = "%s. This validation check identified an issue that should be reviewed and corrected."
+	genericNoticeImpact      = "Data quality issue that should be reviewed"
+)
 
-		// === ENTITY VALIDATION ERRORS ===
-		"missing_route_name": {
-			Description:    "Both route_short_name and route_long_name are empty. At least one route name must be provided for passenger identification.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
-			AffectedFiles:  []string{"routes.txt"},
-			AffectedFields: []string{"route_short_name", "route_long_name"},
-			Impact:         "Passengers cannot identify routes, poor user experience",
-			ExampleFix:     "Add either route_short_name (e.g., '1', 'Blue Line') or route_long_name (e.g., 'Downtown Express')",
-		},
-		"same_name_and_description": {
-			Description:    "Route short name and long name are identical. These should provide different levels of detail for passenger information.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
-			AffectedFiles:  []string{"routes.txt"},
-			AffectedFields: []string{"route_short_name", "route_long_name"},
-			Impact:         "Reduced information value for passengers",
-			ExampleFix:     "Use route_short_name for '1' or 'Blue', route_long_name for 'Downtown Express'",
-		},
-		"duplicate_route_name": {
-			Description:   "Multiple routes have the same name. This may cause confusion for passengers and should be differentiated.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#routestxt",
-			AffectedFiles: []string{"routes.txt"},
-			Impact:        "Passenger confusion when identifying routes",
-			ExampleFix:    "Ensure each route has a unique name combination of short_name and long_name",
-		},
-		"poor_color_contrast": {
-			Description:    "Route colors have insufficient contrast for accessibility compliance. This affects colorblind users and accessibility standards.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
-			AffectedFiles:  []string{"routes.txt"},
-			AffectedFields: []string{"route_color", "route_text_color"},
-			Impact:         "Accessibility compliance issues, poor user experience for colorblind users",
-			ExampleFix:     "Use high contrast combinations like white text (#FFFFFF) on dark backgrounds (#000000) or vice versa",
-		},
-		"missing_stop_name": {
-			Description:    "A required stop name is missing. Stop names are essential for passenger identification and navigation.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
-			AffectedFiles:  []string{"stops.txt"},
-			AffectedFields: []string{"stop_name"},
-			Impact:         "Passengers cannot identify stops, accessibility issues",
-			ExampleFix:     "Add descriptive stop names like 'Main St & 1st Ave' or 'Downtown Transit Center'",
-		},
-		"foreign_key_violation": {
-			Description:   "A foreign key reference is invalid. The referenced record does not exist in the target file.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
-			Impact:        "Data integrity issues, broken relationships between files",
-			ExampleFix:    "Ensure referenced IDs exist. For example, if trips.txt references route_id 'R1', ensure 'R1' exists in routes.txt",
-		},
-		"excessive_travel_speed": {
-			Description:    "Travel speed between stops is unrealistically fast for the transport mode. This may indicate data errors or missing stops.",
-			GTFSReference:  "https://gtfs.org/schedule/best-practices/#stop_timestxt",
-			AffectedFiles:  []string{"stop_times.txt"},
-			AffectedFields: []string{"arrival_time", "departure_time"},
-			Impact:         "Unrealistic trip planning, passenger confusion",
-			ExampleFix:     "Check for missing intermediate stops or correct travel times. Bus speeds should typically be under 100 km/h.",
-		},
-		"stop_name_missing_but_inherited": {
-			Description: "Stop name is missing but can inherit from parent station. Consider adding explicit stop name for clarity.",
-			Impact:      "Reduced clarity for passengers, dependency on parent station naming",
-			ExampleFix:  "Add explicit stop_name even if it can inherit from parent_station",
-		},
-		"generic_stop_name": {
-			Description: "Stop name is too generic (e.g., 'stop', 'station'). Use descriptive names to help passengers identify locations.",
-			Impact:      "Poor passenger experience, difficulty identifying stops",
-			ExampleFix:  "Replace 'Stop 1' with 'Main St & 1st Ave' or 'Downtown Transit Center'",
-		},
-		"invalid_bikes_allowed": {
-			Description:    "Bikes allowed field contains an invalid value. Must be 0 (no info), 1 (bikes allowed), or 2 (bikes not allowed).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#tripstxt",
-			AffectedFiles:  []string{"trips.txt"},
-			AffectedFields: []string{"bikes_allowed"},
-			Impact:         "Incorrect bike policy information for passengers",
-			ExampleFix:     "Use 0 for no information, 1 if bikes are allowed, 2 if bikes are not allowed",
-		},
-		"attribution_without_role": {
-			Description:    "Attribution has no role assigned. Each attribution must have at least one role (producer, operator, or authority).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#attributionstxt",
-			AffectedFiles:  []string{"attributions.txt"},
-			AffectedFields: []string{"is_producer", "is_operator", "is_authority"},
-			Impact:         "Unclear attribution responsibilities, compliance issues",
-			ExampleFix:     "Set at least one role to 1: is_producer=1, is_operator=0, is_authority=0",
-		},
+// noticeDescriptions maps notice codes to their English descriptions
+var noticeDescriptions = map[string]NoticeDescription{
+	// === CORE VALIDATION ERRORS ===
+	"missing_required_file": {
+		Description:   "A required GTFS file is missing from the feed. This file is essential for GTFS compliance and must be present.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		AffectedFiles: []string{"agency.txt", "stops.txt", "routes.txt", "trips.txt", "stop_times.txt"},
+		Impact:        "Feed will not be accepted by GTFS consumers and transit apps",
+		ExampleFix:    "Create the missing file with required headers and data. For example, agency.txt must contain: agency_id,agency_name,agency_url,agency_timezone",
+	},
+	"missing_required_field": {
+		Description:   "A required field is missing from a GTFS file. This field is mandatory according to the GTFS specification.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
+		Impact:        "Data integrity issues, potential feed rejection by transit applications",
+		ExampleFix:    "Add the missing field to the file header and provide values for all rows. For example, add 'stop_name' column to stops.txt",
+	},
+	"empty_file": {
+		Description:   "A GTFS file is completely empty (no data rows). Empty files may indicate data export issues or missing content.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		Impact:        "File parsing errors, incomplete feed information",
+		ExampleFix:    "Remove the empty file if not needed, or add proper header and data rows",
+	},
+	"invalid_date_format": {
+		Description:    "Date field contains invalid format. GTFS requires dates in YYYYMMDD format.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
+		AffectedFiles:  []string{"calendar.txt", "calendar_dates.txt", "feed_info.txt"},
+		AffectedFields: []string{"start_date", "end_date", "date", "feed_start_date", "feed_end_date"},
+		Impact:         "Date parsing errors, service scheduling issues",
+		ExampleFix:     "Change '2023-12-25' to '20231225' or '25/12/2023' to '20231225'",
+	},
+	"invalid_date": {
+		Description:    "A date field contains an invalid date format. Dates must be in YYYYMMDD format.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
+		AffectedFiles:  []string{"calendar.txt", "calendar_dates.txt", "feed_info.txt"},
+		AffectedFields: []string{"start_date", "end_date", "date", "feed_start_date", "feed_end_date"},
+		Impact:         "Date parsing errors, service scheduling issues",
+		ExampleFix:     "Change '2023-12-25' to '20231225' or '25/12/2023' to '20231225'",
+	},
+	"invalid_time_format": {
+		Description:    "Time field contains invalid format. GTFS requires times in HH:MM:SS format (24-hour clock).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
+		AffectedFiles:  []string{"stop_times.txt", "frequencies.txt"},
+		AffectedFields: []string{"arrival_time", "departure_time", "start_time", "end_time"},
+		Impact:         "Time parsing errors, trip scheduling issues",
+		ExampleFix:     "Change '2:30 PM' to '14:30:00' or '9:15' to '09:15:00'. Use '25:30:00' for next-day service.",
+	},
+	"invalid_coordinate": {
+		Description:    "Coordinates are outside valid ranges. Latitude must be between -90 and 90, longitude between -180 and 180.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles:  []string{"stops.txt", "shapes.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
+		Impact:         "Mapping errors, location display issues",
+		ExampleFix:     "Ensure latitude is between -90 and 90 (e.g., 40.748817) and longitude is between -180 and 180 (e.g., -73.985428)",
+	},
+	"invalid_route_type": {
+		Description:    "Route type must be a valid GTFS route type code (0-12 for basic types, 100-1799 for extended types).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_type"},
+		Impact:         "Route classification errors, consumer confusion",
+		ExampleFix:     "Use valid codes: 0=Tram, 1=Subway, 2=Rail, 3=Bus, 4=Ferry, 5=Cable, 6=Gondola, 7=Funicular, 11=Trolleybus, 12=Monorail",
+	},
+	"duplicate_key": {
+		Description:   "A record has a duplicate primary key. Each record must have a unique identifier to maintain data integrity.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
+		Impact:        "Data conflicts, potential feed rejection",
+		ExampleFix:    "Ensure each record has a unique primary key value. For stops.txt, each stop_id must be unique.",
+	},
 
-		// === RELATIONSHIP VALIDATION ERRORS ===
-		"duplicate_stop_sequence": {
-			Description:    "Duplicate stop sequence found in a trip. Each stop in a trip must have a unique sequence number.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
-			AffectedFiles:  []string{"stop_times.txt"},
-			AffectedFields: []string{"stop_sequence"},
-			Impact:         "Trip routing errors, navigation issues",
-			ExampleFix:     "Ensure stop_sequence values are unique within each trip: 1, 2, 3, 4... not 1, 2, 2, 3",
-		},
-		"decreasing_stop_sequence": {
-			Description:    "Stop sequence decreases along a trip. Stop sequences should generally increase from start to end.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
-			AffectedFiles:  []string{"stop_times.txt"},
-			AffectedFields: []string{"stop_sequence"},
-			Impact:         "Confusing trip progression, route planning issues",
-			ExampleFix:     "Use increasing sequence numbers: 1, 2, 3, 4 instead of 4, 3, 2, 1",
-		},
-		"arrival_after_departure": {
-			Description:    "Arrival time is after departure time at a stop. This creates impossible travel scenarios.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
-			AffectedFiles:  []string{"stop_times.txt"},
-			AffectedFields: []string{"arrival_time", "departure_time"},
-			Impact:         "Impossible schedule, confuses trip planners",
-			ExampleFix:     "Ensure arrival_time <= departure_time: arrival_time=14:30:00, departure_time=14:32:00",
-		},
+	// === ENTITY VALIDATION ERRORS ===
+	"missing_route_name": {
+		Description:    "Both route_short_name and route_long_name are empty. At least one route name must be provided for passenger identification.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_short_name", "route_long_name"},
+		Impact:         "Passengers cannot identify routes, poor user experience",
+		ExampleFix:     "Add either route_short_name (e.g., '1', 'Blue Line') or route_long_name (e.g., 'Downtown Express')",
+	},
+	"same_name_and_description": {
+		Description:    "Route short name and long name are identical. These should provide different levels of detail for passenger information.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_short_name", "route_long_name"},
+		Impact:         "Reduced information value for passengers",
+		ExampleFix:     "Use route_short_name for '1' or 'Blue', route_long_name for 'Downtown Express'",
+	},
+	"duplicate_route_name": {
+		Description:   "Multiple routes have the same name. This may cause confusion for passengers and should be differentiated.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#routestxt",
+		AffectedFiles: []string{"routes.txt"},
+		Impact:        "Passenger confusion when identifying routes",
+		ExampleFix:    "Ensure each route has a unique name combination of short_name and long_name",
+	},
+	"poor_color_contrast": {
+		Description:    "Route colors have insufficient contrast for accessibility compliance. This affects colorblind users and accessibility standards.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_color", "route_text_color"},
+		Impact:         "Accessibility compliance issues, poor user experience for colorblind users",
+		ExampleFix:     "Use high contrast combinations like white text (#FFFFFF) on dark backgrounds (#000000) or vice versa",
+	},
+	"missing_stop_name": {
+		Description:    "A required stop name is missing. Stop names are essential for passenger identification and navigation.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles:  []string{"stops.txt"},
+		AffectedFields: []string{"stop_name"},
+		Impact:         "Passengers cannot identify stops, accessibility issues",
+		ExampleFix:     "Add descriptive stop names like 'Main St & 1st Ave' or 'Downtown Transit Center'",
+	},
+	"foreign_key_violation": {
+		Description:   "A foreign key reference is invalid. The referenced record does not exist in the target file.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#field-definitions",
+		Impact:        "Data integrity issues, broken relationships between files",
+		ExampleFix:    "Ensure referenced IDs exist. For example, if trips.txt references route_id 'R1', ensure 'R1' exists in routes.txt",
+	},
+	"excessive_travel_speed": {
+		Description:    "Travel speed between stops is unrealistically fast for the transport mode. This may indicate data errors or missing stops.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#stop_timestxt",
+		AffectedFiles:  []string{"stop_times.txt"},
+		AffectedFields: []string{"arrival_time", "departure_time"},
+		Impact:         "Unrealistic trip planning, passenger confusion",
+		ExampleFix:     "Check for missing intermediate stops or correct travel times. Bus speeds should typically be under 100 km/h.",
+	},
+	"stop_name_missing_but_inherited": {
+		Description: "Stop name is missing but can inherit from parent station. Consider adding explicit stop name for clarity.",
+		Impact:      "Reduced clarity for passengers, dependency on parent station naming",
+		ExampleFix:  "Add explicit stop_name even if it can inherit from parent_station",
+	},
+	"generic_stop_name": {
+		Description: "Stop name is too generic (e.g., 'stop', 'station'). Use descriptive names to help passengers identify locations.",
+		Impact:      "Poor passenger experience, difficulty identifying stops",
+		ExampleFix:  "Replace 'Stop 1' with 'Main St & 1st Ave' or 'Downtown Transit Center'",
+	},
+	"invalid_bikes_allowed": {
+		Description:    "Bikes allowed field contains an invalid value. Must be 0 (no info), 1 (bikes allowed), or 2 (bikes not allowed).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#tripstxt",
+		AffectedFiles:  []string{"trips.txt"},
+		AffectedFields: []string{"bikes_allowed"},
+		Impact:         "Incorrect bike policy information for passengers",
+		ExampleFix:     "Use 0 for no information, 1 if bikes are allowed, 2 if bikes are not allowed",
+	},
+	"attribution_without_role": {
+		Description:    "Attribution has no role assigned. Each attribution must have at least one role (producer, operator, or authority).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#attributionstxt",
+		AffectedFiles:  []string{"attributions.txt"},
+		AffectedFields: []string{"is_producer", "is_operator", "is_authority"},
+		Impact:         "Unclear attribution responsibilities, compliance issues",
+		ExampleFix:     "Set at least one role to 1: is_producer=1, is_operator=0, is_authority=0",
+	},
 
-		// === BUSINESS LOGIC ERRORS ===
-		"impossible_travel_time": {
-			Description:    "Travel time between stops is impossible for the transport mode. This indicates data quality issues.",
-			GTFSReference:  "https://gtfs.org/schedule/best-practices/#stop_timestxt",
-			AffectedFiles:  []string{"stop_times.txt"},
-			AffectedFields: []string{"arrival_time", "departure_time"},
-			Impact:         "Unrealistic schedules, passenger confusion",
-			ExampleFix:     "Check time calculations: ensure sufficient travel time between stops based on distance and mode",
-		},
-		"invalid_frequency_time_range": {
-			Description:    "Frequency time range is invalid. Start time must be before end time for each frequency period.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
-			AffectedFiles:  []string{"frequencies.txt"},
-			AffectedFields: []string{"start_time", "end_time"},
-			Impact:         "Invalid service periods, schedule confusion",
-			ExampleFix:     "Ensure start_time < end_time: start_time=06:00:00, end_time=22:00:00",
-		},
-		"invalid_headway": {
-			Description:    "Frequency headway is invalid. Headway must be greater than 0 seconds.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
-			AffectedFiles:  []string{"frequencies.txt"},
-			AffectedFields: []string{"headway_secs"},
-			Impact:         "Service frequency errors, schedule planning issues",
-			ExampleFix:     "Use positive headway values: headway_secs=900 (15 minutes) instead of 0 or negative",
-		},
-		"overlapping_frequency": {
-			Description:    "Frequency periods overlap for the same trip. Each time period should be distinct and non-overlapping.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
-			AffectedFiles:  []string{"frequencies.txt"},
-			AffectedFields: []string{"start_time", "end_time", "trip_id"},
-			Impact:         "Schedule conflicts, operational confusion",
-			ExampleFix:     "Ensure non-overlapping periods: Period 1: 06:00-12:00, Period 2: 12:00-18:00",
-		},
-		"invalid_transfer_type": {
-			Description:    "Transfer type is invalid. Must be 0 (recommended), 1 (timed), 2 (minimum time), or 3 (not possible).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#transferstxt",
-			AffectedFiles:  []string{"transfers.txt"},
-			AffectedFields: []string{"transfer_type"},
-			Impact:         "Incorrect transfer guidance for passengers",
-			ExampleFix:     "Use valid values: 0=recommended, 1=timed, 2=minimum time required, 3=not possible",
-		},
-		"expired_feed": {
-			Description:    "The feed has expired. Feeds should be updated regularly to provide current service information.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#feed_infotxt",
-			AffectedFiles:  []string{"feed_info.txt"},
-			AffectedFields: []string{"feed_end_date"},
-			Impact:         "Feed will be rejected by trip planners, outdated service information",
-			ExampleFix:     "Update feed_end_date to a current date: feed_end_date=20241231",
-		},
-		"insufficient_service_coverage": {
-			Description:   "Service coverage is insufficient for the next period. Ensure adequate service is available for passengers.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#calendartxt",
-			AffectedFiles: []string{"calendar.txt", "calendar_dates.txt"},
-			Impact:        "Limited service availability, poor passenger experience",
-			ExampleFix:    "Extend service dates or add more active service periods in calendar.txt",
-		},
+	// === RELATIONSHIP VALIDATION ERRORS ===
+	"duplicate_stop_sequence": {
+		Description:    "Duplicate stop sequence found in a trip. Each stop in a trip must have a unique sequence number.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
+		AffectedFiles:  []string{"stop_times.txt"},
+		AffectedFields: []string{"stop_sequence"},
+		Impact:         "Trip routing errors, navigation issues",
+		ExampleFix:     "Ensure stop_sequence values are unique within each trip: 1, 2, 3, 4... not 1, 2, 2, 3",
+	},
+	"decreasing_stop_sequence": {
+		Description:    "Stop sequence decreases along a trip. Stop sequences should generally increase from start to end.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
+		AffectedFiles:  []string{"stop_times.txt"},
+		AffectedFields: []string{"stop_sequence"},
+		Impact:         "Confusing trip progression, route planning issues",
+		ExampleFix:     "Use increasing sequence numbers: 1, 2, 3, 4 instead of 4, 3, 2, 1",
+	},
+	"arrival_after_departure": {
+		Description:    "Arrival time is after departure time at a stop. This creates impossible travel scenarios.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stop_timestxt",
+		AffectedFiles:  []string{"stop_times.txt"},
+		AffectedFields: []string{"arrival_time", "departure_time"},
+		Impact:         "Impossible schedule, confuses trip planners",
+		ExampleFix:     "Ensure arrival_time <= departure_time: arrival_time=14:30:00, departure_time=14:32:00",
+	},
 
-		// === ACCESSIBILITY ERRORS ===
-		"invalid_pathway_mode": {
-			Description:    "Pathway mode is invalid or not specified. Pathway modes must be valid GTFS pathway type codes (1-7).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#pathwaystxt",
-			AffectedFiles:  []string{"pathways.txt"},
-			AffectedFields: []string{"pathway_mode"},
-			Impact:         "Accessibility navigation issues, compliance problems",
-			ExampleFix:     "Use valid codes: 1=walkway, 2=stairs, 3=moving_sidewalk, 4=escalator, 5=elevator, 6=fare_gate, 7=exit_gate",
-		},
-		"unreasonable_level_index": {
-			Description:    "Level index is outside reasonable bounds. Level indices should be between -50 and +50.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#levelstxt",
-			AffectedFiles:  []string{"levels.txt"},
-			AffectedFields: []string{"level_index"},
-			Impact:         "Level navigation confusion, accessibility issues",
-			ExampleFix:     "Use reasonable values: level_index=0 (ground), -1 (basement), 2 (second floor)",
-		},
+	// === BUSINESS LOGIC ERRORS ===
+	"impossible_travel_time": {
+		Description:    "Travel time between stops is impossible for the transport mode. This indicates data quality issues.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#stop_timestxt",
+		AffectedFiles:  []string{"stop_times.txt"},
+		AffectedFields: []string{"arrival_time", "departure_time"},
+		Impact:         "Unrealistic schedules, passenger confusion",
+		ExampleFix:     "Check time calculations: ensure sufficient travel time between stops based on distance and mode",
+	},
+	"invalid_frequency_time_range": {
+		Description:    "Frequency time range is invalid. Start time must be before end time for each frequency period.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
+		AffectedFiles:  []string{"frequencies.txt"},
+		AffectedFields: []string{"start_time", "end_time"},
+		Impact:         "Invalid service periods, schedule confusion",
+		ExampleFix:     "Ensure start_time < end_time: start_time=06:00:00, end_time=22:00:00",
+	},
+	"invalid_headway": {
+		Description:    "Frequency headway is invalid. Headway must be greater than 0 seconds.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
+		AffectedFiles:  []string{"frequencies.txt"},
+		AffectedFields: []string{"headway_secs"},
+		Impact:         "Service frequency errors, schedule planning issues",
+		ExampleFix:     "Use positive headway values: headway_secs=900 (15 minutes) instead of 0 or negative",
+	},
+	"overlapping_frequency": {
+		Description:    "Frequency periods overlap for the same trip. Each time period should be distinct and non-overlapping.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#frequenciestxt",
+		AffectedFiles:  []string{"frequencies.txt"},
+		AffectedFields: []string{"start_time", "end_time", "trip_id"},
+		Impact:         "Schedule conflicts, operational confusion",
+		ExampleFix:     "Ensure non-overlapping periods: Period 1: 06:00-12:00, Period 2: 12:00-18:00",
+	},
+	"invalid_transfer_type": {
+		Description:    "Transfer type is invalid. Must be 0 (recommended), 1 (timed), 2 (minimum time), or 3 (not possible).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#transferstxt",
+		AffectedFiles:  []string{"transfers.txt"},
+		AffectedFields: []string{"transfer_type"},
+		Impact:         "Incorrect transfer guidance for passengers",
+		ExampleFix:     "Use valid values: 0=recommended, 1=timed, 2=minimum time required, 3=not possible",
+	},
+	"expired_feed": {
+		Description:    "The feed has expired. Feeds should be updated regularly to provide current service information.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#feed_infotxt",
+		AffectedFiles:  []string{"feed_info.txt"},
+		AffectedFields: []string{"feed_end_date"},
+		Impact:         "Feed will be rejected by trip planners, outdated service information",
+		ExampleFix:     "Update feed_end_date to a current date: feed_end_date=20241231",
+	},
+	"insufficient_service_coverage": {
+		Description:   "Service coverage is insufficient for the next period. Ensure adequate service is available for passengers.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#calendartxt",
+		AffectedFiles: []string{"calendar.txt", "calendar_dates.txt"},
+		Impact:        "Limited service availability, poor passenger experience",
+		ExampleFix:    "Extend service dates or add more active service periods in calendar.txt",
+	},
 
-		// === FARE SYSTEM ERRORS ===
-		"invalid_fare_price": {
-			Description:    "Fare price is negative or has excessive precision. Prices should be non-negative with reasonable decimal places.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
-			AffectedFiles:  []string{"fare_attributes.txt"},
-			AffectedFields: []string{"price"},
-			Impact:         "Fare calculation errors, payment system issues",
-			ExampleFix:     "Use valid prices: price=2.50 (not -2.50 or 2.123456)",
-		},
-		"invalid_payment_method": {
-			Description:    "Payment method is invalid. Must be 0 (paid on board) or 1 (paid before boarding).",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
-			AffectedFiles:  []string{"fare_attributes.txt"},
-			AffectedFields: []string{"payment_method"},
-			Impact:         "Confusion about payment process for passengers",
-			ExampleFix:     "Use 0 for pay-on-board or 1 for prepaid tickets/cards",
-		},
-		"empty_fare_rule": {
-			Description:    "A fare rule has no qualifying conditions specified. At least one condition must be defined.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#fare_rulestxt",
-			AffectedFiles:  []string{"fare_rules.txt"},
-			AffectedFields: []string{"route_id", "origin_id", "destination_id", "contains_id"},
-			Impact:         "Ambiguous fare application, pricing confusion",
-			ExampleFix:     "Specify at least one condition: route_id=R1 or origin_id=zone_A",
-		},
+	// === ACCESSIBILITY ERRORS ===
+	"invalid_pathway_mode": {
+		Description:    "Pathway mode is invalid or not specified. Pathway modes must be valid GTFS pathway type codes (1-7).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#pathwaystxt",
+		AffectedFiles:  []string{"pathways.txt"},
+		AffectedFields: []string{"pathway_mode"},
+		Impact:         "Accessibility navigation issues, compliance problems",
+		ExampleFix:     "Use valid codes: 1=walkway, 2=stairs, 3=moving_sidewalk, 4=escalator, 5=elevator, 6=fare_gate, 7=exit_gate",
+	},
+	"unreasonable_level_index": {
+		Description:    "Level index is outside reasonable bounds. Level indices should be between -50 and +50.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#levelstxt",
+		AffectedFiles:  []string{"levels.txt"},
+		AffectedFields: []string{"level_index"},
+		Impact:         "Level navigation confusion, accessibility issues",
+		ExampleFix:     "Use reasonable values: level_index=0 (ground), -1 (basement), 2 (second floor)",
+	},
 
-		// === GEOGRAPHIC DATA ERRORS ===
-		"suspicious_coordinate": {
-			Description:    "Coordinates appear to be placeholder or error values (e.g., 0,0). This may indicate data import issues.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
-			AffectedFiles:  []string{"stops.txt", "shapes.txt"},
-			AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
-			Impact:         "Incorrect location display, navigation problems",
-			ExampleFix:     "Replace with actual coordinates: stop_lat=40.748817, stop_lon=-73.985428",
-		},
-		"very_close_stops": {
-			Description:    "Stops are located very close together (within 10 meters). This may indicate duplicate stops or data errors.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
-			AffectedFiles:  []string{"stops.txt"},
-			AffectedFields: []string{"stop_lat", "stop_lon"},
-			Impact:         "Confusion for passengers, redundant data",
-			ExampleFix:     "Review stops and consolidate duplicates or ensure accurate coordinates",
-		},
+	// === FARE SYSTEM ERRORS ===
+	"invalid_fare_price": {
+		Description:    "Fare price is negative or has excessive precision. Prices should be non-negative with reasonable decimal places.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
+		AffectedFiles:  []string{"fare_attributes.txt"},
+		AffectedFields: []string{"price"},
+		Impact:         "Fare calculation errors, payment system issues",
+		ExampleFix:     "Use valid prices: price=2.50 (not -2.50 or 2.123456)",
+	},
+	"invalid_payment_method": {
+		Description:    "Payment method is invalid. Must be 0 (paid on board) or 1 (paid before boarding).",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
+		AffectedFiles:  []string{"fare_attributes.txt"},
+		AffectedFields: []string{"payment_method"},
+		Impact:         "Confusion about payment process for passengers",
+		ExampleFix:     "Use 0 for pay-on-board or 1 for prepaid tickets/cards",
+	},
+	"empty_fare_rule": {
+		Description:    "A fare rule has no qualifying conditions specified. At least one condition must be defined.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#fare_rulestxt",
+		AffectedFiles:  []string{"fare_rules.txt"},
+		AffectedFields: []string{"route_id", "origin_id", "destination_id", "contains_id"},
+		Impact:         "Ambiguous fare application, pricing confusion",
+		ExampleFix:     "Specify at least one condition: route_id=R1 or origin_id=zone_A",
+	},
 
-		// === NETWORK TOPOLOGY ERRORS ===
-		"isolated_stop": {
-			Description:   "A stop cannot be reached by any trip. This creates disconnected network elements.",
-			GTFSReference: "https://gtfs.org/schedule/reference/#stopstxt",
-			AffectedFiles: []string{"stops.txt", "stop_times.txt"},
-			Impact:        "Stop is unusable by passengers, waste of resources",
-			ExampleFix:    "Add trips that serve this stop or remove if no longer needed",
-		},
+	// === GEOGRAPHIC DATA ERRORS ===
+	"suspicious_coordinate": {
+		Description:    "Coordinates appear to be placeholder or error values (e.g., 0,0). This may indicate data import issues.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles:  []string{"stops.txt", "shapes.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
+		Impact:         "Incorrect location display, navigation problems",
+		ExampleFix:     "Replace with actual coordinates: stop_lat=40.748817, stop_lon=-73.985428",
+	},
+	"very_close_stops": {
+		Description:    "Stops are located very close together (within 10 meters). This may indicate duplicate stops or data errors.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles:  []string{"stops.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon"},
+		Impact:         "Confusion for passengers, redundant data",
+		ExampleFix:     "Review stops and consolidate duplicates or ensure accurate coordinates",
+	},
 
-		// === FEED INFO ERRORS ===
-		"invalid_feed_language": {
-			Description:    "Feed language code is invalid. Must be a valid 2-letter ISO 639-1 language code.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#feed_infotxt",
-			AffectedFiles:  []string{"feed_info.txt"},
-			AffectedFields: []string{"feed_lang"},
-			Impact:         "Language detection issues in transit apps",
-			ExampleFix:     "Use valid codes: en, es, fr, de, ja, etc.",
-		},
+	// === NETWORK TOPOLOGY ERRORS ===
+	"isolated_stop": {
+		Description:   "A stop cannot be reached by any trip. This creates disconnected network elements.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles: []string{"stops.txt", "stop_times.txt"},
+		Impact:        "Stop is unusable by passengers, waste of resources",
+		ExampleFix:    "Add trips that serve this stop or remove if no longer needed",
+	},
 
-		// === TRIP AND SERVICE ERRORS ===
-		"service_never_active": {
-			Description:    "A service is defined but never active on any day. This creates unused service definitions.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#calendartxt",
-			AffectedFiles:  []string{"calendar.txt"},
-			AffectedFields: []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
-			Impact:         "Data bloat, potential confusion",
-			ExampleFix:     "Set at least one day to 1: monday=1, or remove unused service",
-		},
-		"unused_service": {
-			Description:    "A service is defined but not used by any trips. This creates orphaned service definitions.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#tripstxt",
-			AffectedFiles:  []string{"calendar.txt", "trips.txt"},
-			AffectedFields: []string{"service_id"},
-			Impact:         "Data bloat, maintenance overhead",
-			ExampleFix:     "Remove unused services or add trips that reference them",
-		},
-		"invalid_currency_code": {
-			Description:    "Currency code is invalid or not recognized. Must be a valid ISO 4217 3-letter currency code.",
-			GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
-			AffectedFiles:  []string{"fare_attributes.txt"},
-			AffectedFields: []string{"currency_type"},
-			Impact:         "Fare calculation errors, currency display issues",
-			ExampleFix:     "Use valid codes: USD, EUR, CAD, GBP, JPY, etc.",
-		},
-		"insufficient_coordinate_precision": {
-			Description:    "Coordinate precision is insufficient (less than 4 decimal places). This affects location accuracy.",
-			GTFSReference:  "https://gtfs.org/schedule/best-practices/#coordinate-precision",
-			AffectedFiles:  []string{"stops.txt", "shapes.txt"},
-			AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
-			Impact:         "Inaccurate location display, navigation errors",
-			ExampleFix:     "Use at least 4 decimal places: 40.7488 instead of 40.75",
-		},
-		"validator_error": {
-			Description: "A validator encountered an error during processing. This may indicate data corruption or validator issues.",
-			Impact:      "Validation may be incomplete, some issues may be missed",
-			ExampleFix:  "Check data file integrity and report issue if problem persists",
-		},
-	}
+	// === FEED INFO ERRORS ===
+	"invalid_feed_language": {
+		Description:    "Feed language code is invalid. Must be a valid 2-letter ISO 639-1 language code.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#feed_infotxt",
+		AffectedFiles:  []string{"feed_info.txt"},
+		AffectedFields: []string{"feed_lang"},
+		Impact:         "Language detection issues in transit apps",
+		ExampleFix:     "Use valid codes: en, es, fr, de, ja, etc.",
+	},
 
-	if desc, exists := descriptions[code]; exists {
+	// === TRIP AND SERVICE ERRORS ===
+	"service_never_active": {
+		Description:    "A service is defined but never active on any day. This creates unused service definitions.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#calendartxt",
+		AffectedFiles:  []string{"calendar.txt"},
+		AffectedFields: []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+		Impact:         "Data bloat, potential confusion",
+		ExampleFix:     "Set at least one day to 1: monday=1, or remove unused service",
+	},
+	"unused_service": {
+		Description:    "A service is defined but not used by any trips. This creates orphaned service definitions.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#tripstxt",
+		AffectedFiles:  []string{"calendar.txt", "trips.txt"},
+		AffectedFields: []string{"service_id"},
+		Impact:         "Data bloat, maintenance overhead",
+		ExampleFix:     "Remove unused services or add trips that reference them",
+	},
+	"invalid_currency_code": {
+		Description:    "Currency code is invalid or not recognized. Must be a valid ISO 4217 3-letter currency code.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#fare_attributestxt",
+		AffectedFiles:  []string{"fare_attributes.txt"},
+		AffectedFields: []string{"currency_type"},
+		Impact:         "Fare calculation errors, currency display issues",
+		ExampleFix:     "Use valid codes: USD, EUR, CAD, GBP, JPY, etc.",
+	},
+	"insufficient_coordinate_precision": {
+		Description:    "Coordinate precision is insufficient (less than 4 decimal places). This affects location accuracy.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#coordinate-precision",
+		AffectedFiles:  []string{"stops.txt", "shapes.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon", "shape_pt_lat", "shape_pt_lon"},
+		Impact:         "Inaccurate location display, navigation errors",
+		ExampleFix:     "Use at least 4 decimal places: 40.7488 instead of 40.75",
+	},
+	// === FEED DIFF NOTICES ===
+	"stop_removed": {
+		Description:    "A stop present in the previous feed version no longer exists in the current feed. Passengers and downstream systems referencing this stop_id will lose it.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#stopstxt",
+		AffectedFiles:  []string{"stops.txt"},
+		AffectedFields: []string{"stop_id"},
+		Impact:         "Saved favourites, real-time feeds and fare systems keyed by stop_id may break",
+		ExampleFix:     "Confirm the stop was intentionally closed. If it was only renumbered, keep the original stop_id.",
+	},
+	"stop_id_renamed": {
+		Description:    "A stop appears to have been given a new stop_id: a stop with the same name and location exists under a different ID than in the previous feed.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#stopstxt",
+		AffectedFiles:  []string{"stops.txt"},
+		AffectedFields: []string{"stop_id"},
+		Impact:         "Consumers treat the stop as removed and re-added, breaking persistent references",
+		ExampleFix:     "Keep stop_id stable between feed versions; IDs should only change when the stop itself changes",
+	},
+	"route_removed": {
+		Description:    "A route present in the previous feed version no longer exists in the current feed.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_id"},
+		Impact:         "Passengers lose the route in trip planners; alerts and real-time data for it stop matching",
+		ExampleFix:     "Confirm the route was intentionally discontinued. If it was only renumbered, keep the original route_id.",
+	},
+	"route_id_renamed": {
+		Description:    "A route appears to have been given a new route_id: a route with the same agency, names and type exists under a different ID than in the previous feed.",
+		GTFSReference:  "https://gtfs.org/schedule/best-practices/#routestxt",
+		AffectedFiles:  []string{"routes.txt"},
+		AffectedFields: []string{"route_id"},
+		Impact:         "Consumers treat the route as removed and re-added, breaking persistent references",
+		ExampleFix:     "Keep route_id stable between feed versions",
+	},
+	"trip_count_changed": {
+		Description:    "The number of trips a route operates on upcoming service dates changed significantly compared with the previous feed version.",
+		AffectedFiles:  []string{"trips.txt", "calendar.txt", "calendar_dates.txt"},
+		AffectedFields: []string{"route_id", "service_id"},
+		Impact:         "May indicate an accidental loss of service or an incomplete export",
+		ExampleFix:     "Verify the schedule change is intended; otherwise check the export for missing trips or calendars",
+	},
+	"stop_moved": {
+		Description:    "A stop's coordinates moved further than the configured threshold compared with the previous feed version.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#stopstxt",
+		AffectedFiles:  []string{"stops.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon"},
+		Impact:         "Passengers may be directed to the wrong location if the move is a data error",
+		ExampleFix:     "Verify the new coordinates are correct; check for swapped latitude/longitude or lost precision",
+	},
+	"service_dates_removed": {
+		Description:    "Future service dates that had service in the previous feed version no longer have any service in the current feed.",
+		AffectedFiles:  []string{"calendar.txt", "calendar_dates.txt"},
+		AffectedFields: []string{"start_date", "end_date", "date"},
+		Impact:         "Trip planners will show no service on these dates",
+		ExampleFix:     "Extend calendar end dates or restore calendar_dates entries if the service gap is unintended",
+	},
+	"shape_changed_significantly": {
+		Description:    "A shape's geometry deviates from the previous feed version by more than the configured threshold.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#shapestxt",
+		AffectedFiles:  []string{"shapes.txt"},
+		AffectedFields: []string{"shape_pt_lat", "shape_pt_lon"},
+		Impact:         "Informational: route maps will change for passengers",
+		ExampleFix:     "No action needed if the re-routing is intended",
+	},
+
+	// === ARCHIVE LAYOUT NOTICES ===
+	"archive_file_in_subfolder": {
+		Description:   "A GTFS file is stored in a subfolder of the ZIP archive instead of at its root. This usually happens when the feed folder itself is zipped.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		Impact:        "Most consumers only read files at the archive root and will reject the feed as missing required files",
+		ExampleFix:    "Zip the files themselves rather than their folder, e.g. run 'zip ../feed.zip *.txt' from inside the feed folder",
+	},
+	"archive_duplicate_file_name": {
+		Description:   "Several entries of the ZIP archive have the same GTFS file name in different folders. Only one of them is used for validation.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		Impact:        "Consumers may read a different copy than the one validated",
+		ExampleFix:    "Keep a single copy of each file at the root of the archive",
+	},
+	"archive_nested_zip": {
+		Description:   "The feed archive contains another ZIP file. Nested archives are not read by GTFS consumers.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		Impact:        "Files inside the nested archive are ignored",
+		ExampleFix:    "Extract the nested archive and add its files to the root of the feed archive, or remove it",
+	},
+	"archive_os_metadata": {
+		Description:   "The archive contains operating system metadata such as __MACOSX/ folders, ._ resource forks or .DS_Store files.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#dataset-files",
+		Impact:        "Some consumers reject archives with unexpected entries; the metadata also leaks local file information",
+		ExampleFix:    "Create the archive with 'zip -X' or remove the entries with 'zip -d feed.zip \"__MACOSX/*\" \"*.DS_Store\"'",
+	},
+	"archive_non_utf8_file_name": {
+		Description:   "An entry of the ZIP archive has a name that is not valid UTF-8, so it cannot be matched to a GTFS file name.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "The entry is ignored and may be unreadable on other systems",
+		ExampleFix:    "Rename the file using ASCII characters and recreate the archive",
+	},
+	"archive_limit_exceeded": {
+		Description:   "The ZIP archive exceeds a configured limit (archive size, number of entries, file size, total uncompressed size or compression ratio). Validation stopped to protect the server; the archive may be a zip bomb.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "The feed was not fully validated",
+		ExampleFix:    "Check the archive for unexpected or corrupted entries. If the feed is legitimately this large, raise the limit with WithArchiveLimits",
+	},
+	"non_utf8_encoding": {
+		Description:   "The file is not encoded as UTF-8. It appears to use a legacy encoding such as Windows-1252 or ISO-8859-1, or UTF-16 without a byte order mark.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Accented and special characters in names are garbled by consumers reading the file as UTF-8",
+		ExampleFix:    "Re-export the file as UTF-8, e.g. 'iconv -f WINDOWS-1252 -t UTF-8 stops.txt > stops_utf8.txt'",
+	},
+	"utf16_byte_order_mark": {
+		Description:   "The file starts with a UTF-16 byte order mark. GTFS files must be encoded as UTF-8.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Most consumers cannot read UTF-16 files and reject the whole file",
+		ExampleFix:    "Save the file as 'CSV UTF-8' instead of 'Unicode Text', or convert it with 'iconv -f UTF-16 -t UTF-8'",
+	},
+	"invalid_utf8_sequence": {
+		Description:   "A field contains bytes that are not a valid UTF-8 sequence. The file is otherwise UTF-8, so the value was probably corrupted or pasted from another encoding.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "The value is shown with replacement characters or rejected by consumers",
+		ExampleFix:    "Retype the affected value or fix the tool that produced it",
+	},
+	"csv_parsing_failed": {
+		Description:   "A row could not be parsed, usually because of a stray quote opening a field that is never closed. The row was skipped and parsing resumed at the next line.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "The skipped row is missing from validation; many consumers reject the file or lose every row after it",
+		ExampleFix:    "Remove the stray quote or enclose the whole field in quotes, doubling any quote inside it",
+	},
+	"csv_bare_quote": {
+		Description:   "A quote character appears inside a field that is not enclosed in quotes. RFC 4180 only allows quotes in quoted fields, where they must be doubled.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Strict CSV parsers reject the row or the whole file",
+		ExampleFix:    "Enclose the field in quotes and double the quote, e.g. \"Platform 5\"\"\" instead of Platform 5\"",
+	},
+	"csv_invalid_quote": {
+		Description:   "A quoted field is closed by a quote that is not followed by a comma or a line break, e.g. \"Main\" St.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Consumers split or truncate the value differently",
+		ExampleFix:    "Enclose the whole value in quotes and double the inner quotes: \"\"\"Main\"\" St\"",
+	},
+	"csv_unterminated_quote": {
+		Description:   "A field starts with a quote that is never closed. Lenient parsers read the following lines as part of the field.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Consumers may lose every row after this one",
+		ExampleFix:    "Add the missing closing quote or remove the stray opening quote",
+	},
+	"csv_nul_byte": {
+		Description:   "The file contains a NUL byte (0x00), which is not valid in a text file.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Many parsers stop reading at the NUL byte or reject the file",
+		ExampleFix:    "Remove the NUL bytes; they often come from UTF-16 exports or corrupted copies",
+	},
+	"csv_mixed_line_endings": {
+		Description:   "The file uses both CRLF (Windows) and LF (Unix) line breaks, usually because it was concatenated from several sources.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "Some parsers keep a stray carriage return at the end of the last field",
+		ExampleFix:    "Normalize the line breaks, e.g. with 'dos2unix' or 'unix2dos'",
+	},
+	"csv_missing_trailing_newline": {
+		Description:   "The last line of the file has no line break. RFC 4180 allows this, but some tools drop or merge the last row.",
+		GTFSReference: "https://gtfs.org/schedule/reference/#file-requirements",
+		Impact:        "The last row may be lost when files are concatenated or processed line by line",
+		ExampleFix:    "End the file with a line break",
+	},
+	"cross_feed_id_collision": {
+		Description:    "An agency_id, stop_id, route_id or fare_id is used by more than one of the feeds validated together, so the feeds cannot be merged without renaming IDs.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#field-types",
+		AffectedFiles:  []string{"agency.txt", "stops.txt", "routes.txt", "fare_attributes.txt"},
+		AffectedFields: []string{"agency_id", "stop_id", "route_id", "fare_id"},
+		Impact:         "Merging the feeds silently overwrites or mixes up entities, breaking trips, stops and fares of one operator",
+		ExampleFix:     "Prefix the IDs with an operator code (e.g. 'metro:S1') in one of the feeds, or namespace them when merging",
+	},
+	"cross_feed_nearby_stops": {
+		Description:    "Stops or stations of different feeds are within a few meters of each other, so they are probably the same place served by several operators.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#transferstxt",
+		AffectedFiles:  []string{"stops.txt", "transfers.txt"},
+		AffectedFields: []string{"stop_lat", "stop_lon"},
+		Impact:         "Journey planners using the merged feed will not offer transfers between the operators at this location",
+		ExampleFix:     "Add transfers between the stops in the merged feed, or group them under a common parent station",
+	},
+	"cross_feed_inconsistent_timezone": {
+		Description:    "Feeds serving the same area declare different agency_timezone values.",
+		GTFSReference:  "https://gtfs.org/schedule/reference/#agencytxt",
+		AffectedFiles:  []string{"agency.txt"},
+		AffectedFields: []string{"agency_timezone"},
+		Impact:         "Departure times of the merged feed are interpreted in different timezones, shifting connections between operators",
+		ExampleFix:     "Use the same IANA timezone (e.g. 'Europe/Berlin') in every feed of the region",
+	},
+	"validator_error": {
+		Description: "A validator encountered an error during processing. This may indicate data corruption or validator issues.",
+		Impact:      "Validation may be incomplete, some issues may be missed",
+		ExampleFix:  "Check data file integrity and report issue if problem persists",
+	},
+}
+
+// GetEnhancedNoticeDescription returns detailed notice information including GTFS references
+func GetEnhancedNoticeDescription(code string) NoticeDescription {
+	if desc, exists := noticeDescriptions[code]; exists {
 		return desc
 	}
 
 	// Generate a user-friendly description from the code name for unknown codes
+	return NoticeDescription{
+		Description: fmt.Sprintf(genericNoticeDescription, noticeCodeTitle(code)),
+		Impact:      genericNoticeImpact,
+	}
+}
+
+// noticeCodeTitle turns a notice code into a title, e.g. "Unused Shape"
+func noticeCodeTitle(code string) string {
 	words := strings.Split(code, "_")
 	caser := cases.Title(language.English)
 	for i, word := range words {
 		words[i] = caser.String(word)
 	}
-	return NoticeDescription{
-		Description: strings.Join(words, " ") + ". This validation check identified an issue that should be reviewed and corrected.",
-		Impact:      "Data quality issue that should be reviewed",
-	}
+	return strings.Join(words, " ")
 }
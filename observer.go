@@ -0,0 +1,260 @@
+package gtfsvalidator
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+)
+
+// Observer receives validation lifecycle events, e.g. to export traces and
+// metrics. The methods are called on the goroutines doing the work, from
+// several goroutines at once when ParallelWorkers > 1, so they must be safe
+// for concurrent use and return quickly. Embed NopObserver to implement only
+// some of them.
+type Observer interface {
+	// FeedLoadStarted is called before a feed is opened.
+	FeedLoadStarted(event FeedLoadStartEvent)
+
+	// FeedLoadFinished is called after a feed was opened or failed to open.
+	FeedLoadFinished(event FeedLoadEndEvent)
+
+	// ValidatorStarted is called before a validator runs.
+	ValidatorStarted(event ValidatorStartEvent)
+
+	// ValidatorFinished is called after a validator ran, also when it panicked.
+	ValidatorFinished(event ValidatorEndEvent)
+
+	// ValidatorPanicked is called when a validator panics, before ValidatorFinished.
+	ValidatorPanicked(event ValidatorPanicEvent)
+
+	// CacheAccessed is called on each access to the parsed feed cache, see WithCaching.
+	CacheAccessed(event CacheAccessEvent)
+}
+
+// FeedLoadStartEvent describes a feed about to be opened.
+type FeedLoadStartEvent struct {
+	// FeedPath is the ZIP file or directory, empty for feeds held in memory.
+	FeedPath string
+}
+
+// FeedLoadEndEvent describes an opened feed.
+type FeedLoadEndEvent struct {
+	// FeedPath is the ZIP file or directory, empty for feeds held in memory.
+	FeedPath string
+
+	// Files lists the GTFS files of the feed sorted by name.
+	Files []FeedFile
+
+	// Duration is the time spent opening the feed.
+	Duration time.Duration
+
+	// Err is the error that prevented the feed from being opened, if any.
+	Err error
+}
+
+// FeedFile is a file of a loaded feed.
+type FeedFile struct {
+	// Name is the GTFS file name, e.g. "stops.txt".
+	Name string
+
+	// Size is the file size in bytes, uncompressed for ZIP archives (-1 = unknown).
+	Size int64
+}
+
+// ValidatorStartEvent describes a validator about to run.
+type ValidatorStartEvent struct {
+	// Validator is the validator type name.
+	Validator string
+}
+
+// ValidatorEndEvent describes a finished validator run.
+type ValidatorEndEvent struct {
+	// Validator is the validator type name.
+	Validator string
+
+	// Duration is the time the validator ran.
+	Duration time.Duration
+
+	// Notices is the number of notices of the validator kept in the report,
+	// after severity overrides, disabled rules and notice limits.
+	Notices int
+
+	// Panicked is true if the validator panicked.
+	Panicked bool
+}
+
+// ValidatorPanicEvent describes a validator panic. Validation continues with
+// the other validators and the panic is reported as a validator_error notice.
+type ValidatorPanicEvent struct {
+	// Validator is the validator type name.
+	Validator string
+
+	// Value is the value passed to panic.
+	Value interface{}
+
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+// CacheAccessEvent describes an access to the parsed feed cache.
+type CacheAccessEvent struct {
+	// Dataset is the cached data: a file name such as "stop_times.txt" or
+	// an index such as "stop_times_by_trip".
+	Dataset string
+
+	// Hit is false when the data had to be loaded or built.
+	Hit bool
+}
+
+// NopObserver ignores all events. Embed it in observers that only handle some events.
+type NopObserver struct{}
+
+// FeedLoadStarted implements Observer.
+func (NopObserver) FeedLoadStarted(FeedLoadStartEvent) {}
+
+// FeedLoadFinished implements Observer.
+func (NopObserver) FeedLoadFinished(FeedLoadEndEvent) {}
+
+// ValidatorStarted implements Observer.
+func (NopObserver) ValidatorStarted(ValidatorStartEvent) {}
+
+// ValidatorFinished implements Observer.
+func (NopObserver) ValidatorFinished(ValidatorEndEvent) {}
+
+// ValidatorPanicked implements Observer.
+func (NopObserver) ValidatorPanicked(ValidatorPanicEvent) {}
+
+// CacheAccessed implements Observer.
+func (NopObserver) CacheAccessed(CacheAccessEvent) {}
+
+// loggingObserver logs events with a logging.Logger
+type loggingObserver struct {
+	logger logging.Logger
+}
+
+// NewLoggingObserver returns an Observer that logs events with logger: loaded
+// feeds at INFO, validator runs and cache accesses at DEBUG, and load
+// failures and validator panics at ERROR.
+func NewLoggingObserver(logger logging.Logger) Observer {
+	return &loggingObserver{logger: logger}
+}
+
+// FeedLoadStarted implements Observer.
+func (o *loggingObserver) FeedLoadStarted(event FeedLoadStartEvent) {
+	o.logger.Debug("Loading feed", logging.String("feed", event.FeedPath))
+}
+
+// FeedLoadFinished implements Observer.
+func (o *loggingObserver) FeedLoadFinished(event FeedLoadEndEvent) {
+	if event.Err != nil {
+		o.logger.Error("Failed to load feed",
+			logging.String("feed", event.FeedPath),
+			logging.Duration("duration", event.Duration),
+			logging.ErrorField("error", event.Err))
+		return
+	}
+
+	var totalSize int64
+	for _, file := range event.Files {
+		if file.Size > 0 {
+			totalSize += file.Size
+		}
+	}
+	o.logger.Info("Loaded feed",
+		logging.String("feed", event.FeedPath),
+		logging.Int("files", len(event.Files)),
+		logging.Int64("bytes", totalSize),
+		logging.Duration("duration", event.Duration))
+}
+
+// ValidatorStarted implements Observer.
+func (o *loggingObserver) ValidatorStarted(event ValidatorStartEvent) {
+	o.logger.Debug("Validator started", logging.String("validator", event.Validator))
+}
+
+// ValidatorFinished implements Observer.
+func (o *loggingObserver) ValidatorFinished(event ValidatorEndEvent) {
+	o.logger.Debug("Validator finished",
+		logging.String("validator", event.Validator),
+		logging.Duration("duration", event.Duration),
+		logging.Int("notices", event.Notices),
+		logging.Bool("panicked", event.Panicked))
+}
+
+// ValidatorPanicked implements Observer.
+func (o *loggingObserver) ValidatorPanicked(event ValidatorPanicEvent) {
+	o.logger.Error("Validator panicked",
+		logging.String("validator", event.Validator),
+		logging.Field{Key: "panic", Value: event.Value},
+		logging.String("stack", string(event.Stack)))
+}
+
+// CacheAccessed implements Observer.
+func (o *loggingObserver) CacheAccessed(event CacheAccessEvent) {
+	o.logger.Debug("Cache access",
+		logging.String("dataset", event.Dataset),
+		logging.Bool("hit", event.Hit))
+}
+
+// loadFeed opens a feed with load, sets the configured logger on the loader
+// and reports the load to the observer, if any.
+func (c Config) loadFeed(feedPath string, load func() (*parser.FeedLoader, error)) (*parser.FeedLoader, error) {
+	if c.Observer == nil {
+		loader, err := load()
+		if loader != nil && c.Logger != nil {
+			loader.SetLogger(c.Logger)
+		}
+		return loader, err
+	}
+
+	c.Observer.FeedLoadStarted(FeedLoadStartEvent{FeedPath: feedPath})
+	start := time.Now()
+	loader, err := load()
+	event := FeedLoadEndEvent{FeedPath: feedPath, Duration: time.Since(start), Err: err}
+	if loader != nil {
+		if c.Logger != nil {
+			loader.SetLogger(c.Logger)
+		}
+		event.Files = feedFiles(loader)
+	}
+	c.Observer.FeedLoadFinished(event)
+	return loader, err
+}
+
+// logger returns the configured logger, or a logger writing warnings to stderr.
+func (c Config) logger() logging.Logger {
+	if c.Logger == nil {
+		return logging.NewStderrLogger()
+	}
+	return c.Logger
+}
+
+// feedFiles lists the files of a feed with their sizes.
+func feedFiles(loader *parser.FeedLoader) []FeedFile {
+	names := loader.ListFiles()
+	sort.Strings(names)
+
+	files := make([]FeedFile, 0, len(names))
+	for _, name := range names {
+		size, ok := loader.FileSize(name)
+		if !ok {
+			size = -1
+		}
+		files = append(files, FeedFile{Name: name, Size: size})
+	}
+	return files
+}
+
+// observeCache reports the cache accesses of a loader to the observer, if any.
+func (c Config) observeCache(loader *parser.FeedLoader) {
+	cache := loader.GetCache()
+	if c.Observer == nil || cache == nil {
+		return
+	}
+	observer := c.Observer
+	cache.SetAccessRecorder(func(dataset string, hit bool) {
+		observer.CacheAccessed(CacheAccessEvent{Dataset: dataset, Hit: hit})
+	})
+}
@@ -0,0 +1,140 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+// recordingObserver records the events it receives
+type recordingObserver struct {
+	mu          sync.Mutex
+	loadStarts  []FeedLoadStartEvent
+	loadEnds    []FeedLoadEndEvent
+	starts      []ValidatorStartEvent
+	ends        []ValidatorEndEvent
+	panics      []ValidatorPanicEvent
+	cacheEvents []CacheAccessEvent
+}
+
+func (o *recordingObserver) FeedLoadStarted(event FeedLoadStartEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.loadStarts = append(o.loadStarts, event)
+}
+
+func (o *recordingObserver) FeedLoadFinished(event FeedLoadEndEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.loadEnds = append(o.loadEnds, event)
+}
+
+func (o *recordingObserver) ValidatorStarted(event ValidatorStartEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.starts = append(o.starts, event)
+}
+
+func (o *recordingObserver) ValidatorFinished(event ValidatorEndEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ends = append(o.ends, event)
+}
+
+func (o *recordingObserver) ValidatorPanicked(event ValidatorPanicEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.panics = append(o.panics, event)
+}
+
+func (o *recordingObserver) CacheAccessed(event CacheAccessEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.cacheEvents = append(o.cacheEvents, event)
+}
+
+func TestObserver(t *testing.T) {
+	zipPath := CreateTempZip(t, InvalidGTFS())
+
+	for _, workers := range []int{1, 4} {
+		observer := &recordingObserver{}
+		validator := New(WithObserver(observer), WithCaching(true), WithParallelWorkers(workers))
+
+		report, err := validator.ValidateFile(zipPath)
+		if err != nil {
+			t.Fatalf("Validation failed: %v", err)
+		}
+
+		if len(observer.loadStarts) != 1 || len(observer.loadEnds) != 1 {
+			t.Fatalf("Expected one feed load, got %d starts and %d ends", len(observer.loadStarts), len(observer.loadEnds))
+		}
+		load := observer.loadEnds[0]
+		if load.FeedPath != zipPath || load.Err != nil || len(load.Files) == 0 {
+			t.Errorf("Unexpected feed load event: %+v", load)
+		}
+		for _, file := range load.Files {
+			if file.Size <= 0 {
+				t.Errorf("Expected the size of %s, got %d", file.Name, file.Size)
+			}
+		}
+
+		if len(observer.starts) == 0 || len(observer.starts) != len(observer.ends) {
+			t.Fatalf("Expected matching validator events, got %d starts and %d ends", len(observer.starts), len(observer.ends))
+		}
+		notices := 0
+		for _, end := range observer.ends {
+			notices += end.Notices
+		}
+		// Required file notices are added before the validators run
+		if notices == 0 || notices > report.Summary.Counts.Total {
+			t.Errorf("Expected validator notices within the %d reported, got %d", report.Summary.Counts.Total, notices)
+		}
+
+		if len(observer.cacheEvents) == 0 {
+			t.Error("Expected cache access events")
+		}
+	}
+}
+
+func TestObserver_ValidatorPanic(t *testing.T) {
+	observer := &recordingObserver{}
+	config := Config{Observer: observer}
+	v := newInternalValidator(config, defaultValidationConfig())
+
+	v.runValidator(&recordingObserver{}, v.noticeContainer, func(container *notice.NoticeContainer) {
+		container.AddNotice(notice.NewBaseNotice("before_panic", notice.WARNING, nil))
+		panic("boom")
+	})
+
+	if len(observer.panics) != 1 || observer.panics[0].Value != "boom" || len(observer.panics[0].Stack) == 0 {
+		t.Fatalf("Expected a panic event with a stack, got %+v", observer.panics)
+	}
+	if len(observer.ends) != 1 || !observer.ends[0].Panicked || observer.ends[0].Notices != 2 {
+		t.Errorf("Expected a panicked run with 2 notices, got %+v", observer.ends)
+	}
+	if len(v.noticeContainer.GetNoticesByCode("validator_error")) != 1 {
+		t.Error("Expected the panic as a validator_error notice")
+	}
+}
+
+func TestNewLoggingObserver(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logging.NewLoggerWithWriter(&buf)
+	logger.SetLevel(logging.DEBUG)
+
+	report, err := New(WithObserver(NewLoggingObserver(logger))).ValidateFile(CreateTempZip(t, MinimalValidGTFS()))
+	if err != nil || report == nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"Loading feed", "Loaded feed", "Validator started", "Validator finished"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in the log, got:\n%s", expected, output)
+		}
+	}
+}
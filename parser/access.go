@@ -0,0 +1,33 @@
+package parser
+
+import "sync"
+
+// AllFiles is recorded when the list of feed files is requested, so the caller
+// depends on which files exist rather than on a particular file
+const AllFiles = "*"
+
+// accessState holds the access recorder of a FeedLoader
+type accessState struct {
+	accessMu sync.RWMutex
+	recorder func(filename string)
+}
+
+// SetAccessRecorder registers a function called with the name of each file that
+// is opened or looked up through the loader, and with AllFiles when the file list
+// is requested. It is used to learn which files a validator depends on. The
+// function may be called from several goroutines. Pass nil to stop recording.
+func (l *FeedLoader) SetAccessRecorder(recorder func(filename string)) {
+	l.accessMu.Lock()
+	l.recorder = recorder
+	l.accessMu.Unlock()
+}
+
+// recordAccess reports a file access to the registered recorder, if any
+func (l *FeedLoader) recordAccess(filename string) {
+	l.accessMu.RLock()
+	recorder := l.recorder
+	l.accessMu.RUnlock()
+	if recorder != nil {
+		recorder(filename)
+	}
+}
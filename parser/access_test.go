@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFeedLoader_SetAccessRecorder(t *testing.T) {
+	fsys := fstest.MapFS{
+		"agency.txt": {Data: []byte("agency_id,agency_name\ntest_agency,Test Agency")},
+		"stops.txt":  {Data: []byte("stop_id\nS1")},
+	}
+	loader, err := LoadFromFS(fsys)
+	if err != nil {
+		t.Fatalf("Failed to load from fs: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		access   func()
+		expected []string
+	}{
+		{
+			name: "opened file",
+			access: func() {
+				if reader, err := loader.GetFile("agency.txt"); err == nil {
+					_ = reader.Close()
+				}
+			},
+			expected: []string{"agency.txt"},
+		},
+		{
+			name:     "missing file lookup",
+			access:   func() { loader.HasFile("feed_info.txt") },
+			expected: []string{"feed_info.txt"},
+		},
+		{
+			name:     "file list",
+			access:   func() { loader.ListFiles() },
+			expected: []string{AllFiles},
+		},
+		{
+			name: "raw file and lookup",
+			access: func() {
+				loader.HasFile("stops.txt")
+				if reader, err := loader.GetRawFile("stops.txt"); err == nil {
+					_ = reader.Close()
+				}
+			},
+			expected: []string{"stops.txt", "stops.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded []string
+			loader.SetAccessRecorder(func(filename string) {
+				recorded = append(recorded, filename)
+			})
+			tt.access()
+			loader.SetAccessRecorder(nil)
+			tt.access()
+
+			sort.Strings(recorded)
+			if !reflect.DeepEqual(recorded, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, recorded)
+			}
+		})
+	}
+}
+
+func TestParsedFeedCache_SetAccessRecorder(t *testing.T) {
+	fsys := fstest.MapFS{
+		"trips.txt": {Data: []byte("route_id,service_id,trip_id\nR1,S1,T1\nR1,S1,T2")},
+	}
+	loader, err := LoadFromFS(fsys)
+	if err != nil {
+		t.Fatalf("Failed to load from fs: %v", err)
+	}
+	loader.EnableCaching()
+	cache := loader.GetCache()
+
+	var recorded []string
+	cache.SetAccessRecorder(func(dataset string, hit bool) {
+		if hit {
+			dataset += " hit"
+		}
+		recorded = append(recorded, dataset)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.GetTrips(); err != nil {
+			t.Fatalf("GetTrips failed: %v", err)
+		}
+		if _, err := cache.GetTripsByRoute(); err != nil {
+			t.Fatalf("GetTripsByRoute failed: %v", err)
+		}
+	}
+	cache.GetTripByID("T1")
+
+	expected := []string{"trips.txt", "trips_by_route", "trips.txt hit", "trips_by_route hit"}
+	if !reflect.DeepEqual(recorded, expected) {
+		t.Errorf("Expected %v, got %v", expected, recorded)
+	}
+}
+
+func TestFeedLoader_FileSize(t *testing.T) {
+	fsys := fstest.MapFS{
+		"agency.txt": {Data: []byte("agency_id,agency_name\ntest_agency,Test Agency")},
+	}
+	loader, err := LoadFromFS(fsys)
+	if err != nil {
+		t.Fatalf("Failed to load from fs: %v", err)
+	}
+
+	if size, ok := loader.FileSize("agency.txt"); !ok || size != int64(len(fsys["agency.txt"].Data)) {
+		t.Errorf("Expected the size of agency.txt, got %d (%v)", size, ok)
+	}
+	if _, ok := loader.FileSize("stops.txt"); ok {
+		t.Error("Expected no size for a missing file")
+	}
+}
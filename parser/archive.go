@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"archive/zip"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ArchiveOptions controls how GTFS files are picked from a ZIP archive
+type ArchiveOptions struct {
+	// IgnoreSubfolderFiles excludes feed files that are not at the archive root.
+	// By default they are still used for validation (and reported as layout issues).
+	IgnoreSubfolderFiles bool
+
+	// Limits bounds the archive size and decompressed data. Zero values disable a limit.
+	Limits ArchiveLimits
+}
+
+// ArchiveIssueType identifies a ZIP layout problem
+type ArchiveIssueType string
+
+const (
+	// ArchiveIssueSubfolder is a feed file stored in a subfolder with no root-level counterpart
+	ArchiveIssueSubfolder ArchiveIssueType = "subfolder"
+	// ArchiveIssueDuplicateName is a feed file name present in several folders
+	ArchiveIssueDuplicateName ArchiveIssueType = "duplicate_name"
+	// ArchiveIssueNestedArchive is a ZIP file stored inside the feed archive
+	ArchiveIssueNestedArchive ArchiveIssueType = "nested_archive"
+	// ArchiveIssueOSMetadata is an operating system metadata entry such as __MACOSX/ or .DS_Store
+	ArchiveIssueOSMetadata ArchiveIssueType = "os_metadata"
+	// ArchiveIssueNonUTF8Name is an entry whose name is not valid UTF-8
+	ArchiveIssueNonUTF8Name ArchiveIssueType = "non_utf8_name"
+)
+
+// ArchiveEntry describes a file entry of a ZIP archive
+type ArchiveEntry struct {
+	// Path is the full entry name inside the archive
+	Path string
+	// Size is the uncompressed size in bytes
+	Size uint64
+	// Used is true if the entry is the file used for its GTFS file name
+	Used bool
+}
+
+// ArchiveIssue is a layout problem found while loading a ZIP archive
+type ArchiveIssue struct {
+	Type ArchiveIssueType
+	// Path is the entry the issue is about
+	Path string
+	// Filename is the GTFS file name (base name) of the entry, if any
+	Filename string
+	// IgnoredPaths lists the entries not used because of a duplicate name
+	IgnoredPaths []string
+}
+
+// ArchiveEntries returns the file entries of the loaded ZIP archive in archive order.
+// It is empty for feeds not loaded from a ZIP archive.
+func (l *FeedLoader) ArchiveEntries() []ArchiveEntry {
+	return l.archiveEntries
+}
+
+// ArchiveIssues returns the layout problems found in the loaded ZIP archive
+func (l *FeedLoader) ArchiveIssues() []ArchiveIssue {
+	return l.archiveIssues
+}
+
+// mapArchiveFiles records the archive structure and picks the entry used for
+// each GTFS file. Root-level files take precedence over files in subfolders,
+// and among subfolder files the first one in archive order wins.
+func (l *FeedLoader) mapArchiveFiles(files []*zip.File, options ArchiveOptions) {
+	candidates := make(map[string][]int)
+	var names []string
+	var entryFiles []*zip.File // aligned with l.archiveEntries
+
+	for _, file := range files {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		name := file.Name
+		l.archiveEntries = append(l.archiveEntries, ArchiveEntry{Path: name, Size: file.UncompressedSize64})
+		entryFiles = append(entryFiles, file)
+		index := len(l.archiveEntries) - 1
+
+		if !utf8.ValidString(name) {
+			l.archiveIssues = append(l.archiveIssues, ArchiveIssue{Type: ArchiveIssueNonUTF8Name, Path: strings.ToValidUTF8(name, "?")})
+			continue
+		}
+
+		// ZIP entry names always use forward slashes
+		base := path.Base(name)
+		if isOSMetadata(name, base) {
+			l.archiveIssues = append(l.archiveIssues, ArchiveIssue{Type: ArchiveIssueOSMetadata, Path: name})
+			continue
+		}
+		if strings.HasSuffix(strings.ToLower(base), ".zip") {
+			l.archiveIssues = append(l.archiveIssues, ArchiveIssue{Type: ArchiveIssueNestedArchive, Path: name})
+			continue
+		}
+
+		if !isFeedFile(base) {
+			continue
+		}
+		if _, exists := candidates[base]; !exists {
+			names = append(names, base)
+		}
+		candidates[base] = append(candidates[base], index)
+	}
+
+	for _, base := range names {
+		indexes := candidates[base]
+
+		// Prefer the root-level file, otherwise the first one
+		chosen := -1
+		for _, index := range indexes {
+			if !strings.Contains(l.archiveEntries[index].Path, "/") {
+				chosen = index
+				break
+			}
+		}
+		if chosen == -1 {
+			chosen = indexes[0]
+			l.archiveIssues = append(l.archiveIssues, ArchiveIssue{Type: ArchiveIssueSubfolder, Path: l.archiveEntries[chosen].Path, Filename: base})
+			if options.IgnoreSubfolderFiles {
+				continue
+			}
+		}
+
+		if len(indexes) > 1 {
+			issue := ArchiveIssue{Type: ArchiveIssueDuplicateName, Path: l.archiveEntries[chosen].Path, Filename: base}
+			for _, index := range indexes {
+				if index != chosen {
+					issue.IgnoredPaths = append(issue.IgnoredPaths, l.archiveEntries[index].Path)
+				}
+			}
+			sort.Strings(issue.IgnoredPaths)
+			l.archiveIssues = append(l.archiveIssues, issue)
+		}
+
+		l.archiveEntries[chosen].Used = true
+		l.zipFiles[base] = entryFiles[chosen]
+	}
+}
+
+// isOSMetadata reports whether an entry is operating system metadata added by archivers
+func isOSMetadata(name, base string) bool {
+	return strings.HasPrefix(name, "__MACOSX/") || strings.Contains(name, "/__MACOSX/") ||
+		strings.HasPrefix(base, "._") || base == ".DS_Store" || base == "Thumbs.db" || base == "desktop.ini"
+}
@@ -0,0 +1,209 @@
+package parser
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ErrArchiveLimitExceeded is returned (wrapped in an *ArchiveLimitError) when
+// a ZIP archive exceeds one of the configured ArchiveLimits
+var ErrArchiveLimitExceeded = errors.New("archive limit exceeded")
+
+// Archive limit names used in ArchiveLimitError.Limit
+const (
+	LimitArchiveSize      = "archive_size"
+	LimitEntries          = "entries"
+	LimitFileSize         = "file_size"
+	LimitUncompressedSize = "uncompressed_size"
+	LimitCompressionRatio = "compression_ratio"
+)
+
+// minRatioCheckSize is the uncompressed size below which the compression ratio
+// is not checked, as small files of repeated values compress very well
+const minRatioCheckSize = 1 << 20
+
+// ArchiveLimits bounds the resources a ZIP archive may use. Zero values disable a limit.
+type ArchiveLimits struct {
+	// MaxArchiveSize is the maximum compressed archive size in bytes
+	MaxArchiveSize int64
+	// MaxEntries is the maximum number of entries, including folders
+	MaxEntries int
+	// MaxFileSize is the maximum uncompressed size of a single entry in bytes
+	MaxFileSize int64
+	// MaxUncompressedSize is the maximum uncompressed size of all entries in bytes
+	MaxUncompressedSize int64
+	// MaxCompressionRatio is the maximum uncompressed/compressed ratio of an entry
+	MaxCompressionRatio float64
+}
+
+// DefaultArchiveLimits returns limits that accept very large real-world feeds
+// while rejecting zip bombs
+func DefaultArchiveLimits() ArchiveLimits {
+	return ArchiveLimits{
+		MaxArchiveSize:      1 << 30, // 1 GiB
+		MaxEntries:          1000,
+		MaxFileSize:         4 << 30, // 4 GiB
+		MaxUncompressedSize: 8 << 30, // 8 GiB
+		MaxCompressionRatio: 1000,
+	}
+}
+
+// IsZero reports whether no limit is set
+func (l ArchiveLimits) IsZero() bool {
+	return l == ArchiveLimits{}
+}
+
+// ArchiveLimitError describes which archive limit was exceeded
+type ArchiveLimitError struct {
+	// Limit is the exceeded limit, e.g. LimitFileSize
+	Limit string
+	// Path is the archive entry that exceeded the limit, if any
+	Path string
+	// Actual is the observed value and Max the configured limit
+	Actual int64
+	Max    int64
+}
+
+func (e *ArchiveLimitError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("%v: %s %d exceeds %d (%s)", ErrArchiveLimitExceeded, e.Limit, e.Actual, e.Max, e.Path)
+	}
+	return fmt.Sprintf("%v: %s %d exceeds %d", ErrArchiveLimitExceeded, e.Limit, e.Actual, e.Max)
+}
+
+// Is makes errors.Is(err, ErrArchiveLimitExceeded) match
+func (e *ArchiveLimitError) Is(target error) bool {
+	return target == ErrArchiveLimitExceeded
+}
+
+// CheckArchiveSize returns an *ArchiveLimitError if size exceeds MaxArchiveSize
+func (l ArchiveLimits) CheckArchiveSize(size int64) error {
+	if l.MaxArchiveSize > 0 && size > l.MaxArchiveSize {
+		return &ArchiveLimitError{Limit: LimitArchiveSize, Actual: size, Max: l.MaxArchiveSize}
+	}
+	return nil
+}
+
+// checkRatio returns an error if an entry expands more than MaxCompressionRatio
+func (l ArchiveLimits) checkRatio(path string, uncompressed, compressed uint64) *ArchiveLimitError {
+	if l.MaxCompressionRatio <= 0 || uncompressed < minRatioCheckSize {
+		return nil
+	}
+	if compressed == 0 {
+		compressed = 1
+	}
+	ratio := float64(uncompressed) / float64(compressed)
+	if ratio > l.MaxCompressionRatio {
+		return &ArchiveLimitError{Limit: LimitCompressionRatio, Path: path, Actual: int64(ratio), Max: int64(l.MaxCompressionRatio)}
+	}
+	return nil
+}
+
+// checkArchiveHeaders checks the sizes declared in the archive directory.
+// Declared sizes can be forged, so limits are enforced again while reading.
+func (l ArchiveLimits) checkArchiveHeaders(files []*zip.File) error {
+	if l.MaxEntries > 0 && len(files) > l.MaxEntries {
+		return &ArchiveLimitError{Limit: LimitEntries, Actual: int64(len(files)), Max: int64(l.MaxEntries)}
+	}
+
+	var total uint64
+	for _, file := range files {
+		size := file.UncompressedSize64
+		if l.MaxFileSize > 0 && size > uint64(l.MaxFileSize) {
+			return &ArchiveLimitError{Limit: LimitFileSize, Path: file.Name, Actual: clampInt64(size), Max: l.MaxFileSize}
+		}
+		if ratioErr := l.checkRatio(file.Name, size, file.CompressedSize64); ratioErr != nil {
+			return ratioErr
+		}
+		total += size
+		if l.MaxUncompressedSize > 0 && total > uint64(l.MaxUncompressedSize) {
+			return &ArchiveLimitError{Limit: LimitUncompressedSize, Actual: clampInt64(total), Max: l.MaxUncompressedSize}
+		}
+	}
+	return nil
+}
+
+// LimitError returns the archive limit error raised while reading archive
+// entries, or nil. Once set, all further file reads fail.
+func (l *FeedLoader) LimitError() error {
+	l.limitMu.Lock()
+	defer l.limitMu.Unlock()
+	if l.limitErr == nil {
+		return nil
+	}
+	return l.limitErr
+}
+
+// recordLimitError stores the first limit error seen while reading
+func (l *FeedLoader) recordLimitError(err *ArchiveLimitError) error {
+	l.limitMu.Lock()
+	defer l.limitMu.Unlock()
+	if l.limitErr == nil {
+		l.limitErr = err
+	}
+	return err
+}
+
+// limitedEntryReader enforces the archive limits while an entry is decompressed
+type limitedEntryReader struct {
+	io.ReadCloser
+	loader     *FeedLoader
+	path       string
+	compressed uint64
+	read       uint64
+}
+
+func (r *limitedEntryReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += uint64(n)
+
+	limits := r.loader.limits
+	if limits.MaxFileSize > 0 && r.read > uint64(limits.MaxFileSize) {
+		return n, r.loader.recordLimitError(&ArchiveLimitError{Limit: LimitFileSize, Path: r.path, Actual: clampInt64(r.read), Max: limits.MaxFileSize})
+	}
+	if ratioErr := limits.checkRatio(r.path, r.read, r.compressed); ratioErr != nil {
+		return n, r.loader.recordLimitError(ratioErr)
+	}
+	if limitErr := r.loader.trackEntrySize(r.path, r.read); limitErr != nil {
+		return n, r.loader.recordLimitError(limitErr)
+	}
+	return n, err
+}
+
+// trackEntrySize records the bytes decompressed for an entry and checks the
+// total against MaxUncompressedSize. Entries read several times count once.
+func (l *FeedLoader) trackEntrySize(path string, read uint64) *ArchiveLimitError {
+	if l.limits.MaxUncompressedSize <= 0 {
+		return nil
+	}
+
+	l.limitMu.Lock()
+	defer l.limitMu.Unlock()
+	if previous := l.entryBytes[path]; read > previous {
+		l.totalBytes += read - previous
+		l.entryBytes[path] = read
+	}
+	if l.totalBytes > uint64(l.limits.MaxUncompressedSize) {
+		return &ArchiveLimitError{Limit: LimitUncompressedSize, Actual: clampInt64(l.totalBytes), Max: l.limits.MaxUncompressedSize}
+	}
+	return nil
+}
+
+// limitedLoaderState holds the streaming limit state of a FeedLoader
+type limitedLoaderState struct {
+	limits     ArchiveLimits
+	limitMu    sync.Mutex
+	limitErr   *ArchiveLimitError
+	entryBytes map[string]uint64
+	totalBytes uint64
+}
+
+func clampInt64(v uint64) int64 {
+	if v > 1<<63-1 {
+		return 1<<63 - 1
+	}
+	return int64(v)
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// buildZip creates an in-memory archive with the given entries
+func buildZip(t *testing.T, entries map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range entries {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write zip entry: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close zip writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestArchiveLimits_Load(t *testing.T) {
+	bomb := strings.Repeat("0", 8<<20) // 8 MiB of zeros compresses ~1000x
+
+	tests := []struct {
+		name          string
+		entries       map[string]string
+		limits        ArchiveLimits
+		expectedLimit string
+	}{
+		{
+			name:    "within default limits",
+			entries: map[string]string{"agency.txt": "agency_id\nA"},
+			limits:  DefaultArchiveLimits(),
+		},
+		{
+			name:          "archive too large",
+			entries:       map[string]string{"agency.txt": "agency_id\nA"},
+			limits:        ArchiveLimits{MaxArchiveSize: 10},
+			expectedLimit: LimitArchiveSize,
+		},
+		{
+			name:          "too many entries",
+			entries:       map[string]string{"a.txt": "a", "b.txt": "b", "c.txt": "c"},
+			limits:        ArchiveLimits{MaxEntries: 2},
+			expectedLimit: LimitEntries,
+		},
+		{
+			name:          "file too large",
+			entries:       map[string]string{"stops.txt": strings.Repeat("x", 2048)},
+			limits:        ArchiveLimits{MaxFileSize: 1024},
+			expectedLimit: LimitFileSize,
+		},
+		{
+			name:          "total too large",
+			entries:       map[string]string{"a.txt": strings.Repeat("x", 600), "b.txt": strings.Repeat("y", 600)},
+			limits:        ArchiveLimits{MaxUncompressedSize: 1000},
+			expectedLimit: LimitUncompressedSize,
+		},
+		{
+			name:          "compression ratio too high",
+			entries:       map[string]string{"stop_times.txt": bomb},
+			limits:        ArchiveLimits{MaxCompressionRatio: 100},
+			expectedLimit: LimitCompressionRatio,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildZip(t, tt.entries)
+			loader, err := LoadFromZipReaderWithOptions(bytes.NewReader(data), int64(len(data)), ArchiveOptions{Limits: tt.limits})
+
+			if tt.expectedLimit == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				_ = loader.Close()
+				return
+			}
+
+			if !errors.Is(err, ErrArchiveLimitExceeded) {
+				t.Fatalf("Expected ErrArchiveLimitExceeded, got %v", err)
+			}
+			var limitErr *ArchiveLimitError
+			if !errors.As(err, &limitErr) || limitErr.Limit != tt.expectedLimit {
+				t.Errorf("Expected %s limit error, got %v", tt.expectedLimit, err)
+			}
+		})
+	}
+}
+
+func TestArchiveLimits_Streaming(t *testing.T) {
+	data := buildZip(t, map[string]string{"stops.txt": strings.Repeat("x", 4096), "agency.txt": "agency_id\nA"})
+	loader, err := LoadFromZipReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Failed to load zip: %v", err)
+	}
+
+	// Declared sizes are checked on load; set the limits afterwards to exercise
+	// the checks made while decompressing, which also catch forged headers
+	loader.limits = ArchiveLimits{MaxFileSize: 1024}
+
+	file, err := loader.GetFile("stops.txt")
+	if err != nil {
+		t.Fatalf("Failed to get stops.txt: %v", err)
+	}
+	_, err = io.Copy(io.Discard, file)
+	_ = file.Close()
+	if !errors.Is(err, ErrArchiveLimitExceeded) {
+		t.Fatalf("Expected limit error while decompressing, got %v", err)
+	}
+
+	if !errors.Is(loader.LimitError(), ErrArchiveLimitExceeded) {
+		t.Error("Expected the loader to record the limit error")
+	}
+	if _, err := loader.GetFile("agency.txt"); !errors.Is(err, ErrArchiveLimitExceeded) {
+		t.Errorf("Expected further reads to fail fast, got %v", err)
+	}
+}
+
+func TestArchiveLimits_RepeatedReadsCountOnce(t *testing.T) {
+	data := buildZip(t, map[string]string{"stops.txt": strings.Repeat("x", 600)})
+	loader, err := LoadFromZipReaderWithOptions(bytes.NewReader(data), int64(len(data)), ArchiveOptions{Limits: ArchiveLimits{MaxUncompressedSize: 1000}})
+	if err != nil {
+		t.Fatalf("Failed to load zip: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		file, err := loader.GetFile("stops.txt")
+		if err != nil {
+			t.Fatalf("Read %d: failed to get stops.txt: %v", i, err)
+		}
+		if _, err := io.Copy(io.Discard, file); err != nil {
+			t.Fatalf("Read %d: unexpected error: %v", i, err)
+		}
+		_ = file.Close()
+	}
+}
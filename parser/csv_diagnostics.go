@@ -0,0 +1,249 @@
+package parser
+
+import (
+	"io"
+	"strings"
+)
+
+// CSVIssueType identifies a strict RFC 4180 violation found by DiagnoseCSV
+type CSVIssueType string
+
+const (
+	// CSVIssueBareQuote is a quote inside an unquoted field
+	CSVIssueBareQuote CSVIssueType = "bare_quote"
+	// CSVIssueInvalidQuote is a closing quote followed by something other than a comma or line break
+	CSVIssueInvalidQuote CSVIssueType = "invalid_quote"
+	// CSVIssueUnterminatedQuote is a quoted field that is never closed
+	CSVIssueUnterminatedQuote CSVIssueType = "unterminated_quote"
+	// CSVIssueMixedLineEndings is a file using both CRLF and LF line breaks
+	CSVIssueMixedLineEndings CSVIssueType = "mixed_line_endings"
+	// CSVIssueMissingTrailingNewline is a file whose last line has no line break
+	CSVIssueMissingTrailingNewline CSVIssueType = "missing_trailing_newline"
+	// CSVIssueNULByte is a NUL byte in the content
+	CSVIssueNULByte CSVIssueType = "nul_byte"
+)
+
+// maxIssuesPerType bounds the issues kept per type so a broken file cannot exhaust memory
+const maxIssuesPerType = 1000
+
+// CSVIssue is a strict CSV violation and its location
+type CSVIssue struct {
+	Type CSVIssueType
+	// Line is the physical line number (header is line 1)
+	Line int
+	// Column is the 1-based character position in the line, 0 for file-level issues
+	Column int
+	// RowNumber is the CSV row number of the record containing the issue (header is row 1)
+	RowNumber int
+}
+
+// CSVDiagnostics is the result of a strict pass over a CSV file
+type CSVDiagnostics struct {
+	// Issues lists the violations in the order found, at most 1000 per type
+	Issues []CSVIssue
+	// CRLFLines and LFLines count the lines ending with each line break
+	CRLFLines int
+	LFLines   int
+}
+
+// DiagnoseCSV checks r against RFC 4180 without changing how the file is parsed.
+//
+// It reports bare quotes in unquoted fields, closing quotes not followed by a
+// comma or line break, unterminated quoted fields, mixed CRLF/LF line breaks, a
+// missing line break at the end of the file and NUL bytes. Like the lenient
+// parser, a quoted field still open after 20 lines is reported as unterminated
+// and scanning resumes at the line after the opening quote.
+func DiagnoseCSV(r io.Reader) (*CSVDiagnostics, error) {
+	scanner := &csvDiagnosticScanner{
+		lines:       newRecordReader(r),
+		diagnostics: &CSVDiagnostics{},
+		counts:      make(map[CSVIssueType]int),
+	}
+	if err := scanner.run(); err != nil {
+		return nil, err
+	}
+	return scanner.diagnostics, nil
+}
+
+// csvDiagnosticScanner holds the quoting state while scanning lines
+type csvDiagnosticScanner struct {
+	lines       *recordReader // used for its line reading and replay
+	diagnostics *CSVDiagnostics
+	counts      map[CSVIssueType]int
+
+	row       int
+	inQuotes  bool
+	quoted    []physicalLine // lines of the open quoted field, for resync
+	quoteCol  int
+	quoteRow  int
+	firstCRLF int  // first line ending with CRLF
+	firstLF   int  // first line ending with LF
+	lastEnded bool // whether the last line read from the input has a line break
+}
+
+func (s *csvDiagnosticScanner) run() error {
+	var pending []CSVIssue // issues found since the open quoted field started
+
+	for {
+		line, err := s.readLine()
+		if err == io.EOF {
+			if !s.inQuotes {
+				break
+			}
+			// Report the open field and scan the lines it swallowed again
+			pending = s.resync(pending)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !s.inQuotes {
+			if strings.TrimSpace(line.text) == "" {
+				continue
+			}
+			s.row++
+		}
+
+		pending = append(pending, s.scanLine(line)...)
+		if s.inQuotes {
+			s.quoted = append(s.quoted, line)
+			if len(s.quoted) >= maxRecordLines {
+				pending = s.resync(pending)
+			}
+			continue
+		}
+
+		s.quoted = nil
+		for _, issue := range pending {
+			s.add(issue)
+		}
+		pending = nil
+	}
+
+	if s.lines.line > 0 && !s.lastEnded {
+		s.add(CSVIssue{Type: CSVIssueMissingTrailingNewline, Line: s.lines.line, RowNumber: s.row})
+	}
+	if s.diagnostics.CRLFLines > 0 && s.diagnostics.LFLines > 0 {
+		// Point at the first line using the less common line break
+		line := s.firstLF
+		if s.diagnostics.CRLFLines < s.diagnostics.LFLines {
+			line = s.firstCRLF
+		}
+		s.add(CSVIssue{Type: CSVIssueMixedLineEndings, Line: line})
+	}
+	return nil
+}
+
+// resync reports the open quoted field as unterminated and queues the lines
+// after its first one to be scanned again. Issues found on the first line are
+// kept; the others are found again when the lines are rescanned.
+func (s *csvDiagnosticScanner) resync(pending []CSVIssue) []CSVIssue {
+	first := s.quoted[0]
+	for _, issue := range pending {
+		if issue.Line == first.number {
+			s.add(issue)
+		}
+	}
+	s.add(CSVIssue{Type: CSVIssueUnterminatedQuote, Line: first.number, Column: s.quoteCol, RowNumber: s.quoteRow})
+
+	s.inQuotes = false
+	s.lines.pending = append(append([]physicalLine{}, s.quoted[1:]...), s.lines.pending...)
+	s.quoted = nil
+	return nil
+}
+
+// readLine returns the next line without its line break. Line breaks are
+// counted when a line is read from the input, not when it is replayed.
+func (s *csvDiagnosticScanner) readLine() (physicalLine, error) {
+	if len(s.lines.pending) > 0 {
+		return s.lines.readLine()
+	}
+
+	text, err := s.lines.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || text == "") {
+		return physicalLine{}, err
+	}
+	s.lines.line++
+	line := physicalLine{text: text, number: s.lines.line}
+	s.lastEnded = true
+
+	switch {
+	case strings.HasSuffix(text, "\r\n"):
+		line.text = text[:len(text)-2]
+		s.diagnostics.CRLFLines++
+		if s.firstCRLF == 0 {
+			s.firstCRLF = line.number
+		}
+	case strings.HasSuffix(text, "\n"):
+		line.text = text[:len(text)-1]
+		s.diagnostics.LFLines++
+		if s.firstLF == 0 {
+			s.firstLF = line.number
+		}
+	default:
+		s.lastEnded = false
+	}
+	return line, nil
+}
+
+// scanLine checks the quoting of a line, carrying the quoted field state across lines
+func (s *csvDiagnosticScanner) scanLine(line physicalLine) []CSVIssue {
+	var issues []CSVIssue
+	issue := func(issueType CSVIssueType, column int) {
+		issues = append(issues, CSVIssue{Type: issueType, Line: line.number, Column: column, RowNumber: s.row})
+	}
+
+	text := line.text
+	fieldStart := !s.inQuotes
+	column := 0
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c&0xC0 != 0x80 {
+			column++
+		}
+		if c == 0 {
+			issue(CSVIssueNULByte, column)
+		}
+
+		if s.inQuotes {
+			if c != '"' {
+				continue
+			}
+			if i+1 < len(text) && text[i+1] == '"' {
+				i++
+				column++
+				continue
+			}
+			s.inQuotes = false
+			if i+1 < len(text) && text[i+1] != ',' {
+				issue(CSVIssueInvalidQuote, column)
+			}
+			continue
+		}
+
+		switch c {
+		case ',':
+			fieldStart = true
+			continue
+		case '"':
+			if fieldStart {
+				s.inQuotes = true
+				s.quoteCol = column
+				s.quoteRow = s.row
+			} else {
+				issue(CSVIssueBareQuote, column)
+			}
+		}
+		fieldStart = false
+	}
+	return issues
+}
+
+// add records an issue unless its type already reached the limit
+func (s *csvDiagnosticScanner) add(issue CSVIssue) {
+	s.counts[issue.Type]++
+	if s.counts[issue.Type] <= maxIssuesPerType {
+		s.diagnostics.Issues = append(s.diagnostics.Issues, issue)
+	}
+}
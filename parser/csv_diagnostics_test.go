@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiagnoseCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		expected  []CSVIssue
+		crlfLines int
+		lfLines   int
+	}{
+		{
+			name:    "well-formed file",
+			content: "stop_id,stop_name\nS1,\"Main, \"\"Central\"\"\"\nS2,\"Multi\nline\"\n",
+			lfLines: 4,
+		},
+		{
+			name:    "bare quote",
+			content: "stop_id,stop_name\nS1,Platform 5\"\n",
+			expected: []CSVIssue{
+				{Type: CSVIssueBareQuote, Line: 2, Column: 14, RowNumber: 2},
+			},
+			lfLines: 2,
+		},
+		{
+			name:    "invalid closing quote",
+			content: "stop_id,stop_name\nS1,\"Main\" St\n",
+			expected: []CSVIssue{
+				{Type: CSVIssueInvalidQuote, Line: 2, Column: 9, RowNumber: 2},
+			},
+			lfLines: 2,
+		},
+		{
+			name:    "unterminated quote resumes at next line",
+			content: "stop_id,stop_name\nS1,\"Broken\nS2,Main\nS3,Nul\x00\n",
+			expected: []CSVIssue{
+				{Type: CSVIssueUnterminatedQuote, Line: 2, Column: 4, RowNumber: 2},
+				{Type: CSVIssueNULByte, Line: 4, Column: 7, RowNumber: 4},
+			},
+			lfLines: 4,
+		},
+		{
+			name:    "NUL byte with multi-byte characters",
+			content: "stop_id,stop_name\nS1,Plaça\x00\n",
+			expected: []CSVIssue{
+				{Type: CSVIssueNULByte, Line: 2, Column: 9, RowNumber: 2},
+			},
+			lfLines: 2,
+		},
+		{
+			name:    "missing trailing newline",
+			content: "stop_id,stop_name\nS1,Main",
+			expected: []CSVIssue{
+				{Type: CSVIssueMissingTrailingNewline, Line: 2, RowNumber: 2},
+			},
+			lfLines: 1,
+		},
+		{
+			name:    "mixed line endings",
+			content: "stop_id,stop_name\r\nS1,Main\r\nS2,Second\n",
+			expected: []CSVIssue{
+				{Type: CSVIssueMixedLineEndings, Line: 3},
+			},
+			crlfLines: 2,
+			lfLines:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics, err := DiagnoseCSV(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("DiagnoseCSV failed: %v", err)
+			}
+			if !reflect.DeepEqual(diagnostics.Issues, tt.expected) {
+				t.Errorf("expected issues %+v, got %+v", tt.expected, diagnostics.Issues)
+			}
+			if diagnostics.CRLFLines != tt.crlfLines || diagnostics.LFLines != tt.lfLines {
+				t.Errorf("expected %d CRLF and %d LF lines, got %d and %d", tt.crlfLines, tt.lfLines, diagnostics.CRLFLines, diagnostics.LFLines)
+			}
+		})
+	}
+}
@@ -15,7 +15,8 @@ type CSVFile struct {
 	Headers    []string
 	rawHeaders int
 	Rows       []CSVRow
-	reader     *csv.Reader
+	reader     csvRecordReader
+	recovery   *recordReader          // Set in recovery mode, see NewRecoveringCSVFile
 	rowCounter int                    // Track the current row being read
 	parser     *pools.PooledCSVParser // Memory-efficient CSV parsing
 }
@@ -27,8 +28,29 @@ type CSVRow struct {
 	RawFieldCount int
 }
 
+// csvRecordReader reads the fields of one CSV record at a time
+type csvRecordReader interface {
+	Read() ([]string, error)
+}
+
+// CSVOptions configures how NewCSVFileWithOptions parses a file
+type CSVOptions struct {
+	// Recover skips malformed rows instead of failing, see NewRecoveringCSVFile
+	Recover bool
+}
+
 // NewCSVFile creates a new CSV file parser
 func NewCSVFile(reader io.Reader, filename string) (*CSVFile, error) {
+	return NewCSVFileWithOptions(reader, filename, CSVOptions{})
+}
+
+// NewCSVFileWithOptions creates a new CSV file parser using the given options.
+// Files read from a FeedLoader should be parsed with FeedLoader.CSVOptions.
+func NewCSVFileWithOptions(reader io.Reader, filename string, options CSVOptions) (*CSVFile, error) {
+	if options.Recover {
+		return NewRecoveringCSVFile(reader, filename)
+	}
+
 	csvReader := csv.NewReader(reader)
 	csvReader.LazyQuotes = true
 	csvReader.TrimLeadingSpace = false // We'll handle whitespace validation
@@ -42,6 +64,35 @@ func NewCSVFile(reader io.Reader, filename string) (*CSVFile, error) {
 		return nil, fmt.Errorf("failed to read headers from %s: %v", filename, err)
 	}
 
+	return newCSVFile(filename, headers, csvReader, nil), nil
+}
+
+// NewRecoveringCSVFile creates a CSV file parser that recovers from malformed rows.
+//
+// Quotes are handled leniently, and rows may have more or fewer fields than the
+// header. Malformed rows, such as a stray quote opening a field that is never
+// closed, are skipped and recorded in ParseErrors; parsing resumes at the next
+// line so the rest of the file is still read.
+func NewRecoveringCSVFile(reader io.Reader, filename string) (*CSVFile, error) {
+	csvReader := newRecordReader(reader)
+
+	// Read headers
+	headers, err := csvReader.Read()
+	if err == nil && len(csvReader.errors) > 0 {
+		err = csvReader.errors[0]
+	}
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("empty file: %s", filename)
+		}
+		return nil, fmt.Errorf("failed to read headers from %s: %v", filename, err)
+	}
+	csvReader.expectedFields = len(headers)
+
+	return newCSVFile(filename, headers, csvReader, csvReader), nil
+}
+
+func newCSVFile(filename string, headers []string, reader csvRecordReader, recovery *recordReader) *CSVFile {
 	// Clean headers (remove BOM if present)
 	if len(headers) > 0 {
 		headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
@@ -52,10 +103,11 @@ func NewCSVFile(reader io.Reader, filename string) (*CSVFile, error) {
 		Headers:    headers,
 		rawHeaders: len(headers),
 		Rows:       make([]CSVRow, 0),
-		reader:     csvReader,
+		reader:     reader,
+		recovery:   recovery,
 		rowCounter: 1, // Start at 1 (header is row 1)
 		parser:     pools.NewPooledCSVParser(),
-	}, nil
+	}
 }
 
 // ReadRow reads the next row from the CSV file
@@ -65,7 +117,11 @@ func (f *CSVFile) ReadRow() (*CSVRow, error) {
 		return nil, err
 	}
 
-	f.rowCounter++ // Increment counter for each data row; first data row should be row 2
+	if f.recovery != nil {
+		f.rowCounter = f.recovery.row // Skipped malformed rows keep their row number; first data row is row 2
+	} else {
+		f.rowCounter++ // Increment counter for each data row; first data row should be row 2
+	}
 
 	// Use pooled memory for the row values map
 	rowValues := make(map[string]string)
@@ -99,6 +155,15 @@ func (f *CSVFile) ReadRow() (*CSVRow, error) {
 	return row, nil
 }
 
+// ParseErrors returns the malformed rows skipped so far, in file order.
+// It is always empty outside recovery mode.
+func (f *CSVFile) ParseErrors() []CSVParseError {
+	if f.recovery == nil {
+		return nil
+	}
+	return f.recovery.errors
+}
+
 // ReadAll reads all remaining rows from the CSV file
 func (f *CSVFile) ReadAll() error {
 	for {
@@ -0,0 +1,205 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxRecordLines bounds how many physical lines a quoted field may span before
+// the record is treated as malformed
+const maxRecordLines = 20
+
+// maxRawTextLength truncates the raw text kept for malformed lines
+const maxRawTextLength = 500
+
+// CSVParseError describes a malformed record skipped while parsing a CSV file
+type CSVParseError struct {
+	// Line is the physical line number where the record starts (header is line 1)
+	Line int
+	// RowNumber is the CSV row number the record would have had (header is row 1)
+	RowNumber int
+	// RawText is the content of the line, truncated to 500 bytes
+	RawText string
+	// Message explains why the record was skipped
+	Message string
+}
+
+func (e CSVParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// EnableCSVRecovery makes CSVOptions request recovery mode, see
+// NewRecoveringCSVFile. Call this method before starting validation.
+func (l *FeedLoader) EnableCSVRecovery() {
+	l.recoverCSV = true
+}
+
+// CSVRecoveryEnabled reports whether the files of the feed are parsed in recovery mode
+func (l *FeedLoader) CSVRecoveryEnabled() bool {
+	return l.recoverCSV
+}
+
+// CSVOptions returns the options to parse the files of the feed with NewCSVFileWithOptions
+func (l *FeedLoader) CSVOptions() CSVOptions {
+	return CSVOptions{Recover: l.recoverCSV}
+}
+
+// physicalLine is a line of the input with "\r\n" normalized to "\n"
+type physicalLine struct {
+	text   string
+	number int
+}
+
+// recordReader splits CSV input into records with the lazy quoting rules of
+// encoding/csv, but resynchronises at the next line when a quoted field is not
+// terminated instead of swallowing the rest of the file into one field.
+//
+// A record is malformed if a quoted field is still open at the end of the input
+// or after maxRecordLines lines, or if a record spanning several lines does not
+// have the expected number of fields. The first line of a malformed record is
+// skipped and parsing restarts at the following line.
+type recordReader struct {
+	reader  *bufio.Reader
+	pending []physicalLine // lines read ahead, replayed after a resync
+	line    int            // number of the last physical line read
+	row     int            // number of the last record read, including malformed ones
+
+	// expectedFields is the header field count, 0 while reading the header
+	expectedFields int
+	errors         []CSVParseError
+}
+
+func newRecordReader(reader io.Reader) *recordReader {
+	return &recordReader{reader: bufio.NewReader(reader)}
+}
+
+// Read returns the fields of the next well-formed record, skipping malformed ones
+func (r *recordReader) Read() ([]string, error) {
+	for {
+		first, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		// Empty lines are ignored like in encoding/csv
+		if first.text == "\n" || first.text == "" {
+			continue
+		}
+		r.row++
+
+		fields, complete := parseRecord(first.text, r.expectedFields)
+		if complete {
+			return fields, nil
+		}
+
+		lines := []physicalLine{first}
+		for !complete && len(lines) < maxRecordLines {
+			next, err := r.readLine()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, next)
+			fields, complete = parseRecord(joinLines(lines), r.expectedFields)
+		}
+
+		switch {
+		case !complete:
+			r.skip(lines, "unterminated quoted field")
+		case len(lines) > 1 && r.expectedFields > 0 && len(fields) != r.expectedFields:
+			r.skip(lines, fmt.Sprintf("quoted field spans %d lines and yields %d fields instead of %d", len(lines), len(fields), r.expectedFields))
+		default:
+			return fields, nil
+		}
+	}
+}
+
+// skip records the first line of a malformed record and queues the others to be read again
+func (r *recordReader) skip(lines []physicalLine, message string) {
+	raw := strings.TrimSuffix(lines[0].text, "\n")
+	if len(raw) > maxRawTextLength {
+		raw = raw[:maxRawTextLength]
+	}
+	r.errors = append(r.errors, CSVParseError{Line: lines[0].number, RowNumber: r.row, RawText: raw, Message: message})
+	r.pending = append(append([]physicalLine{}, lines[1:]...), r.pending...)
+}
+
+// readLine returns the next physical line, including its trailing newline
+func (r *recordReader) readLine() (physicalLine, error) {
+	if len(r.pending) > 0 {
+		line := r.pending[0]
+		r.pending = r.pending[1:]
+		return line, nil
+	}
+
+	text, err := r.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || text == "") {
+		return physicalLine{}, err
+	}
+	r.line++
+
+	if strings.HasSuffix(text, "\r\n") {
+		text = text[:len(text)-2] + "\n"
+	} else if err == io.EOF {
+		text = strings.TrimSuffix(text, "\r")
+	}
+	return physicalLine{text: text, number: r.line}, nil
+}
+
+func joinLines(lines []physicalLine) string {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line.text)
+	}
+	return b.String()
+}
+
+// parseRecord splits text into fields. It returns false if a quoted field is
+// not terminated, so more lines are needed. Quotes are handled like encoding/csv
+// with LazyQuotes: a quote not followed by a comma or the end of the line is kept
+// literally inside a quoted field, and quotes in unquoted fields are literal.
+func parseRecord(text string, capacity int) ([]string, bool) {
+	fields := make([]string, 0, capacity)
+	pos := 0
+
+nextField:
+	for {
+		if pos < len(text) && text[pos] == '"' {
+			var field strings.Builder
+			i := pos + 1
+			for {
+				j := strings.IndexByte(text[i:], '"')
+				if j < 0 {
+					return nil, false
+				}
+				field.WriteString(text[i : i+j])
+				i += j + 1
+
+				switch {
+				case i < len(text) && text[i] == '"':
+					field.WriteByte('"')
+					i++
+				case i < len(text) && text[i] == ',':
+					fields = append(fields, field.String())
+					pos = i + 1
+					continue nextField
+				case i == len(text) || text[i] == '\n':
+					return append(fields, field.String()), true
+				default:
+					field.WriteByte('"')
+				}
+			}
+		}
+
+		rest := text[pos:]
+		if end := strings.IndexByte(rest, ','); end >= 0 {
+			fields = append(fields, rest[:end])
+			pos += end + 1
+			continue
+		}
+		return append(fields, strings.TrimSuffix(rest, "\n")), true
+	}
+}
@@ -0,0 +1,194 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRecordReader_MatchesEncodingCSV(t *testing.T) {
+	inputs := []string{
+		"a,b,c\n1,2,3\n",
+		"a,b\r\n1,2\r\n3,4",
+		"a,b\n\n1,2\n\r\n3,4\n",
+		"a,b\n\"quoted, with comma\",\"escaped \"\"quote\"\"\"\n",
+		"a,b\n\"multi\nline\",2\n3,4\n",
+		"a,b\nbare \"quote\" inside,2\n",
+		"a,b\n\"lazy \"quote\" inside\",2\n",
+		"a,b,c\n1,2,\n,,\n",
+		"a,b\n1,2,3\n4\n",
+		"a\n\"\"\n",
+		"a,b\n  \n1,2\n",
+		"a,b,c\n1,\"first\n2,3,4\nlast\",5\n",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			expected := csv.NewReader(strings.NewReader(input))
+			expected.LazyQuotes = true
+			expected.FieldsPerRecord = -1
+
+			actual := newRecordReader(strings.NewReader(input))
+			for {
+				want, wantErr := expected.Read()
+				got, gotErr := actual.Read()
+				if wantErr == io.EOF || gotErr == io.EOF {
+					if wantErr != gotErr {
+						t.Fatalf("expected %v, got %v", wantErr, gotErr)
+					}
+					break
+				}
+				if wantErr != nil {
+					t.Fatalf("encoding/csv failed: %v", wantErr)
+				}
+				if gotErr != nil {
+					t.Fatalf("unexpected error: %v", gotErr)
+				}
+				if !reflect.DeepEqual(want, got) {
+					t.Fatalf("expected %q, got %q", want, got)
+				}
+			}
+			if len(actual.errors) != 0 {
+				t.Errorf("expected no parse errors, got %v", actual.errors)
+			}
+		})
+	}
+}
+
+func TestCSVFile_Recovery(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		expectedIDs    []string
+		expectedRows   []int
+		expectedErrors []CSVParseError
+	}{
+		{
+			name:         "unterminated quote at end of file",
+			content:      "stop_id,stop_name\nS1,Main\nS2,\"Broken\nS3,Last\n",
+			expectedIDs:  []string{"S1", "S3"},
+			expectedRows: []int{2, 4},
+			expectedErrors: []CSVParseError{
+				{Line: 3, RowNumber: 3, RawText: "S2,\"Broken", Message: "unterminated quoted field"},
+			},
+		},
+		{
+			name:         "multi-line field with the wrong number of fields",
+			content:      "stop_id,stop_name\nS1,\"Open\nS2,Close\",extra\nS3,After\n",
+			expectedIDs:  []string{"S2", "S3"},
+			expectedRows: []int{3, 4},
+			expectedErrors: []CSVParseError{
+				{Line: 2, RowNumber: 2, RawText: "S1,\"Open", Message: "quoted field spans 2 lines and yields 3 fields instead of 2"},
+			},
+		},
+		{
+			name:         "whitespace-only lines kept as rows",
+			content:      "stop_id,stop_name\nS1,Main\n   \nS2,Second\n",
+			expectedIDs:  []string{"S1", "   ", "S2"},
+			expectedRows: []int{2, 3, 4},
+		},
+		{
+			name:         "valid multi-line field",
+			content:      "stop_id,stop_desc\nS1,\"Line one\nLine two\"\nS2,Plain\n",
+			expectedIDs:  []string{"S1", "S2"},
+			expectedRows: []int{2, 3},
+		},
+		{
+			name:         "multi-line field with a line that looks like a row",
+			content:      "stop_id,stop_desc\nS1,\"Exits:\nNorth,South\nEast\"\nS2,Plain\n",
+			expectedIDs:  []string{"S1", "S2"},
+			expectedRows: []int{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csvFile, err := NewRecoveringCSVFile(strings.NewReader(tt.content), "stops.txt")
+			if err != nil {
+				t.Fatalf("Failed to create CSV file: %v", err)
+			}
+			if err := csvFile.ReadAll(); err != nil {
+				t.Fatalf("ReadAll failed: %v", err)
+			}
+
+			var ids []string
+			var rows []int
+			for _, row := range csvFile.Rows {
+				ids = append(ids, row.Values["stop_id"])
+				rows = append(rows, row.RowNumber)
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("expected rows %v, got %v", tt.expectedIDs, ids)
+			}
+			if !reflect.DeepEqual(rows, tt.expectedRows) {
+				t.Errorf("expected row numbers %v, got %v", tt.expectedRows, rows)
+			}
+			if !reflect.DeepEqual(csvFile.ParseErrors(), tt.expectedErrors) {
+				t.Errorf("expected parse errors %+v, got %+v", tt.expectedErrors, csvFile.ParseErrors())
+			}
+		})
+	}
+}
+
+func TestNewRecoveringCSVFile_MalformedHeader(t *testing.T) {
+	if _, err := NewRecoveringCSVFile(strings.NewReader("stop_id,\"stop_name\nS1,Main\n"), "stops.txt"); err == nil {
+		t.Error("expected an error for an unterminated quote in the header")
+	}
+}
+
+func TestNewCSVFile_QuotedNewline(t *testing.T) {
+	content := "stop_id,stop_desc\nS1,\"Exits:\nNorth,South\nEast\"\nS2,Plain\n"
+
+	// Without recovery, files are parsed by encoding/csv
+	csvFile, err := NewCSVFile(strings.NewReader(content), "stops.txt")
+	if err != nil {
+		t.Fatalf("Failed to create CSV file: %v", err)
+	}
+	if err := csvFile.ReadAll(); err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if csvFile.RowCount() != 2 || csvFile.Rows[0].Values["stop_desc"] != "Exits:\nNorth,South\nEast" || csvFile.Rows[1].RowNumber != 3 {
+		t.Errorf("Unexpected rows: %+v", csvFile.Rows)
+	}
+}
+
+func TestFeedLoader_EnableCSVRecovery(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "stops.txt"), []byte("stop_id,stop_name\nS1,\"Broken\nS2,Main\n"), 0600); err != nil {
+		t.Fatalf("Failed to write stops.txt: %v", err)
+	}
+
+	for _, recover := range []bool{false, true} {
+		loader, err := LoadFromDirectory(dir)
+		if err != nil {
+			t.Fatalf("Failed to load directory: %v", err)
+		}
+		if recover {
+			loader.EnableCSVRecovery()
+		}
+
+		reader, err := loader.GetFile("stops.txt")
+		if err != nil {
+			t.Fatalf("GetFile failed: %v", err)
+		}
+		// Wrapping the reader keeps the mode, which comes from the loader options
+		csvFile, err := NewCSVFileWithOptions(bufio.NewReader(reader), "stops.txt", loader.CSVOptions())
+		if err != nil {
+			t.Fatalf("Failed to create CSV file: %v", err)
+		}
+		_ = csvFile.ReadAll()
+		_ = reader.Close()
+
+		if got := len(csvFile.ParseErrors()); recover != (got == 1) {
+			t.Errorf("recover=%v: got %d parse errors", recover, got)
+		}
+		if recover && (csvFile.RowCount() != 1 || csvFile.Rows[0].Values["stop_id"] != "S2") {
+			t.Errorf("Expected the row after the malformed one, got %+v", csvFile.Rows)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Encoding is a character encoding detected for a feed file
+type Encoding string
+
+const (
+	EncodingUTF8        Encoding = "UTF-8"
+	EncodingUTF16LE     Encoding = "UTF-16LE"
+	EncodingUTF16BE     Encoding = "UTF-16BE"
+	EncodingWindows1252 Encoding = "Windows-1252"
+	EncodingISO88591    Encoding = "ISO-8859-1"
+)
+
+// encodingSampleSize is the number of leading bytes used to detect UTF-16 without a BOM
+const encodingSampleSize = 64 * 1024
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// EncodingInfo describes the character encoding of a file
+type EncodingInfo struct {
+	Encoding Encoding
+	// BOM is true if the file starts with a byte order mark
+	BOM bool
+	// InvalidUTF8 is true if a UTF-8 file contains invalid byte sequences
+	InvalidUTF8 bool
+}
+
+// IsUTF8 reports whether the file is valid UTF-8
+func (e EncodingInfo) IsUTF8() bool {
+	return e.Encoding == EncodingUTF8 && !e.InvalidUTF8
+}
+
+// IsUTF16 reports whether the file is UTF-16 encoded
+func (e EncodingInfo) IsUTF16() bool {
+	return e.Encoding == EncodingUTF16LE || e.Encoding == EncodingUTF16BE
+}
+
+// decoder returns the decoder transcoding the file to UTF-8, or nil if none is needed.
+// Invalid sequences in UTF-8 files are replaced with U+FFFD.
+func (e EncodingInfo) decoder() *encoding.Decoder {
+	switch e.Encoding {
+	case EncodingUTF8:
+		if e.InvalidUTF8 {
+			return unicode.UTF8.NewDecoder()
+		}
+		return nil
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder()
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder()
+	case EncodingWindows1252:
+		return charmap.Windows1252.NewDecoder()
+	case EncodingISO88591:
+		return charmap.ISO8859_1.NewDecoder()
+	default:
+		return nil
+	}
+}
+
+// DetectEncoding reads r to detect its character encoding.
+//
+// UTF-16 is recognized by its BOM or by the NUL bytes of ASCII characters in the
+// first 64 KiB. Otherwise the content is scanned as UTF-8: files with invalid byte
+// sequences and no valid multi-byte sequence are treated as a single-byte legacy
+// encoding (Windows-1252 if C1 bytes 0x80-0x9F occur, ISO-8859-1 otherwise), while
+// files mixing both are reported as UTF-8 with invalid sequences.
+func DetectEncoding(r io.Reader) (EncodingInfo, error) {
+	br := bufio.NewReaderSize(r, encodingSampleSize)
+	sample, err := br.Peek(encodingSampleSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return EncodingInfo{}, err
+	}
+
+	switch {
+	case bytes.HasPrefix(sample, utf8BOM):
+		return detectUTF8(br, EncodingInfo{Encoding: EncodingUTF8, BOM: true})
+	case bytes.HasPrefix(sample, utf16LEBOM):
+		return EncodingInfo{Encoding: EncodingUTF16LE, BOM: true}, nil
+	case bytes.HasPrefix(sample, utf16BEBOM):
+		return EncodingInfo{Encoding: EncodingUTF16BE, BOM: true}, nil
+	}
+
+	if enc := detectUTF16WithoutBOM(sample); enc != "" {
+		return EncodingInfo{Encoding: enc}, nil
+	}
+	return detectUTF8(br, EncodingInfo{Encoding: EncodingUTF8})
+}
+
+// detectUTF16WithoutBOM looks for the NUL high bytes of ASCII text encoded as UTF-16
+func detectUTF16WithoutBOM(sample []byte) Encoding {
+	pairs := len(sample) / 2
+	if pairs < 2 {
+		return ""
+	}
+
+	var evenZeros, oddZeros int
+	for i := 0; i+1 < len(sample); i += 2 {
+		if sample[i] == 0 {
+			evenZeros++
+		}
+		if sample[i+1] == 0 {
+			oddZeros++
+		}
+	}
+
+	switch {
+	case oddZeros*10 > pairs*3 && evenZeros*10 < pairs:
+		return EncodingUTF16LE
+	case evenZeros*10 > pairs*3 && oddZeros*10 < pairs:
+		return EncodingUTF16BE
+	default:
+		return ""
+	}
+}
+
+// utf8Scan accumulates what was seen while scanning content as UTF-8
+type utf8Scan struct {
+	invalid   bool // an invalid byte sequence was found
+	multibyte bool // a valid multi-byte sequence was found
+	c1        bool // an invalid byte in the C1 range 0x80-0x9F was found
+}
+
+// scan processes p and returns the number of trailing bytes that may be the
+// start of a rune continued in the next chunk
+func (s *utf8Scan) scan(p []byte, atEOF bool) int {
+	for i := 0; i < len(p); {
+		if p[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(p[i:])
+		if r == utf8.RuneError && size == 1 {
+			if !atEOF && !utf8.FullRune(p[i:]) {
+				return len(p) - i
+			}
+			s.invalid = true
+			if p[i] <= 0x9F {
+				s.c1 = true
+			}
+			i++
+			continue
+		}
+		s.multibyte = true
+		i += size
+	}
+	return 0
+}
+
+// detectUTF8 scans r as UTF-8 and classifies files with invalid sequences
+func detectUTF8(r io.Reader, info EncodingInfo) (EncodingInfo, error) {
+	var scan utf8Scan
+	buf := make([]byte, 32*1024+utf8.UTFMax)
+	carry := 0
+
+	for {
+		n, err := r.Read(buf[carry:])
+		atEOF := err == io.EOF
+		if err != nil && !atEOF {
+			return info, err
+		}
+
+		chunk := buf[:carry+n]
+		carry = scan.scan(chunk, atEOF)
+		copy(buf, chunk[len(chunk)-carry:])
+
+		// A valid multi-byte sequence rules out a legacy encoding, so stop early
+		if atEOF || (scan.invalid && scan.multibyte) {
+			break
+		}
+	}
+
+	switch {
+	case !scan.invalid:
+	case scan.multibyte || info.BOM:
+		info.InvalidUTF8 = true
+	case scan.c1:
+		info.Encoding = EncodingWindows1252
+	default:
+		info.Encoding = EncodingISO88591
+	}
+	return info, nil
+}
+
+// encodingState holds the detected encodings and transcoding setting of a FeedLoader
+type encodingState struct {
+	transcode  bool
+	encodingMu sync.Mutex
+	encodings  map[string]EncodingInfo
+}
+
+// EnableTranscoding makes GetFile decode files detected as non-UTF-8 to UTF-8,
+// so the rest of the feed can be validated. Invalid sequences in UTF-8 files are
+// replaced with U+FFFD. Call this method before starting validation.
+func (l *FeedLoader) EnableTranscoding() {
+	l.transcode = true
+}
+
+// TranscodingEnabled reports whether GetFile transcodes non-UTF-8 files
+func (l *FeedLoader) TranscodingEnabled() bool {
+	return l.transcode
+}
+
+// DetectFileEncoding detects the character encoding of a feed file.
+// Results are cached, so each file is scanned at most once.
+func (l *FeedLoader) DetectFileEncoding(filename string) (EncodingInfo, error) {
+	l.encodingMu.Lock()
+	info, ok := l.encodings[filename]
+	l.encodingMu.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	reader, err := l.GetRawFile(filename)
+	if err != nil {
+		return EncodingInfo{}, err
+	}
+	defer reader.Close()
+
+	info, err = DetectEncoding(reader)
+	if err != nil {
+		return EncodingInfo{}, err
+	}
+
+	l.encodingMu.Lock()
+	if l.encodings == nil {
+		l.encodings = make(map[string]EncodingInfo)
+	}
+	l.encodings[filename] = info
+	l.encodingMu.Unlock()
+	return info, nil
+}
+
+// transcodeFile wraps reader with a UTF-8 decoder if the file needs one
+func (l *FeedLoader) transcodeFile(filename string, reader io.ReadCloser) (io.ReadCloser, error) {
+	info, err := l.DetectFileEncoding(filename)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+
+	decoder := info.decoder()
+	if decoder == nil {
+		return reader, nil
+	}
+	return &transcodingReader{Reader: transform.NewReader(reader, decoder), closer: reader}, nil
+}
+
+// transcodingReader reads decoded content and closes the underlying file
+type transcodingReader struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (r *transcodingReader) Close() error {
+	return r.closer.Close()
+}
@@ -0,0 +1,173 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/unicode"
+)
+
+// utf16Bytes encodes s as UTF-16 with the given endianness and BOM policy
+func utf16Bytes(t *testing.T, s string, endianness unicode.Endianness, bom unicode.BOMPolicy) string {
+	t.Helper()
+
+	encoded, err := unicode.UTF16(endianness, bom).NewEncoder().String(s)
+	if err != nil {
+		t.Fatalf("Failed to encode UTF-16: %v", err)
+	}
+	return encoded
+}
+
+func TestDetectEncoding(t *testing.T) {
+	stops := "stop_id,stop_name\nS1,Gare de Lyon\n"
+
+	tests := []struct {
+		name     string
+		content  string
+		expected EncodingInfo
+	}{
+		{
+			name:     "ascii",
+			content:  stops,
+			expected: EncodingInfo{Encoding: EncodingUTF8},
+		},
+		{
+			name:     "utf-8 with accents",
+			content:  "stop_id,stop_name\nS1,Plaça d'Espanya\n",
+			expected: EncodingInfo{Encoding: EncodingUTF8},
+		},
+		{
+			name:     "utf-8 with BOM",
+			content:  "\ufeff" + stops,
+			expected: EncodingInfo{Encoding: EncodingUTF8, BOM: true},
+		},
+		{
+			name:     "utf-8 with an invalid sequence",
+			content:  "stop_id,stop_name\nS1,Plaça\nS2,Caf\xe9\n",
+			expected: EncodingInfo{Encoding: EncodingUTF8, InvalidUTF8: true},
+		},
+		{
+			name:     "iso-8859-1",
+			content:  "stop_id,stop_name\nS1,Pla\xe7a d'Espanya\n",
+			expected: EncodingInfo{Encoding: EncodingISO88591},
+		},
+		{
+			name:     "windows-1252 with curly quotes",
+			content:  "stop_id,stop_name\nS1,\x93Caf\xe9\x94\n",
+			expected: EncodingInfo{Encoding: EncodingWindows1252},
+		},
+		{
+			name:     "utf-16le with BOM",
+			content:  utf16Bytes(t, stops, unicode.LittleEndian, unicode.UseBOM),
+			expected: EncodingInfo{Encoding: EncodingUTF16LE, BOM: true},
+		},
+		{
+			name:     "utf-16be with BOM",
+			content:  utf16Bytes(t, stops, unicode.BigEndian, unicode.UseBOM),
+			expected: EncodingInfo{Encoding: EncodingUTF16BE, BOM: true},
+		},
+		{
+			name:     "utf-16le without BOM",
+			content:  utf16Bytes(t, stops, unicode.LittleEndian, unicode.IgnoreBOM),
+			expected: EncodingInfo{Encoding: EncodingUTF16LE},
+		},
+		{
+			name:     "multi-byte rune across read chunks",
+			content:  strings.Repeat("a", 32*1024-1) + "é\n",
+			expected: EncodingInfo{Encoding: EncodingUTF8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := DetectEncoding(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("DetectEncoding failed: %v", err)
+			}
+			if info != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, info)
+			}
+		})
+	}
+}
+
+func TestFeedLoader_Transcoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "windows-1252",
+			content:  "stop_id,stop_name\nS1,\x93Caf\xe9\x94\n",
+			expected: "“Café”",
+		},
+		{
+			name:     "iso-8859-1",
+			content:  "stop_id,stop_name\nS1,Pla\xe7a\n",
+			expected: "Plaça",
+		},
+		{
+			name:     "utf-16le with BOM",
+			content:  utf16Bytes(t, "stop_id,stop_name\nS1,Plaça\n", unicode.LittleEndian, unicode.UseBOM),
+			expected: "Plaça",
+		},
+		{
+			name:     "invalid sequence replaced",
+			content:  "stop_id,stop_name\nS1,Plaça \xff\n",
+			expected: "Plaça �",
+		},
+		{
+			name:     "utf-8 unchanged",
+			content:  "stop_id,stop_name\nS1,Plaça\n",
+			expected: "Plaça",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildZip(t, map[string]string{"stops.txt": tt.content})
+			loader, err := LoadFromZipReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatalf("Failed to load zip: %v", err)
+			}
+			loader.EnableTranscoding()
+
+			reader, err := loader.GetFile("stops.txt")
+			if err != nil {
+				t.Fatalf("GetFile failed: %v", err)
+			}
+			defer reader.Close()
+
+			csvFile, err := NewCSVFile(reader, "stops.txt")
+			if err != nil {
+				t.Fatalf("NewCSVFile failed: %v", err)
+			}
+			if csvFile.Headers[0] != "stop_id" {
+				t.Errorf("expected stop_id header, got %q", csvFile.Headers[0])
+			}
+			row, err := csvFile.ReadRow()
+			if err != nil {
+				t.Fatalf("ReadRow failed: %v", err)
+			}
+			if row.Values["stop_name"] != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, row.Values["stop_name"])
+			}
+
+			raw, err := loader.GetRawFile("stops.txt")
+			if err != nil {
+				t.Fatalf("GetRawFile failed: %v", err)
+			}
+			defer raw.Close()
+			content, err := io.ReadAll(raw)
+			if err != nil {
+				t.Fatalf("Failed to read raw file: %v", err)
+			}
+			if string(content) != tt.content {
+				t.Error("expected GetRawFile to return the original bytes")
+			}
+		})
+	}
+}
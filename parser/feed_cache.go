@@ -3,9 +3,9 @@ package parser
 import (
 	"fmt"
 	"io"
-	"log"
 	"sync"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/schema"
 )
 
@@ -34,6 +34,10 @@ type ParsedFeedCache struct {
 
 	// Reference to loader for on-demand file access
 	loader *FeedLoader
+
+	// Optional access recorder
+	recordMu sync.RWMutex
+	recorder func(dataset string, hit bool)
 }
 
 // NewParsedFeedCache creates a new feed cache attached to the given loader.
@@ -53,16 +57,20 @@ func (c *ParsedFeedCache) GetStopTimes() ([]*schema.StopTime, error) {
 	if c.loadedFiles["stop_times.txt"] {
 		result := c.stopTimes
 		c.mu.RUnlock()
+		c.recordAccess("stop_times.txt", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
 	// Slow path: need to load (write lock)
+	hit := false
+	defer func() { c.recordAccess("stop_times.txt", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check: another goroutine may have loaded it
 	if c.loadedFiles["stop_times.txt"] {
+		hit = true
 		return c.stopTimes, nil
 	}
 
@@ -86,16 +94,20 @@ func (c *ParsedFeedCache) GetTrips() ([]*schema.Trip, error) {
 	if c.loadedFiles["trips.txt"] {
 		result := c.trips
 		c.mu.RUnlock()
+		c.recordAccess("trips.txt", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
 	// Slow path: need to load (write lock)
+	hit := false
+	defer func() { c.recordAccess("trips.txt", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check: another goroutine may have loaded it
 	if c.loadedFiles["trips.txt"] {
+		hit = true
 		return c.trips, nil
 	}
 
@@ -119,16 +131,20 @@ func (c *ParsedFeedCache) GetStops() ([]*schema.Stop, error) {
 	if c.loadedFiles["stops.txt"] {
 		result := c.stops
 		c.mu.RUnlock()
+		c.recordAccess("stops.txt", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
 	// Slow path: need to load (write lock)
+	hit := false
+	defer func() { c.recordAccess("stops.txt", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check: another goroutine may have loaded it
 	if c.loadedFiles["stops.txt"] {
+		hit = true
 		return c.stops, nil
 	}
 
@@ -152,16 +168,20 @@ func (c *ParsedFeedCache) GetRoutes() ([]*schema.Route, error) {
 	if c.loadedFiles["routes.txt"] {
 		result := c.routes
 		c.mu.RUnlock()
+		c.recordAccess("routes.txt", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
 	// Slow path: need to load (write lock)
+	hit := false
+	defer func() { c.recordAccess("routes.txt", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check: another goroutine may have loaded it
 	if c.loadedFiles["routes.txt"] {
+		hit = true
 		return c.routes, nil
 	}
 
@@ -301,15 +321,19 @@ func (c *ParsedFeedCache) GetStopTimesByTrip() (map[string][]*schema.StopTime, e
 	if c.stopTimesByTrip != nil {
 		result := c.stopTimesByTrip
 		c.mu.RUnlock()
+		c.recordAccess("stop_times_by_trip", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
+	hit := false
+	defer func() { c.recordAccess("stop_times_by_trip", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check
 	if c.stopTimesByTrip != nil {
+		hit = true
 		return c.stopTimesByTrip, nil
 	}
 
@@ -340,15 +364,19 @@ func (c *ParsedFeedCache) GetTripsByRoute() (map[string][]*schema.Trip, error) {
 	if c.tripsByRoute != nil {
 		result := c.tripsByRoute
 		c.mu.RUnlock()
+		c.recordAccess("trips_by_route", true)
 		return result, nil
 	}
 	c.mu.RUnlock()
 
+	hit := false
+	defer func() { c.recordAccess("trips_by_route", hit) }()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Double-check
 	if c.tripsByRoute != nil {
+		hit = true
 		return c.tripsByRoute, nil
 	}
 
@@ -389,6 +417,27 @@ func (c *ParsedFeedCache) Clear() {
 	c.loadedFiles = make(map[string]bool)
 }
 
+// SetAccessRecorder registers a function called on every access to a data set
+// of the cache: the parsed files (e.g. "stop_times.txt") and the grouped indexes
+// "stop_times_by_trip" and "trips_by_route". hit is false when the access had
+// to load or build the data. Lookups by ID are not recorded. The function may
+// be called from several goroutines. Pass nil to stop recording.
+func (c *ParsedFeedCache) SetAccessRecorder(recorder func(dataset string, hit bool)) {
+	c.recordMu.Lock()
+	c.recorder = recorder
+	c.recordMu.Unlock()
+}
+
+// recordAccess reports a data set access to the registered recorder, if any
+func (c *ParsedFeedCache) recordAccess(dataset string, hit bool) {
+	c.recordMu.RLock()
+	recorder := c.recorder
+	c.recordMu.RUnlock()
+	if recorder != nil {
+		recorder(dataset, hit)
+	}
+}
+
 // GetLoader returns the underlying FeedLoader.
 // This is useful for validators that need to access files not in the cache.
 func (c *ParsedFeedCache) GetLoader() *FeedLoader {
@@ -404,11 +453,11 @@ func (c *ParsedFeedCache) loadStopTimesInternal() ([]*schema.StopTime, error) {
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close stop_times.txt reader: %v", closeErr)
+			c.loader.Logger().Warn("Failed to close reader", logging.String("file", "stop_times.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := NewCSVFile(reader, "stop_times.txt")
+	csvFile, err := NewCSVFileWithOptions(reader, "stop_times.txt", c.loader.CSVOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse stop_times.txt: %w", err)
 	}
@@ -439,11 +488,11 @@ func (c *ParsedFeedCache) loadTripsInternal() ([]*schema.Trip, error) {
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close trips.txt reader: %v", closeErr)
+			c.loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := NewCSVFile(reader, "trips.txt")
+	csvFile, err := NewCSVFileWithOptions(reader, "trips.txt", c.loader.CSVOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse trips.txt: %w", err)
 	}
@@ -474,11 +523,11 @@ func (c *ParsedFeedCache) loadStopsInternal() ([]*schema.Stop, error) {
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close stops.txt reader: %v", closeErr)
+			c.loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := NewCSVFile(reader, "stops.txt")
+	csvFile, err := NewCSVFileWithOptions(reader, "stops.txt", c.loader.CSVOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse stops.txt: %w", err)
 	}
@@ -509,11 +558,11 @@ func (c *ParsedFeedCache) loadRoutesInternal() ([]*schema.Route, error) {
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close routes.txt reader: %v", closeErr)
+			c.loader.Logger().Warn("Failed to close reader", logging.String("file", "routes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := NewCSVFile(reader, "routes.txt")
+	csvFile, err := NewCSVFileWithOptions(reader, "routes.txt", c.loader.CSVOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse routes.txt: %w", err)
 	}
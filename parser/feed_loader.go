@@ -4,65 +4,147 @@ import (
 	"archive/zip"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 )
 
 // FeedLoader loads GTFS feeds from various sources
 type FeedLoader struct {
-	files     map[string]io.ReadCloser // For ZIP files (deprecated approach)
-	filePaths map[string]string        // For directory files
-	zipReader *zip.ReadCloser          // For ZIP files (new approach)
-	zipFiles  map[string]*zip.File     // For ZIP files (new approach)
-	isDir     bool                     // True if loading from directory
-	cache     *ParsedFeedCache         // Optional cache for parsed data (nil = disabled)
+	files      map[string]io.ReadCloser // For ZIP files (deprecated approach)
+	filePaths  map[string]string        // For directory files
+	zipReader  *zip.ReadCloser          // For ZIP files (new approach)
+	zipFiles   map[string]*zip.File     // For ZIP files (new approach)
+	isDir      bool                     // True if loading from directory or fs.FS
+	fsys       fs.FS                    // For fs.FS feeds; filePaths are relative to it
+	cache      *ParsedFeedCache         // Optional cache for parsed data (nil = disabled)
+	logger     logging.Logger           // Optional logger (nil = warnings to stderr)
+	recoverCSV bool                     // Parse CSV files in recovery mode
+
+	archiveEntries []ArchiveEntry // ZIP file entries in archive order
+	archiveIssues  []ArchiveIssue // ZIP layout problems found while loading
+	limitedLoaderState
+	encodingState
+	accessState
 }
 
 // LoadFromZip loads a GTFS feed from a zip file
 func LoadFromZip(zipPath string) (*FeedLoader, error) {
+	return LoadFromZipWithOptions(zipPath, ArchiveOptions{})
+}
+
+// LoadFromZipWithOptions loads a GTFS feed from a zip file using the given archive options
+func LoadFromZipWithOptions(zipPath string, options ArchiveOptions) (*FeedLoader, error) {
+	if options.Limits.MaxArchiveSize > 0 {
+		info, err := os.Stat(zipPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open zip file: %v", err)
+		}
+		if err := options.Limits.CheckArchiveSize(info.Size()); err != nil {
+			return nil, err
+		}
+	}
+
 	reader, err := zip.OpenReader(zipPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open zip file: %v", err)
 	}
 
+	loader, err := newZipLoader(reader.File, options)
+	if err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+	loader.zipReader = reader
+	return loader, nil
+}
+
+// LoadFromZipReader loads a GTFS feed from a zip archive held in memory or
+// any other random-access source, e.g. bytes.NewReader or an object store adapter.
+// The reader must stay valid until the loader is no longer used.
+func LoadFromZipReader(r io.ReaderAt, size int64) (*FeedLoader, error) {
+	return LoadFromZipReaderWithOptions(r, size, ArchiveOptions{})
+}
+
+// LoadFromZipReaderWithOptions loads a GTFS feed from a zip archive reader using the given archive options
+func LoadFromZipReaderWithOptions(r io.ReaderAt, size int64, options ArchiveOptions) (*FeedLoader, error) {
+	if err := options.Limits.CheckArchiveSize(size); err != nil {
+		return nil, err
+	}
+
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip archive: %v", err)
+	}
+
+	return newZipLoader(reader.File, options)
+}
+
+// newZipLoader checks the archive limits and maps the GTFS files of a zip archive for multiple access
+func newZipLoader(files []*zip.File, options ArchiveOptions) (*FeedLoader, error) {
+	if err := options.Limits.checkArchiveHeaders(files); err != nil {
+		return nil, err
+	}
+
 	loader := &FeedLoader{
 		files:     make(map[string]io.ReadCloser),
 		filePaths: make(map[string]string),
-		zipReader: reader,
 		zipFiles:  make(map[string]*zip.File),
 		isDir:     false,
 	}
+	loader.limits = options.Limits
+	loader.entryBytes = make(map[string]uint64)
+
+	loader.mapArchiveFiles(files, options)
+	return loader, nil
+}
+
+// LoadFromDirectory loads a GTFS feed from a directory
+func LoadFromDirectory(dirPath string) (*FeedLoader, error) {
+	loader := &FeedLoader{
+		files:     make(map[string]io.ReadCloser),
+		filePaths: make(map[string]string),
+		isDir:     true,
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %v", err)
+	}
 
-	// Map ZIP files for multiple access
-	for _, file := range reader.File {
-		if file.FileInfo().IsDir() {
+	for _, entry := range entries {
+		if entry.IsDir() {
 			continue
 		}
 
-		// Only include .txt and .geojson files at root level
-		name := filepath.Base(file.Name)
-		if !strings.HasSuffix(name, ".txt") && !strings.HasSuffix(name, ".geojson") {
+		name := entry.Name()
+		if !isFeedFile(name) {
 			continue
 		}
 
-		loader.zipFiles[name] = file
+		filePath := filepath.Join(dirPath, name)
+		loader.filePaths[name] = filePath
 	}
 
 	return loader, nil
 }
 
-// LoadFromDirectory loads a GTFS feed from a directory
-func LoadFromDirectory(dirPath string) (*FeedLoader, error) {
+// LoadFromFS loads a GTFS feed from the root of a file system such as
+// embed.FS, fstest.MapFS or os.DirFS. Nothing is written to disk.
+func LoadFromFS(fsys fs.FS) (*FeedLoader, error) {
 	loader := &FeedLoader{
 		files:     make(map[string]io.ReadCloser),
 		filePaths: make(map[string]string),
 		isDir:     true,
+		fsys:      fsys,
 	}
 
-	entries, err := os.ReadDir(dirPath)
+	entries, err := fs.ReadDir(fsys, ".")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %v", err)
+		return nil, fmt.Errorf("failed to read file system: %v", err)
 	}
 
 	for _, entry := range entries {
@@ -71,25 +153,43 @@ func LoadFromDirectory(dirPath string) (*FeedLoader, error) {
 		}
 
 		name := entry.Name()
-		if !strings.HasSuffix(name, ".txt") && !strings.HasSuffix(name, ".geojson") {
+		if !isFeedFile(name) {
 			continue
 		}
 
-		filePath := filepath.Join(dirPath, name)
-		loader.filePaths[name] = filePath
+		loader.filePaths[name] = name
 	}
 
 	return loader, nil
 }
 
-// GetFile returns a reader for the specified GTFS file
+// isFeedFile reports whether a file name is a GTFS text or GeoJSON file
+func isFeedFile(name string) bool {
+	return strings.HasSuffix(name, ".txt") || strings.HasSuffix(name, ".geojson")
+}
+
+// GetFile returns a reader for the specified GTFS file. When transcoding is
+// enabled, files detected as non-UTF-8 are decoded to UTF-8.
 func (l *FeedLoader) GetFile(filename string) (io.ReadCloser, error) {
+	reader, err := l.GetRawFile(filename)
+	if err == nil && l.transcode {
+		reader, err = l.transcodeFile(filename, reader)
+	}
+	return reader, err
+}
+
+// GetRawFile returns a reader for the specified GTFS file bytes as stored in the feed
+func (l *FeedLoader) GetRawFile(filename string) (io.ReadCloser, error) {
+	l.recordAccess(filename)
 	if l.isDir {
 		// For directory files, open a fresh reader each time
 		filePath, exists := l.filePaths[filename]
 		if !exists {
 			return nil, fmt.Errorf("file not found: %s", filename)
 		}
+		if l.fsys != nil {
+			return l.fsys.Open(filePath)
+		}
 		return os.Open(filePath) // #nosec G304 -- GTFS file path from validated directory
 	} else {
 		// For ZIP files, open a fresh reader each time
@@ -97,12 +197,23 @@ func (l *FeedLoader) GetFile(filename string) (io.ReadCloser, error) {
 		if !exists {
 			return nil, fmt.Errorf("file not found: %s", filename)
 		}
-		return zipFile.Open()
+		if l.limits.IsZero() {
+			return zipFile.Open()
+		}
+		if err := l.LimitError(); err != nil {
+			return nil, err
+		}
+		reader, err := zipFile.Open()
+		if err != nil {
+			return nil, err
+		}
+		return &limitedEntryReader{ReadCloser: reader, loader: l, path: zipFile.Name, compressed: zipFile.CompressedSize64}, nil
 	}
 }
 
 // HasFile returns true if the specified file exists in the feed
 func (l *FeedLoader) HasFile(filename string) bool {
+	l.recordAccess(filename)
 	if l.isDir {
 		_, exists := l.filePaths[filename]
 		return exists
@@ -114,6 +225,7 @@ func (l *FeedLoader) HasFile(filename string) bool {
 
 // ListFiles returns a list of all files in the feed
 func (l *FeedLoader) ListFiles() []string {
+	l.recordAccess(AllFiles)
 	if l.isDir {
 		files := make([]string, 0, len(l.filePaths))
 		for filename := range l.filePaths {
@@ -129,6 +241,34 @@ func (l *FeedLoader) ListFiles() []string {
 	}
 }
 
+// FileSize returns the size in bytes of the specified GTFS file as stored in
+// the feed (uncompressed for ZIP archives) and whether the size is known
+func (l *FeedLoader) FileSize(filename string) (int64, bool) {
+	if l.isDir {
+		filePath, exists := l.filePaths[filename]
+		if !exists {
+			return 0, false
+		}
+		var info fs.FileInfo
+		var err error
+		if l.fsys != nil {
+			info, err = fs.Stat(l.fsys, filePath)
+		} else {
+			info, err = os.Stat(filePath)
+		}
+		if err != nil {
+			return 0, false
+		}
+		return info.Size(), true
+	}
+
+	zipFile, exists := l.zipFiles[filename]
+	if !exists {
+		return 0, false
+	}
+	return int64(zipFile.UncompressedSize64), true // #nosec G115 -- sizes checked by archive limits
+}
+
 // Close closes all open file readers
 func (l *FeedLoader) Close() error {
 	var firstErr error
@@ -179,6 +319,21 @@ var OptionalFiles = []string{
 	"attributions.txt",
 }
 
+// SetLogger sets the logger used by the loader, its cache and the validators
+// reading the feed. Call it before starting validation.
+func (l *FeedLoader) SetLogger(logger logging.Logger) {
+	l.logger = logger
+}
+
+// Logger returns the logger set with SetLogger, or a logger writing warnings
+// and errors to stderr if none was set.
+func (l *FeedLoader) Logger() logging.Logger {
+	if l.logger == nil {
+		return logging.NewStderrLogger()
+	}
+	return l.logger
+}
+
 // EnableCaching enables the parsed feed cache for this loader.
 // When enabled, frequently-accessed files (stop_times, trips, stops, routes)
 // are loaded once and shared across all validators, significantly reducing
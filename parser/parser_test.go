@@ -1,11 +1,16 @@
 package parser
 
 import (
+	"archive/zip"
+	"bytes"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestCSVFile_Basic(t *testing.T) {
@@ -251,6 +256,83 @@ func TestFeedLoader_GetNonExistentFile(t *testing.T) {
 	}
 }
 
+func TestFeedLoader_FromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"agency.txt":      {Data: []byte("agency_id,agency_name\ntest_agency,Test Agency")},
+		"routes.txt":      {Data: []byte("route_id,route_short_name\nroute_1,1")},
+		"README.md":       {Data: []byte("not a feed file")},
+		"nested/stop.txt": {Data: []byte("stop_id\nS1")},
+	}
+
+	loader, err := LoadFromFS(fsys)
+	if err != nil {
+		t.Fatalf("Failed to load from fs: %v", err)
+	}
+
+	files := loader.ListFiles()
+	sort.Strings(files)
+	if strings.Join(files, ",") != "agency.txt,routes.txt" {
+		t.Errorf("Expected only root-level feed files, got %v", files)
+	}
+
+	reader, err := loader.GetFile("agency.txt")
+	if err != nil {
+		t.Fatalf("Failed to get agency.txt: %v", err)
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			log.Printf("Warning: failed to close %v", closeErr)
+		}
+	}()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Failed to read agency.txt: %v", err)
+	}
+	if !strings.Contains(string(data), "test_agency") {
+		t.Errorf("Unexpected agency.txt content: %s", data)
+	}
+
+	if _, err := loader.GetFile("stop.txt"); err == nil {
+		t.Error("Expected error for file in subdirectory")
+	}
+}
+
+func TestFeedLoader_FromZipReader(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"agency.txt": "agency_id,agency_name\ntest_agency,Test Agency",
+		"notes.pdf":  "ignored",
+	} {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write zip entry: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close zip writer: %v", err)
+	}
+
+	loader, err := LoadFromZipReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Failed to load from zip reader: %v", err)
+	}
+	if !loader.HasFile("agency.txt") || loader.HasFile("notes.pdf") {
+		t.Errorf("Unexpected files: %v", loader.ListFiles())
+	}
+	if err := loader.Close(); err != nil {
+		t.Errorf("Close() failed: %v", err)
+	}
+
+	if _, err := LoadFromZipReader(strings.NewReader("not a zip"), 9); err == nil {
+		t.Error("Expected error for invalid zip data")
+	}
+}
+
 // Test the required files constant
 func TestRequiredFiles(t *testing.T) {
 	expectedFiles := []string{
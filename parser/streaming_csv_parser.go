@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/pools"
 )
 
@@ -22,6 +23,7 @@ type StreamingCSVParser struct {
 	rowCounter   int
 	bufferSize   int
 	lastReadTime time.Time
+	logger       logging.Logger
 }
 
 // StreamingCSVOptions configures the streaming CSV parser
@@ -34,6 +36,9 @@ type StreamingCSVOptions struct {
 
 	// TrimLeadingSpace trims leading space in CSV fields
 	TrimLeadingSpace bool
+
+	// Logger receives warnings (default: warnings to stderr)
+	Logger logging.Logger
 }
 
 // DefaultStreamingCSVOptions returns sensible defaults for streaming CSV parsing
@@ -80,6 +85,7 @@ func NewStreamingCSVParser(reader io.Reader, filename string, opts *StreamingCSV
 		rowCounter:   1, // Start at 1 (header is row 1)
 		bufferSize:   opts.BufferSize,
 		lastReadTime: time.Now(),
+		logger:       opts.Logger,
 	}, nil
 }
 
@@ -168,13 +174,22 @@ type StreamingCSVBatchProcessor interface {
 	ProcessingComplete() error
 }
 
+// warn logs a warning about the file with the configured logger
+func (s *StreamingCSVParser) warn(msg string, err error) {
+	logger := s.logger
+	if logger == nil {
+		logger = logging.NewStderrLogger()
+	}
+	logger.Warn(msg, logging.String("file", s.filename), logging.ErrorField("error", err))
+}
+
 // ProcessStream processes the entire CSV stream using the given processor
 func (s *StreamingCSVParser) ProcessStream(ctx context.Context, processor StreamingCSVProcessor) error {
 	defer func() {
 		// Ensure processor cleanup is called
 		if err := processor.ProcessingComplete(); err != nil {
 			// Log error but don't return it as main processing might have succeeded
-			fmt.Printf("Warning: ProcessingComplete failed: %v\n", err)
+			s.warn("ProcessingComplete failed", err)
 		}
 	}()
 
@@ -216,7 +231,7 @@ func (s *StreamingCSVParser) ProcessStreamInBatches(ctx context.Context, process
 		// Ensure processor cleanup is called
 		if err := processor.ProcessingComplete(); err != nil {
 			// Log error but don't return it as main processing might have succeeded
-			fmt.Printf("Warning: ProcessingComplete failed: %v\n", err)
+			s.warn("ProcessingComplete failed", err)
 		}
 	}()
 
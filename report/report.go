@@ -50,6 +50,8 @@ type NoticeReport struct {
 	Description   string                   `json:"description"`
 	TotalNotices  int                      `json:"totalNotices"`
 	SampleNotices []map[string]interface{} `json:"sampleNotices"`
+	// SampleLocations holds the location of each sample notice, in the same order
+	SampleLocations []notice.NoticeLocation `json:"sampleLocations,omitempty"`
 }
 
 // ReportGenerator generates validation reports
@@ -79,11 +81,12 @@ func (g *ReportGenerator) GenerateReport(container *notice.NoticeContainer, feed
 		}
 
 		report := NoticeReport{
-			Code:          code,
-			Severity:      notices[0].Severity().String(),
-			Description:   "", // Will be populated by the main package
-			TotalNotices:  len(notices),
-			SampleNotices: g.getSampleNotices(notices),
+			Code:            code,
+			Severity:        notices[0].Severity().String(),
+			Description:     "", // Will be populated by the main package
+			TotalNotices:    len(notices),
+			SampleNotices:   g.getSampleNotices(notices),
+			SampleLocations: g.getSampleLocations(notices),
 		}
 		noticeReports = append(noticeReports, report)
 	}
@@ -137,6 +140,21 @@ func (g *ReportGenerator) getSampleNotices(notices []notice.Notice) []map[string
 	return samples
 }
 
+// getSampleLocations returns the locations of the sampled notices
+func (g *ReportGenerator) getSampleLocations(notices []notice.Notice) []notice.NoticeLocation {
+	limit := g.maxSamplesPerNotice
+	if len(notices) < limit {
+		limit = len(notices)
+	}
+
+	locations := make([]notice.NoticeLocation, limit)
+	for i := 0; i < limit; i++ {
+		locations[i] = notices[i].Location()
+	}
+
+	return locations
+}
+
 // ToJSON converts the report to JSON
 func (r *ValidationReport) ToJSON() ([]byte, error) {
 	return json.MarshalIndent(r, "", "  ")
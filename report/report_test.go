@@ -12,19 +12,10 @@ func TestReportGenerator_GenerateReport(t *testing.T) {
 
 	// Add a mix of notices
 	for i := 0; i < 7; i++ { // exceed default sample cap (5)
-		container.AddNotice(notice.NewBaseNotice("invalid_url", notice.ERROR, map[string]interface{}{
-			"filename":     "agency.txt",
-			"fieldName":    "agency_url",
-			"fieldValue":   "not-a-url",
-			"csvRowNumber": i + 2,
-		}))
+		container.AddNotice(notice.NewInvalidURLNotice("agency.txt", "agency_url", "not-a-url", i+2))
 	}
 	for i := 0; i < 3; i++ {
-		container.AddNotice(notice.NewBaseNotice("whitespace_only_field", notice.WARNING, map[string]interface{}{
-			"filename":     "stops.txt",
-			"fieldName":    "stop_desc",
-			"csvRowNumber": i + 2,
-		}))
+		container.AddNotice(notice.NewWhitespaceOnlyFieldNotice("stops.txt", "stop_desc", i+2))
 	}
 
 	gen := NewReportGenerator("v0.0.0-test")
@@ -74,6 +65,12 @@ func TestReportGenerator_GenerateReport(t *testing.T) {
 	if len(invURL.SampleNotices) != 5 { // capped
 		t.Errorf("expected 5 sample notices, got %d", len(invURL.SampleNotices))
 	}
+	if len(invURL.SampleLocations) != len(invURL.SampleNotices) {
+		t.Fatalf("expected one location per sample, got %d", len(invURL.SampleLocations))
+	}
+	if loc := invURL.SampleLocations[0]; loc.File != "agency.txt" || loc.RowNumber != 2 || loc.FieldName != "agency_url" {
+		t.Errorf("unexpected sample location: %+v", loc)
+	}
 
 	wsOnly, ok := byCode["whitespace_only_field"]
 	if !ok {
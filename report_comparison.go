@@ -0,0 +1,282 @@
+package gtfsvalidator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ReportComparison describes what changed between two validation runs.
+type ReportComparison struct {
+	// Previous summarizes the baseline report.
+	Previous ComparedReport `json:"previous"`
+
+	// Current summarizes the report being compared against the baseline.
+	Current ComparedReport `json:"current"`
+
+	// SeverityDeltas contains the change in notice counts per severity (current - previous).
+	SeverityDeltas NoticeCounts `json:"severityDeltas"`
+
+	// NewCodes lists notice codes present only in the current report.
+	NewCodes []CodeChange `json:"newCodes"`
+
+	// ResolvedCodes lists notice codes present only in the previous report.
+	ResolvedCodes []CodeChange `json:"resolvedCodes"`
+
+	// ChangedCodes lists notice codes present in both reports whose counts differ.
+	ChangedCodes []CodeChange `json:"changedCodes"`
+
+	// NewlyAffectedEntities lists entities referenced by current sample notices
+	// that were not referenced by the same notice code in the previous report.
+	NewlyAffectedEntities []AffectedEntity `json:"newlyAffectedEntities"`
+}
+
+// ComparedReport contains the identifying information of one side of a comparison.
+type ComparedReport struct {
+	// FeedPath is the path of the validated feed.
+	FeedPath string `json:"feedPath"`
+
+	// Date is the validation timestamp.
+	Date string `json:"date"`
+
+	// ValidatorVersion is the version of the validator that produced the report.
+	ValidatorVersion string `json:"validatorVersion"`
+
+	// Counts contains notice counts by severity.
+	Counts NoticeCounts `json:"counts"`
+}
+
+// CodeChange describes how the number of notices for a single code changed.
+type CodeChange struct {
+	// Code is the notice type code.
+	Code string `json:"code"`
+
+	// Severity is the notice severity (taken from the current report when available).
+	Severity string `json:"severity"`
+
+	// PreviousCount is the number of notices in the previous report.
+	PreviousCount int `json:"previousCount"`
+
+	// CurrentCount is the number of notices in the current report.
+	CurrentCount int `json:"currentCount"`
+
+	// Delta is CurrentCount - PreviousCount.
+	Delta int `json:"delta"`
+}
+
+// AffectedEntity identifies a GTFS entity referenced by a notice.
+type AffectedEntity struct {
+	// Type is the entity type (e.g., "route", "stop", "trip").
+	Type string `json:"type"`
+
+	// ID is the entity identifier.
+	ID string `json:"id"`
+
+	// Code is the notice code that references the entity.
+	Code string `json:"code"`
+
+	// Severity is the severity of the notice.
+	Severity string `json:"severity"`
+}
+
+// CompareReports compares two validation reports and returns the differences.
+// The previous report is treated as the baseline.
+func CompareReports(previous, current *ValidationReport) *ReportComparison {
+	previous.mu.RLock()
+	defer previous.mu.RUnlock()
+	// A recursive read lock can deadlock behind a waiting writer
+	if current != previous {
+		current.mu.RLock()
+		defer current.mu.RUnlock()
+	}
+
+	comparison := &ReportComparison{
+		Previous: newComparedReport(previous),
+		Current:  newComparedReport(current),
+		SeverityDeltas: NoticeCounts{
+			Errors:   current.Summary.Counts.Errors - previous.Summary.Counts.Errors,
+			Warnings: current.Summary.Counts.Warnings - previous.Summary.Counts.Warnings,
+			Infos:    current.Summary.Counts.Infos - previous.Summary.Counts.Infos,
+			Total:    current.Summary.Counts.Total - previous.Summary.Counts.Total,
+		},
+		NewCodes:              []CodeChange{},
+		ResolvedCodes:         []CodeChange{},
+		ChangedCodes:          []CodeChange{},
+		NewlyAffectedEntities: []AffectedEntity{},
+	}
+
+	previousGroups := groupsByCode(previous.Notices)
+	currentGroups := groupsByCode(current.Notices)
+
+	for code, cur := range currentGroups {
+		prev, existed := previousGroups[code]
+		if !existed {
+			comparison.NewCodes = append(comparison.NewCodes, CodeChange{
+				Code:         code,
+				Severity:     cur.Severity,
+				CurrentCount: cur.TotalNotices,
+				Delta:        cur.TotalNotices,
+			})
+			continue
+		}
+		if cur.TotalNotices != prev.TotalNotices {
+			comparison.ChangedCodes = append(comparison.ChangedCodes, CodeChange{
+				Code:          code,
+				Severity:      cur.Severity,
+				PreviousCount: prev.TotalNotices,
+				CurrentCount:  cur.TotalNotices,
+				Delta:         cur.TotalNotices - prev.TotalNotices,
+			})
+		}
+	}
+
+	for code, prev := range previousGroups {
+		if _, stillPresent := currentGroups[code]; !stillPresent {
+			comparison.ResolvedCodes = append(comparison.ResolvedCodes, CodeChange{
+				Code:          code,
+				Severity:      prev.Severity,
+				PreviousCount: prev.TotalNotices,
+				Delta:         -prev.TotalNotices,
+			})
+		}
+	}
+
+	comparison.NewlyAffectedEntities = newlyAffectedEntities(previousGroups, currentGroups)
+
+	sortCodeChanges(comparison.NewCodes)
+	sortCodeChanges(comparison.ResolvedCodes)
+	sortCodeChanges(comparison.ChangedCodes)
+
+	return comparison
+}
+
+// HasRegressions returns true if the current report introduced new notice codes
+// or increased the number of errors.
+func (c *ReportComparison) HasRegressions() bool {
+	if c.SeverityDeltas.Errors > 0 {
+		return true
+	}
+	for _, change := range c.NewCodes {
+		if change.Severity == "ERROR" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasChanges returns true if anything differs between the two reports.
+func (c *ReportComparison) HasChanges() bool {
+	return len(c.NewCodes) > 0 || len(c.ResolvedCodes) > 0 || len(c.ChangedCodes) > 0 ||
+		len(c.NewlyAffectedEntities) > 0 || c.SeverityDeltas != (NoticeCounts{})
+}
+
+// newComparedReport extracts the identifying information of a report.
+func newComparedReport(r *ValidationReport) ComparedReport {
+	return ComparedReport{
+		FeedPath:         r.Summary.FeedInfo.FeedPath,
+		Date:             r.Summary.Date,
+		ValidatorVersion: r.Summary.ValidatorVersion,
+		Counts:           r.Summary.Counts,
+	}
+}
+
+// groupsByCode indexes notice groups by code, merging duplicates.
+func groupsByCode(groups []NoticeGroup) map[string]NoticeGroup {
+	result := make(map[string]NoticeGroup, len(groups))
+	for _, group := range groups {
+		if existing, exists := result[group.Code]; exists {
+			existing.TotalNotices += group.TotalNotices
+			existing.SampleNotices = append(existing.SampleNotices, group.SampleNotices...)
+			existing.SampleLocations = append(existing.SampleLocations, group.SampleLocations...)
+			result[group.Code] = existing
+			continue
+		}
+		result[group.Code] = group
+	}
+	return result
+}
+
+// newlyAffectedEntities returns entities referenced by current samples that
+// were not referenced by the same code in the previous samples.
+func newlyAffectedEntities(previous, current map[string]NoticeGroup) []AffectedEntity {
+	seenBefore := make(map[string]bool)
+	for code, group := range previous {
+		for _, location := range group.Locations() {
+			for _, entity := range location.RelatedEntities {
+				seenBefore[entityKey(code, entity.Type, entity.ID)] = true
+			}
+		}
+	}
+
+	var result []AffectedEntity
+	reported := make(map[string]bool)
+	for code, group := range current {
+		for _, location := range group.Locations() {
+			for _, entity := range location.RelatedEntities {
+				key := entityKey(code, entity.Type, entity.ID)
+				if seenBefore[key] || reported[key] {
+					continue
+				}
+				reported[key] = true
+				result = append(result, AffectedEntity{
+					Type:     entity.Type,
+					ID:       entity.ID,
+					Code:     code,
+					Severity: group.Severity,
+				})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		if result[i].ID != result[j].ID {
+			return result[i].ID < result[j].ID
+		}
+		return result[i].Code < result[j].Code
+	})
+
+	if result == nil {
+		return []AffectedEntity{}
+	}
+	return result
+}
+
+// SignedDelta formats a count delta with an explicit sign, e.g. "+3" or "-2".
+func SignedDelta(delta int) string {
+	if delta > 0 {
+		return fmt.Sprintf("+%d", delta)
+	}
+	return fmt.Sprintf("%d", delta)
+}
+
+// entityKey builds a unique key for an entity referenced by a notice code.
+func entityKey(code, entityType, id string) string {
+	return code + "\x00" + entityType + "\x00" + id
+}
+
+// sortCodeChanges orders changes by severity (errors first), then by code.
+func sortCodeChanges(changes []CodeChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		ri, rj := severityRank(changes[i].Severity), severityRank(changes[j].Severity)
+		if ri != rj {
+			return ri < rj
+		}
+		return changes[i].Code < changes[j].Code
+	})
+}
+
+// severityRank returns a sort rank for a severity string (errors first).
+func severityRank(severity string) int {
+	switch severity {
+	case "ERROR":
+		return 0
+	case "WARNING":
+		return 1
+	case "INFO":
+		return 2
+	default:
+		return 3
+	}
+}
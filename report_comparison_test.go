@@ -0,0 +1,186 @@
+package gtfsvalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func newComparisonTestReports() (*ValidationReport, *ValidationReport) {
+	previous := &ValidationReport{
+		Summary: Summary{
+			Date:     "2025-01-01T00:00:00Z",
+			FeedInfo: FeedInfo{FeedPath: "old.zip"},
+			Counts:   NoticeCounts{Errors: 3, Warnings: 2, Total: 5},
+		},
+		Notices: []NoticeGroup{
+			{
+				Code:         "foreign_key_violation",
+				Severity:     "ERROR",
+				TotalNotices: 3,
+				SampleNotices: []map[string]interface{}{
+					{"filename": "trips.txt", "routeId": "R1"},
+				},
+			},
+			{
+				Code:         "unused_shape",
+				Severity:     "WARNING",
+				TotalNotices: 2,
+				SampleNotices: []map[string]interface{}{
+					{"shapeId": "S1"},
+				},
+			},
+		},
+	}
+
+	current := &ValidationReport{
+		Summary: Summary{
+			Date:     "2025-01-02T00:00:00Z",
+			FeedInfo: FeedInfo{FeedPath: "new.zip"},
+			Counts:   NoticeCounts{Errors: 6, Infos: 1, Total: 7},
+		},
+		Notices: []NoticeGroup{
+			{
+				Code:         "foreign_key_violation",
+				Severity:     "ERROR",
+				TotalNotices: 5,
+				SampleNotices: []map[string]interface{}{
+					{"filename": "trips.txt", "routeId": "R1"},
+					{"filename": "trips.txt", "routeId": "R42"},
+				},
+			},
+			{
+				Code:         "duplicate_key",
+				Severity:     "ERROR",
+				TotalNotices: 1,
+				SampleNotices: []map[string]interface{}{
+					{"filename": "stops.txt", "stopId": "S9"},
+				},
+			},
+			{
+				Code:         "feed_info_lang_and_agency_lang_mismatch",
+				Severity:     "INFO",
+				TotalNotices: 1,
+			},
+		},
+	}
+
+	return previous, current
+}
+
+func TestCompareReports(t *testing.T) {
+	previous, current := newComparisonTestReports()
+	comparison := CompareReports(previous, current)
+
+	if comparison.SeverityDeltas.Errors != 3 {
+		t.Errorf("expected error delta 3, got %d", comparison.SeverityDeltas.Errors)
+	}
+	if comparison.SeverityDeltas.Warnings != -2 {
+		t.Errorf("expected warning delta -2, got %d", comparison.SeverityDeltas.Warnings)
+	}
+	if comparison.SeverityDeltas.Total != 2 {
+		t.Errorf("expected total delta 2, got %d", comparison.SeverityDeltas.Total)
+	}
+
+	if len(comparison.NewCodes) != 2 {
+		t.Fatalf("expected 2 new codes, got %d", len(comparison.NewCodes))
+	}
+	// Errors are sorted first
+	if comparison.NewCodes[0].Code != "duplicate_key" {
+		t.Errorf("expected first new code duplicate_key, got %s", comparison.NewCodes[0].Code)
+	}
+
+	if len(comparison.ResolvedCodes) != 1 || comparison.ResolvedCodes[0].Code != "unused_shape" {
+		t.Errorf("expected unused_shape to be resolved, got %+v", comparison.ResolvedCodes)
+	}
+	if comparison.ResolvedCodes[0].Delta != -2 {
+		t.Errorf("expected resolved delta -2, got %d", comparison.ResolvedCodes[0].Delta)
+	}
+
+	if len(comparison.ChangedCodes) != 1 {
+		t.Fatalf("expected 1 changed code, got %d", len(comparison.ChangedCodes))
+	}
+	changed := comparison.ChangedCodes[0]
+	if changed.Code != "foreign_key_violation" || changed.PreviousCount != 3 || changed.CurrentCount != 5 || changed.Delta != 2 {
+		t.Errorf("unexpected changed code: %+v", changed)
+	}
+
+	entities := map[string]AffectedEntity{}
+	for _, entity := range comparison.NewlyAffectedEntities {
+		entities[entity.Type+":"+entity.ID] = entity
+	}
+	if _, exists := entities["route:R1"]; exists {
+		t.Error("route R1 was already affected in the previous report")
+	}
+	if entity, exists := entities["route:R42"]; !exists || entity.Code != "foreign_key_violation" {
+		t.Errorf("expected route R42 to be newly affected, got %+v", comparison.NewlyAffectedEntities)
+	}
+	if _, exists := entities["stop:S9"]; !exists {
+		t.Error("expected stop S9 to be newly affected")
+	}
+
+	if !comparison.HasRegressions() {
+		t.Error("expected comparison to report regressions")
+	}
+	if !comparison.HasChanges() {
+		t.Error("expected comparison to report changes")
+	}
+}
+
+func TestCompareReports_Identical(t *testing.T) {
+	previous, _ := newComparisonTestReports()
+	comparison := CompareReports(previous, previous)
+
+	if comparison.HasChanges() {
+		t.Errorf("expected no changes when comparing a report with itself, got %+v", comparison)
+	}
+	if comparison.HasRegressions() {
+		t.Error("expected no regressions when comparing a report with itself")
+	}
+}
+
+func TestHTMLFormatter_GenerateComparisonHTML(t *testing.T) {
+	formatter, err := NewHTMLFormatter()
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+
+	previous, current := newComparisonTestReports()
+	var output strings.Builder
+	if err := formatter.GenerateComparisonHTML(CompareReports(previous, current), &output); err != nil {
+		t.Fatalf("GenerateComparisonHTML() failed: %v", err)
+	}
+
+	html := output.String()
+	for _, expected := range []string{"<!DOCTYPE html>", "old.zip", "new.zip", "duplicate_key", "unused_shape", "R42", "&#43;3"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("comparison HTML missing %q", expected)
+		}
+	}
+}
+
+func TestCompareReports_UsesSampleLocations(t *testing.T) {
+	previous := &ValidationReport{Notices: []NoticeGroup{}}
+	current := &ValidationReport{
+		Notices: []NoticeGroup{
+			{
+				Code:          "foreign_key_violation",
+				Severity:      "ERROR",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{{"filename": "trips.txt", "fieldName": "route_id", "fieldValue": "R7"}},
+				SampleLocations: []NoticeLocation{{
+					File:            "trips.txt",
+					FieldName:       "route_id",
+					RelatedEntities: []EntityRef{{Type: "route", ID: "R7"}, {Type: "trip", ID: "T1"}},
+				}},
+			},
+		},
+	}
+
+	comparison := CompareReports(previous, current)
+	if len(comparison.NewlyAffectedEntities) != 2 {
+		t.Fatalf("expected entities from the sample location, got %+v", comparison.NewlyAffectedEntities)
+	}
+	if comparison.NewlyAffectedEntities[0].Type != "route" || comparison.NewlyAffectedEntities[1].ID != "T1" {
+		t.Errorf("unexpected entities: %+v", comparison.NewlyAffectedEntities)
+	}
+}
@@ -0,0 +1,136 @@
+package gtfsvalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ReportSchemaVersion is the version of the JSON report format written by this
+// package, as "major.minor". The minor version changes when fields are added and
+// the major version when fields are removed or change meaning.
+const ReportSchemaVersion = "1.1"
+
+// legacyReportSchemaVersion is assumed for reports written before the schema
+// version was recorded; their fields are a subset of version 1.0.
+const legacyReportSchemaVersion = "1.0"
+
+// ErrUnsupportedReportVersion is returned by ParseReport for reports written
+// with a newer major schema version than this package understands.
+var ErrUnsupportedReportVersion = errors.New("unsupported report schema version")
+
+// ParseReport reads a JSON validation report, e.g. one written with
+// `--format json` or json.Marshal, back into a ValidationReport that can be
+// rendered with HTMLFormatter, WriteSARIF or compared with CompareReports.
+//
+// Numbers in sample notices are restored as int when they are whole and as
+// float64 otherwise, and the entity index is rebuilt from the samples and the
+// stored entity relations. Reports without a schema version are read as
+// version 1.0; reports written before version 1.1 carry no entity relations,
+// so their route and agency roll-ups only see notices on the entity itself.
+func ParseReport(r io.Reader) (*ValidationReport, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var raw struct {
+		SchemaVersion string           `json:"schemaVersion"`
+		Summary       *Summary         `json:"summary"`
+		Notices       []NoticeGroup    `json:"notices"`
+		Entities      *EntityRelations `json:"entities"`
+	}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON report: %w", err)
+	}
+	if raw.Summary == nil {
+		return nil, errors.New("invalid JSON report: missing summary")
+	}
+
+	version := raw.SchemaVersion
+	if version == "" {
+		version = legacyReportSchemaVersion
+	}
+	if err := checkReportSchemaVersion(version); err != nil {
+		return nil, err
+	}
+
+	notices := raw.Notices
+	if notices == nil {
+		notices = []NoticeGroup{}
+	}
+	for i := range notices {
+		for j, sample := range notices[i].SampleNotices {
+			notices[i].SampleNotices[j] = normalizeJSONMap(sample)
+		}
+	}
+
+	return &ValidationReport{
+		SchemaVersion: version,
+		Summary:       *raw.Summary,
+		Notices:       notices,
+		Entities:      raw.Entities,
+		entityIndex:   newEntityIndexFromGroups(notices, raw.Entities),
+	}, nil
+}
+
+// checkReportSchemaVersion rejects malformed versions and major versions newer
+// than ReportSchemaVersion.
+func checkReportSchemaVersion(version string) error {
+	major, err := schemaMajorVersion(version)
+	if err != nil {
+		return fmt.Errorf("invalid JSON report: malformed schema version %q", version)
+	}
+	supported, _ := schemaMajorVersion(ReportSchemaVersion)
+	if major > supported {
+		return fmt.Errorf("%w: %s (supported: %s)", ErrUnsupportedReportVersion, version, ReportSchemaVersion)
+	}
+	return nil
+}
+
+// schemaMajorVersion returns the major component of a "major.minor" version.
+func schemaMajorVersion(version string) (int, error) {
+	majorPart, minorPart, found := strings.Cut(version, ".")
+	if !found {
+		return 0, fmt.Errorf("missing minor version")
+	}
+	if _, err := strconv.Atoi(minorPart); err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(majorPart)
+}
+
+// normalizeJSONMap converts the json.Number values of a decoded object to int or float64.
+func normalizeJSONMap(values map[string]interface{}) map[string]interface{} {
+	for key, value := range values {
+		values[key] = normalizeJSONValue(value)
+	}
+	return values
+}
+
+// normalizeJSONValue converts json.Number values, including nested ones, to int
+// when they are whole numbers within the int range and to float64 otherwise.
+func normalizeJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil && n >= math.MinInt && n <= math.MaxInt {
+			return int(n)
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+		return f
+	case map[string]interface{}:
+		return normalizeJSONMap(v)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeJSONValue(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,142 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseReport_RoundTrip(t *testing.T) {
+	original, err := New().ValidateFile(CreateTempZip(t, entityTestFeed()))
+	if err != nil {
+		t.Fatalf("ValidateFile failed: %v", err)
+	}
+	if original.SchemaVersion != ReportSchemaVersion {
+		t.Errorf("expected schema version %s, got %q", ReportSchemaVersion, original.SchemaVersion)
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	parsed, err := ParseReport(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseReport failed: %v", err)
+	}
+
+	if parsed.Summary != original.Summary {
+		t.Errorf("expected summary %+v, got %+v", original.Summary, parsed.Summary)
+	}
+	if len(parsed.Notices) != len(original.Notices) {
+		t.Fatalf("expected %d notice groups, got %d", len(original.Notices), len(parsed.Notices))
+	}
+	if codes := noticeCodes(parsed.NoticesForTrip("trip_2")); codes["stop_time_decreasing_time"] == 0 {
+		t.Errorf("expected trip_2 notices to be indexed after parsing, got %v", codes)
+	}
+
+	rows := 0
+	for _, group := range parsed.Notices {
+		for _, sample := range group.SampleNotices {
+			if row, ok := sample["csvRowNumber"]; ok {
+				rows++
+				if _, isInt := row.(int); !isInt {
+					t.Errorf("expected csvRowNumber of %s as int, got %T", group.Code, row)
+				}
+			}
+		}
+	}
+	if rows == 0 {
+		t.Error("expected samples with a csvRowNumber")
+	}
+
+	reencoded, err := json.Marshal(parsed)
+	if err != nil {
+		t.Fatalf("Marshal of parsed report failed: %v", err)
+	}
+	if !bytes.Equal(data, reencoded) {
+		t.Errorf("expected parsed report to re-encode identically\noriginal: %s\nparsed:   %s", data, reencoded)
+	}
+
+	formatter, err := NewHTMLFormatter()
+	if err != nil {
+		t.Fatalf("NewHTMLFormatter failed: %v", err)
+	}
+	if _, err := formatter.GenerateHTMLString(parsed); err != nil {
+		t.Errorf("expected parsed report to render as HTML: %v", err)
+	}
+}
+
+func TestParseReport_Versions(t *testing.T) {
+	tests := []struct {
+		name            string
+		json            string
+		expectedVersion string
+		wantErr         error
+		errContains     string
+	}{
+		{
+			name:            "legacy report without version",
+			json:            `{"summary":{"counts":{"errors":1,"total":1}},"notices":[{"code":"duplicate_key","severity":"ERROR","totalNotices":1,"sampleNotices":[{"stopId":"S1","csvRowNumber":3}]}]}`,
+			expectedVersion: "1.0",
+		},
+		{
+			name:            "older minor version",
+			json:            `{"schemaVersion":"1.0","summary":{}}`,
+			expectedVersion: "1.0",
+		},
+		{
+			name:            "newer minor version",
+			json:            `{"schemaVersion":"1.7","summary":{}}`,
+			expectedVersion: "1.7",
+		},
+		{
+			name:    "newer major version",
+			json:    `{"schemaVersion":"2.0","summary":{}}`,
+			wantErr: ErrUnsupportedReportVersion,
+		},
+		{
+			name:        "malformed version",
+			json:        `{"schemaVersion":"v1","summary":{}}`,
+			errContains: "malformed schema version",
+		},
+		{
+			name:        "missing summary",
+			json:        `{"notices":[]}`,
+			errContains: "missing summary",
+		},
+		{
+			name:        "not JSON",
+			json:        `<html>`,
+			errContains: "invalid JSON report",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := ParseReport(strings.NewReader(tt.json))
+			if tt.wantErr != nil || tt.errContains != "" {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing %q, got %v", tt.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseReport failed: %v", err)
+			}
+			if report.SchemaVersion != tt.expectedVersion {
+				t.Errorf("expected schema version %s, got %s", tt.expectedVersion, report.SchemaVersion)
+			}
+			if report.Notices == nil {
+				t.Error("expected a non-nil notices slice")
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package gtfsvalidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+//go:generate go run ./cmd/gtfs-validator schema -o docs/schemas/report-1.1.schema.json
+
+// ReportSchemaID is the $id of the JSON Schema for version ReportSchemaVersion
+// of the report format, published in docs/schemas.
+const ReportSchemaID = "https://raw.githubusercontent.com/theoremus-urban-solutions/gtfs-validator/main/docs/schemas/report-" + ReportSchemaVersion + ".schema.json"
+
+// schemaDefinitionNames renames definitions of types exported under an alias.
+var schemaDefinitionNames = map[string]string{
+	"Metadata": "DownloadInfo",
+}
+
+// ReportJSONSchema returns the JSON Schema (draft 2020-12) of the JSON report
+// format, version ReportSchemaVersion. It is generated from the ValidationReport
+// type and the notice registry: the sample notices of each registered code are
+// described by a "context.<code>" definition with the type of every key.
+//
+// The schema describes the public ValidationReport; report.ValidationReport is
+// an intermediate type without the enhanced description fields. Objects accept
+// additional properties so that minor versions can add fields.
+func ReportJSONSchema() ([]byte, error) {
+	builder := &schemaBuilder{defs: make(map[string]interface{})}
+	root := builder.structSchema(reflect.TypeOf(ValidationReport{}))
+	root["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	root["$id"] = ReportSchemaID
+	root["title"] = "GTFS Validator Report " + ReportSchemaVersion
+	root["description"] = "Validation report written by gtfs-validator with --format json or json.Marshal(ValidationReport)."
+	root["$defs"] = builder.defs
+
+	properties := root["properties"].(map[string]interface{})
+	properties["schemaVersion"] = map[string]interface{}{"type": "string", "const": ReportSchemaVersion}
+
+	group := builder.defs["NoticeGroup"].(map[string]interface{})
+	groupProperties := group["properties"].(map[string]interface{})
+	groupProperties["severity"] = map[string]interface{}{"enum": []string{notice.ERROR.String(), notice.WARNING.String(), notice.INFO.String()}}
+
+	var contexts []interface{}
+	for _, rule := range notice.Rules() {
+		if rule.Context == nil {
+			continue
+		}
+		name := "context." + rule.Code
+		builder.defs[name] = contextSchema(rule)
+		contexts = append(contexts, map[string]interface{}{
+			"if": map[string]interface{}{
+				"properties": map[string]interface{}{"code": map[string]interface{}{"const": rule.Code}},
+				"required":   []string{"code"},
+			},
+			"then": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"sampleNotices": map[string]interface{}{"items": map[string]interface{}{"$ref": "#/$defs/" + name}},
+				},
+			},
+		})
+	}
+	group["allOf"] = contexts
+
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// contextSchema describes the sample notice context of a rule.
+func contextSchema(rule notice.Rule) map[string]interface{} {
+	properties := make(map[string]interface{}, len(rule.Context))
+	required := make([]string, 0, len(rule.Context))
+	for _, field := range rule.Context {
+		required = append(required, field.Name)
+		switch field.Type {
+		case "":
+			properties[field.Name] = map[string]interface{}{}
+		case "array":
+			properties[field.Name] = map[string]interface{}{"type": []string{"array", "null"}}
+		default:
+			properties[field.Name] = map[string]interface{}{"type": field.Type}
+		}
+	}
+	return map[string]interface{}{
+		"type":        "object",
+		"description": "Context of " + rule.Code + " notices.",
+		"properties":  properties,
+		"required":    required,
+	}
+}
+
+// schemaBuilder converts Go types to JSON Schema, collecting named structs as definitions.
+type schemaBuilder struct {
+	defs map[string]interface{}
+}
+
+// typeSchema returns the schema of a Go type as encoded by encoding/json.
+func (b *schemaBuilder) typeSchema(t reflect.Type) map[string]interface{} {
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return b.typeSchema(t.Elem())
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": []string{"array", "null"}, "items": b.typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.typeSchema(t.Elem())}
+	case reflect.Struct:
+		name := t.Name()
+		if alias, ok := schemaDefinitionNames[name]; ok {
+			name = alias
+		}
+		if _, exists := b.defs[name]; !exists {
+			b.defs[name] = nil // reserve the name for recursive types
+			b.defs[name] = b.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + name}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// structSchema returns an object schema with the exported, JSON-encoded fields of a struct.
+// Fields without omitempty are required.
+func (b *schemaBuilder) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = b.typeSchema(field.Type)
+		if !strings.Contains(options, "omitempty") {
+			required = append(required, name)
+		}
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
@@ -0,0 +1,248 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+func TestReportJSONSchema_UpToDate(t *testing.T) {
+	schema, err := ReportJSONSchema()
+	if err != nil {
+		t.Fatalf("ReportJSONSchema failed: %v", err)
+	}
+	committed, err := os.ReadFile("docs/schemas/report-" + ReportSchemaVersion + ".schema.json")
+	if err != nil {
+		t.Fatalf("Failed to read published schema: %v", err)
+	}
+	if !bytes.Equal(append(schema, '\n'), committed) {
+		t.Error("published report schema is out of date; run `go generate .`")
+	}
+}
+
+func TestReportJSONSchema_Definitions(t *testing.T) {
+	schema := loadReportSchema(t)
+	defs := schema["$defs"].(map[string]interface{})
+
+	for _, rule := range notice.Rules() {
+		def, exists := defs["context."+rule.Code]
+		if rule.Context == nil {
+			if exists {
+				t.Errorf("expected no context definition for %s", rule.Code)
+			}
+			continue
+		}
+		if !exists {
+			t.Errorf("missing context definition for %s", rule.Code)
+			continue
+		}
+		properties := def.(map[string]interface{})["properties"].(map[string]interface{})
+		if len(properties) != len(rule.Context) {
+			t.Errorf("expected %d context properties for %s, got %d", len(rule.Context), rule.Code, len(properties))
+		}
+	}
+
+	duplicateKey := defs["context.duplicate_key"].(map[string]interface{})["properties"].(map[string]interface{})
+	if fmt.Sprint(duplicateKey["csvRowNumber"]) != "map[type:integer]" || fmt.Sprint(duplicateKey["filename"]) != "map[type:string]" {
+		t.Errorf("unexpected duplicate_key context types: %v", duplicateKey)
+	}
+}
+
+func TestReportJSONSchema_ReportsConform(t *testing.T) {
+	schema := loadReportSchema(t)
+
+	feeds := map[string]map[string]string{
+		"valid":  MinimalValidGTFS(),
+		"entity": entityTestFeed(),
+	}
+	invalid := MinimalValidGTFS()
+	invalid["stops.txt"] = `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,95.0,-74.0
+stop_1,Duplicate Stop,40.0,-200.0
+stop_2,"Bad "quote",40.7,-74.0`
+	invalid["routes.txt"] = `route_id,agency_id,route_short_name,route_long_name,route_type,route_color,route_text_color
+route_1,missing_agency,,,3,ZZZZZZ,FFFFFF`
+	feeds["invalid"] = invalid
+
+	codes := make(map[string]bool)
+	for name, files := range feeds {
+		for _, mode := range []ValidationMode{ValidationModeDefault, ValidationModeComprehensive} {
+			report, err := New(WithValidationMode(mode)).ValidateFile(CreateTempZip(t, files))
+			if err != nil {
+				t.Fatalf("%s/%s: ValidateFile failed: %v", name, mode, err)
+			}
+			for _, group := range report.Notices {
+				codes[group.Code] = true
+			}
+
+			data, err := json.Marshal(report)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			for _, violation := range validateAgainstSchema(t, schema, data) {
+				t.Errorf("%s/%s: %s", name, mode, violation)
+			}
+		}
+	}
+	if len(codes) < 10 {
+		t.Errorf("expected the test feeds to cover at least 10 notice codes, got %d", len(codes))
+	}
+
+	// A report with a mistyped context value must not conform
+	broken := []byte(`{"schemaVersion":"` + ReportSchemaVersion + `","summary":{"validatorVersion":"","validationTimeSeconds":0,"date":"",` +
+		`"feedInfo":{"feedPath":"","agencyCount":0,"routeCount":0,"tripCount":0,"stopCount":0,"stopTimeCount":0},` +
+		`"counts":{"errors":1,"warnings":0,"infos":0,"total":1}},"notices":[{"code":"duplicate_key","severity":"ERROR","description":"",` +
+		`"totalNotices":1,"sampleNotices":[{"filename":"stops.txt","fieldName":"stop_id","fieldValue":"S1","csvRowNumber":"3","duplicateRow":2}]}]}`)
+	if violations := validateAgainstSchema(t, schema, broken); len(violations) != 1 || !strings.Contains(violations[0], "csvRowNumber") {
+		t.Errorf("expected one csvRowNumber violation, got %v", violations)
+	}
+}
+
+func loadReportSchema(t *testing.T) map[string]interface{} {
+	t.Helper()
+	data, err := ReportJSONSchema()
+	if err != nil {
+		t.Fatalf("ReportJSONSchema failed: %v", err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	return schema
+}
+
+// validateAgainstSchema checks a JSON document against the subset of JSON Schema
+// used by ReportJSONSchema: $ref, type, const, enum, properties, required,
+// items, additionalProperties, allOf and if/then.
+func validateAgainstSchema(t *testing.T, schema map[string]interface{}, data []byte) []string {
+	t.Helper()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		t.Fatalf("document is not valid JSON: %v", err)
+	}
+	defs := schema["$defs"].(map[string]interface{})
+	return schemaViolations(defs, schema, document, "$")
+}
+
+func schemaViolations(defs map[string]interface{}, schema map[string]interface{}, value interface{}, path string) []string {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, exists := defs[strings.TrimPrefix(ref, "#/$defs/")]
+		if !exists {
+			return []string{fmt.Sprintf("%s: unresolved $ref %s", path, ref)}
+		}
+		return schemaViolations(defs, def.(map[string]interface{}), value, path)
+	}
+
+	var violations []string
+	if types, ok := schema["type"]; ok && !matchesSchemaType(types, value) {
+		return []string{fmt.Sprintf("%s: %v does not match type %v", path, value, types)}
+	}
+	if constant, ok := schema["const"]; ok && fmt.Sprint(constant) != fmt.Sprint(value) {
+		violations = append(violations, fmt.Sprintf("%s: expected %v, got %v", path, constant, value))
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			found = found || fmt.Sprint(allowed) == fmt.Sprint(value)
+		}
+		if !found {
+			violations = append(violations, fmt.Sprintf("%s: %v not in %v", path, value, enum))
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, name := range required {
+				if _, present := v[name.(string)]; !present {
+					violations = append(violations, fmt.Sprintf("%s: missing required property %s", path, name))
+				}
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if property, ok := properties[key].(map[string]interface{}); ok {
+				violations = append(violations, schemaViolations(defs, property, v[key], path+"."+key)...)
+			} else if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+				violations = append(violations, schemaViolations(defs, additional, v[key], path+"."+key)...)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				violations = append(violations, schemaViolations(defs, items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			subSchema := sub.(map[string]interface{})
+			if condition, ok := subSchema["if"].(map[string]interface{}); ok {
+				if len(schemaViolations(defs, condition, value, path)) == 0 {
+					violations = append(violations, schemaViolations(defs, subSchema["then"].(map[string]interface{}), value, path)...)
+				}
+				continue
+			}
+			violations = append(violations, schemaViolations(defs, subSchema, value, path)...)
+		}
+	}
+	return violations
+}
+
+func matchesSchemaType(types interface{}, value interface{}) bool {
+	var names []interface{}
+	if list, ok := types.([]interface{}); ok {
+		names = list
+	} else {
+		names = []interface{}{types}
+	}
+	for _, name := range names {
+		switch name {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]interface{}); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]interface{}); ok {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(json.Number); ok {
+				return true
+			}
+		case "integer":
+			if number, ok := value.(json.Number); ok {
+				if _, err := number.Int64(); err == nil {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package gtfsvalidator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+// RuleInfo describes a validation rule (notice code) and where it runs.
+type RuleInfo struct {
+	Code             string           `json:"code"`
+	Severity         string           `json:"severity"`
+	VariableSeverity bool             `json:"variableSeverity,omitempty"`
+	Category         string           `json:"category"`
+	Validators       []string         `json:"validators,omitempty"`
+	Modes            []ValidationMode `json:"modes"`
+	NoticeDescription
+}
+
+// RuleCatalogue returns every notice code the validator knows about, sorted by code.
+//
+// Modes lists the validation modes whose validators can emit the notice. Diff
+// and cross-feed rules have no modes as they are only emitted by ValidateDiff
+// and ValidateFeeds, and rules with category "unused" are not emitted by any
+// registered validator.
+func RuleCatalogue() []RuleInfo {
+	modeValidators := validatorsByMode()
+
+	rules := notice.Rules()
+	catalogue := make([]RuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		catalogue = append(catalogue, newRuleInfo(rule, modeValidators))
+	}
+	return catalogue
+}
+
+// ExplainRule returns the catalogue entry for a notice code.
+func ExplainRule(code string) (RuleInfo, error) {
+	rule, found := notice.LookupRule(strings.TrimSpace(code))
+	if !found {
+		return RuleInfo{}, fmt.Errorf("unknown notice code: %s", code)
+	}
+	return newRuleInfo(rule, validatorsByMode()), nil
+}
+
+// newRuleInfo combines a registry rule with its description and mode membership.
+func newRuleInfo(rule notice.Rule, modeValidators map[ValidationMode]map[string]bool) RuleInfo {
+	info := RuleInfo{
+		Code:              rule.Code,
+		Severity:          rule.Severity.String(),
+		VariableSeverity:  rule.VariableSeverity,
+		Category:          rule.Category,
+		Validators:        rule.Validators,
+		Modes:             []ValidationMode{},
+		NoticeDescription: GetEnhancedNoticeDescription(rule.Code),
+	}
+
+	for _, mode := range []ValidationMode{ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive} {
+		for _, name := range rule.Validators {
+			// Notices emitted by the validation pipeline itself are part of every mode
+			if strings.HasPrefix(name, "gtfsvalidator.") || modeValidators[mode][name] {
+				info.Modes = append(info.Modes, mode)
+				break
+			}
+		}
+	}
+	return info
+}
+
+// validatorsByMode returns the names ("package.Type") of the validators registered in each mode.
+func validatorsByMode() map[ValidationMode]map[string]bool {
+	configs := map[ValidationMode]validationConfig{
+		ValidationModePerformance:   performanceValidationConfig(),
+		ValidationModeDefault:       defaultValidationConfig(),
+		ValidationModeComprehensive: comprehensiveValidationConfig(),
+	}
+
+	result := make(map[ValidationMode]map[string]bool, len(configs))
+	for mode, config := range configs {
+		v := newInternalValidator(Config{}, config)
+		v.initializeValidators()
+
+		names := make(map[string]bool, len(v.validators))
+		for _, registered := range v.validators {
+			names[strings.TrimPrefix(fmt.Sprintf("%T", registered), "*")] = true
+		}
+		result[mode] = names
+	}
+	return result
+}
+
+// RuleCategories returns the distinct categories in the rule catalogue.
+func RuleCategories() []string {
+	seen := make(map[string]bool)
+	for _, rule := range notice.Rules() {
+		seen[rule.Category] = true
+	}
+	categories := make([]string, 0, len(seen))
+	for category := range seen {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
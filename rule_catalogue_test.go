@@ -0,0 +1,104 @@
+package gtfsvalidator
+
+import (
+	"testing"
+)
+
+func TestRuleCatalogue(t *testing.T) {
+	catalogue := RuleCatalogue()
+	if len(catalogue) == 0 {
+		t.Fatal("Expected rules in the catalogue")
+	}
+
+	rules := make(map[string]RuleInfo, len(catalogue))
+	for _, rule := range catalogue {
+		if rule.Description == "" {
+			t.Errorf("Rule %q has no description", rule.Code)
+		}
+		rules[rule.Code] = rule
+	}
+
+	tests := []struct {
+		code     string
+		severity string
+		category string
+		modes    []ValidationMode
+	}{
+		{"duplicate_key", "ERROR", "core", []ValidationMode{ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive}},
+		{"agency_mixed_route_types", "INFO", "entity", []ValidationMode{ValidationModeDefault, ValidationModeComprehensive}},
+		{"validator_error", "ERROR", "system", []ValidationMode{ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive}},
+		{"stop_removed", "WARNING", "diff", []ValidationMode{}},
+		{"non_utf8_encoding", "ERROR", "core", []ValidationMode{ValidationModeDefault, ValidationModeComprehensive}},
+		{"csv_bare_quote", "WARNING", "core", []ValidationMode{ValidationModeDefault, ValidationModeComprehensive}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			rule, exists := rules[tt.code]
+			if !exists {
+				t.Fatalf("Rule %q not in catalogue", tt.code)
+			}
+			if rule.Severity != tt.severity {
+				t.Errorf("Expected severity %s, got %s", tt.severity, rule.Severity)
+			}
+			if rule.Category != tt.category {
+				t.Errorf("Expected category %s, got %s", tt.category, rule.Category)
+			}
+			if len(rule.Modes) != len(tt.modes) {
+				t.Fatalf("Expected modes %v, got %v", tt.modes, rule.Modes)
+			}
+			for i, mode := range tt.modes {
+				if rule.Modes[i] != mode {
+					t.Errorf("Expected modes %v, got %v", tt.modes, rule.Modes)
+				}
+			}
+		})
+	}
+}
+
+func TestRuleCatalogue_ComprehensiveOnly(t *testing.T) {
+	// Expensive validators only run in comprehensive mode
+	for _, rule := range RuleCatalogue() {
+		for _, validator := range rule.Validators {
+			if validator != "business.GeospatialValidator" || len(rule.Validators) > 1 {
+				continue
+			}
+			if len(rule.Modes) != 1 || rule.Modes[0] != ValidationModeComprehensive {
+				t.Errorf("Rule %q from GeospatialValidator should only run in comprehensive mode, got %v", rule.Code, rule.Modes)
+			}
+		}
+	}
+}
+
+func TestExplainRule(t *testing.T) {
+	rule, err := ExplainRule("foreign_key_violation")
+	if err != nil {
+		t.Fatalf("ExplainRule failed: %v", err)
+	}
+	if rule.Code != "foreign_key_violation" || rule.Severity != "ERROR" {
+		t.Errorf("Unexpected rule: %+v", rule)
+	}
+	if rule.GTFSReference == "" {
+		t.Error("Expected GTFS reference for foreign_key_violation")
+	}
+
+	if _, err := ExplainRule("not_a_real_code"); err == nil {
+		t.Error("Expected error for unknown code")
+	}
+}
+
+func TestRuleCategories(t *testing.T) {
+	categories := RuleCategories()
+	for _, expected := range []string{"core", "entity", "relationship", "business", "diff", "system"} {
+		found := false
+		for _, category := range categories {
+			if category == expected {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Expected category %q in %v", expected, categories)
+		}
+	}
+}
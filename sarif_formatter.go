@@ -0,0 +1,178 @@
+package gtfsvalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// SARIF 2.1.0 constants
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolURI   = "https://github.com/theoremus-urban-solutions/gtfs-validator"
+)
+
+// sarifLog is the root object of a SARIF 2.1.0 log
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	HelpURI              string             `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// WriteSARIF writes the report as a SARIF 2.1.0 log, so that code scanning
+// tools can display notices next to the offending rows. Each notice code is a
+// rule and each sample notice a result.
+func (r *ValidationReport) WriteSARIF(writer io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "gtfs-validator",
+			Version:        r.Summary.ValidatorVersion,
+			InformationURI: sarifToolURI,
+			Rules:          make([]sarifRule, 0, len(r.Notices)),
+		}},
+		Results: []sarifResult{},
+	}
+
+	for ruleIndex, group := range r.Notices {
+		level := sarifLevel(group.Severity)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:                   group.Code,
+			ShortDescription:     sarifMessage{Text: firstSentence(group.Description, group.Code)},
+			HelpURI:              group.GTFSReference,
+			DefaultConfiguration: sarifConfiguration{Level: level},
+		})
+
+		for i, location := range group.Locations() {
+			result := sarifResult{
+				RuleID:    group.Code,
+				RuleIndex: ruleIndex,
+				Level:     level,
+				Message:   sarifMessage{Text: sarifResultMessage(group, location)},
+			}
+			if location.File != "" {
+				physical := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: location.File}}
+				if location.RowNumber > 0 {
+					physical.Region = &sarifRegion{StartLine: location.RowNumber}
+				}
+				result.Locations = []sarifLocation{{PhysicalLocation: physical}}
+			}
+			if len(group.SampleNotices[i]) > 0 {
+				result.Properties = group.SampleNotices[i]
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{Schema: sarifSchemaURI, Version: sarifVersion, Runs: []sarifRun{run}})
+}
+
+// sarifLevel maps a notice severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case "ERROR":
+		return "error"
+	case "WARNING":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifResultMessage describes a sample notice with its field and primary key.
+func sarifResultMessage(group NoticeGroup, location NoticeLocation) string {
+	message := firstSentence(group.Description, group.Code)
+
+	var details []string
+	if location.FieldName != "" {
+		details = append(details, "field "+location.FieldName)
+	}
+	keys := make([]string, 0, len(location.PrimaryKey))
+	for key := range location.PrimaryKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		details = append(details, fmt.Sprintf("%s=%s", key, location.PrimaryKey[key]))
+	}
+	if len(details) > 0 {
+		message += " (" + strings.Join(details, ", ") + ")"
+	}
+	return message
+}
+
+// firstSentence returns the first sentence of a description, or fallback if it is empty.
+func firstSentence(description, fallback string) string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fallback
+	}
+	if i := strings.Index(description, ". "); i >= 0 {
+		return description[:i+1]
+	}
+	return description
+}
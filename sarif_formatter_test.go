@@ -0,0 +1,73 @@
+package gtfsvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestValidationReport_WriteSARIF(t *testing.T) {
+	report := &ValidationReport{
+		Summary: Summary{ValidatorVersion: "1.0.0"},
+		Notices: []NoticeGroup{
+			{
+				Code:          "missing_required_field",
+				Severity:      "ERROR",
+				Description:   "A required field is missing. This field is mandatory.",
+				GTFSReference: "https://gtfs.org/schedule/reference/",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{
+					{"filename": "stops.txt", "csvRowNumber": 5.0, "fieldName": "stop_lat", "stopId": "S1"},
+				},
+			},
+			{
+				Code:          "unused_shape",
+				Severity:      "INFO",
+				TotalNotices:  1,
+				SampleNotices: []map[string]interface{}{{}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteSARIF(&buf); err != nil {
+		t.Fatalf("WriteSARIF failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Failed to decode SARIF: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("Expected one SARIF 2.1.0 run, got version %s with %d runs", log.Version, len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[0].ID != "missing_required_field" {
+		t.Fatalf("Expected a rule per notice code, got %+v", run.Tool.Driver.Rules)
+	}
+	if run.Tool.Driver.Rules[0].ShortDescription.Text != "A required field is missing." {
+		t.Errorf("Expected the first sentence as short description, got %q", run.Tool.Driver.Rules[0].ShortDescription.Text)
+	}
+	if run.Tool.Driver.Rules[1].ShortDescription.Text != "unused_shape" {
+		t.Errorf("Expected the code as short description without a description, got %q", run.Tool.Driver.Rules[1].ShortDescription.Text)
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("Expected a result per sample notice, got %d", len(run.Results))
+	}
+
+	result := run.Results[0]
+	if result.Level != "error" || result.RuleIndex != 0 {
+		t.Errorf("Expected an error result for rule 0, got %s for rule %d", result.Level, result.RuleIndex)
+	}
+	if len(result.Locations) != 1 || result.Locations[0].PhysicalLocation.ArtifactLocation.URI != "stops.txt" ||
+		result.Locations[0].PhysicalLocation.Region == nil || result.Locations[0].PhysicalLocation.Region.StartLine != 5 {
+		t.Errorf("Expected a location at stops.txt line 5, got %+v", result.Locations)
+	}
+	if result.Message.Text != "A required field is missing. (field stop_lat, stop_id=S1)" {
+		t.Errorf("Unexpected message: %q", result.Message.Text)
+	}
+	if run.Results[1].Level != "note" || len(run.Results[1].Locations) != 0 {
+		t.Errorf("Expected a note without location, got %+v", run.Results[1])
+	}
+}
@@ -0,0 +1,108 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+)
+
+// defaultStreamBufferSize is the number of notices ValidateStream buffers by default.
+const defaultStreamBufferSize = 256
+
+// Notice is a single validation notice delivered by ValidateStream.
+type Notice struct {
+	// Code is the notice type code (e.g., "missing_required_field").
+	Code string `json:"code,omitempty"`
+
+	// Severity is the notice severity (ERROR, WARNING, INFO).
+	Severity string `json:"severity,omitempty"`
+
+	// Context contains the notice details.
+	Context map[string]interface{} `json:"context,omitempty"`
+
+	// Location points at the record the notice refers to.
+	Location NoticeLocation `json:"location"`
+
+	// Summary is only set on the last value sent by ValidateStream, which
+	// carries no notice but the summary of the whole validation.
+	Summary *Summary `json:"summary,omitempty"`
+}
+
+// IsSummary reports whether the value is the final summary rather than a notice.
+func (n Notice) IsSummary() bool {
+	return n.Summary != nil
+}
+
+// ValidateStream validates a ZIP file or directory and streams its notices.
+func (v *validatorImpl) ValidateStream(ctx context.Context, path string) (<-chan Notice, <-chan error) {
+	bufferSize := v.config.StreamBufferSize
+	if bufferSize == 0 {
+		bufferSize = defaultStreamBufferSize
+	}
+	notices := make(chan Notice, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(notices)
+
+		// send blocks while the buffer is full, unless ctx is cancelled. Parallel
+		// validators may still be running after a cancelled validation returns,
+		// so sends are guarded against the channel being closed.
+		var sendMu sync.Mutex
+		closed := false
+		send := func(n Notice) bool {
+			sendMu.Lock()
+			defer sendMu.Unlock()
+			if closed {
+				return false
+			}
+			select {
+			case notices <- n:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		defer func() {
+			sendMu.Lock()
+			closed = true
+			sendMu.Unlock()
+		}()
+
+		report, err := v.validateStream(ctx, path, send)
+
+		if report != nil {
+			summary := report.Summary
+			send(Notice{Summary: &summary})
+		}
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return notices, errs
+}
+
+// validateStream validates a path, passing each notice to send as it is added.
+func (v *validatorImpl) validateStream(ctx context.Context, path string, send func(Notice) bool) (*ValidationReport, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	startTime := time.Now()
+	internalValidator := newInternalValidator(v.createInternalConfig(), v.createValidationConfig())
+	internalValidator.noticeContainer.SetListener(func(n notice.Notice) {
+		send(Notice{
+			Code:     n.Code(),
+			Severity: n.Severity().String(),
+			Context:  n.Context(),
+			Location: n.Location(),
+		})
+	})
+	return v.validatePath(ctx, internalValidator, path, startTime)
+}
@@ -130,6 +130,84 @@ func TestStreamingValidationCancellation(t *testing.T) {
 	}
 }
 
+// TestValidateStream tests that every notice is streamed and followed by the summary
+func TestValidateStream(t *testing.T) {
+	zipPath := createTestZip(t, InvalidGTFS())
+	validator := New(WithStreamBufferSize(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	notices, errs := validator.ValidateStream(ctx, zipPath)
+
+	var counts NoticeCounts
+	var summary *Summary
+	for n := range notices {
+		if summary != nil {
+			t.Fatal("Expected the summary to be the last value")
+		}
+		if n.IsSummary() {
+			summary = n.Summary
+			continue
+		}
+		// A slow consumer makes the validators wait for the bounded buffer
+		time.Sleep(time.Millisecond)
+		if n.Code == "" || n.Context == nil {
+			t.Errorf("Expected a notice with code and context, got %+v", n)
+		}
+		switch n.Severity {
+		case "ERROR":
+			counts.Errors++
+		case "WARNING":
+			counts.Warnings++
+		case "INFO":
+			counts.Infos++
+		}
+		counts.Total++
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("ValidateStream failed: %v", err)
+	}
+
+	if summary == nil {
+		t.Fatal("Expected a final summary")
+	}
+	if counts.Total == 0 || counts != summary.Counts {
+		t.Errorf("Expected streamed counts to match the summary %+v, got %+v", summary.Counts, counts)
+	}
+}
+
+// TestValidateStream_Cancellation tests that cancelling stops a stream nobody reads
+func TestValidateStream_Cancellation(t *testing.T) {
+	zipPath := createTestZip(t, InvalidGTFS())
+	validator := New(WithStreamBufferSize(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notices, errs := validator.ValidateStream(ctx, zipPath)
+
+	// Read one notice, then stop reading with the validators blocked on the full buffer
+	if _, ok := <-notices; !ok {
+		t.Fatal("Expected at least one notice")
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range notices {
+		}
+		<-errs
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Expected the stream to end after cancellation")
+	}
+
+	if _, errs := validator.ValidateStream(ctx, zipPath); <-errs != context.Canceled {
+		t.Error("Expected context.Canceled for a cancelled context")
+	}
+}
+
 // fileExists checks if a file exists
 func fileExists(filename string) bool {
 	_, err := os.Stat(filename)
@@ -21,12 +21,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
+	"io/fs"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/theoremus-urban-solutions/gtfs-validator/report"
+	"github.com/theoremus-urban-solutions/gtfs-validator/fetch"
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"golang.org/x/text/language"
 )
 
 // NoticeCallback is called for each notice group during streaming validation.
@@ -46,11 +50,58 @@ type Validator interface {
 	// ValidateReaderWithContext validates a reader with cancellation support.
 	ValidateReaderWithContext(ctx context.Context, reader io.Reader) (*ValidationReport, error)
 
+	// ValidateBytes validates a GTFS ZIP archive held in memory.
+	ValidateBytes(data []byte) (*ValidationReport, error)
+
+	// ValidateBytesWithContext validates an in-memory archive with cancellation support.
+	ValidateBytesWithContext(ctx context.Context, data []byte) (*ValidationReport, error)
+
+	// ValidateFS validates a GTFS feed stored at the root of a file system
+	// (e.g. embed.FS, fstest.MapFS or os.DirFS) without touching disk.
+	ValidateFS(fsys fs.FS) (*ValidationReport, error)
+
+	// ValidateFSWithContext validates a file system feed with cancellation support.
+	ValidateFSWithContext(ctx context.Context, fsys fs.FS) (*ValidationReport, error)
+
+	// ValidateURL downloads a GTFS ZIP archive over HTTP(S) and validates it.
+	// Download details are recorded in the report's FeedInfo.Download.
+	ValidateURL(url string) (*ValidationReport, error)
+
+	// ValidateURLWithContext validates a remote feed with cancellation support.
+	ValidateURLWithContext(ctx context.Context, url string) (*ValidationReport, error)
+
 	// ValidateFileStream validates with streaming notice delivery.
 	ValidateFileStream(path string, callback NoticeCallback) (*ValidationReport, error)
 
 	// ValidateFileStreamWithContext validates with streaming and cancellation.
 	ValidateFileStreamWithContext(ctx context.Context, path string, callback NoticeCallback) (*ValidationReport, error)
+
+	// ValidateStream validates a ZIP file or directory and sends each notice on
+	// the returned channel as soon as it is added, followed by a final value
+	// carrying the summary. The channel is bounded: validators wait while it is
+	// full. A validation error is sent on the error channel; both channels are
+	// closed when validation ends. Cancel ctx to stop early.
+	ValidateStream(ctx context.Context, path string) (<-chan Notice, <-chan error)
+
+	// ValidateDiff validates the current feed and compares it with the previous feed version.
+	ValidateDiff(previousPath, currentPath string) (*ValidationReport, error)
+
+	// ValidateDiffWithContext validates a feed diff with cancellation support.
+	ValidateDiffWithContext(ctx context.Context, previousPath, currentPath string) (*ValidationReport, error)
+
+	// ValidateFeeds validates several feeds meant to be merged, each on its own
+	// and against each other (colliding IDs, nearby stops, timezones).
+	ValidateFeeds(paths []string) (*MultiFeedReport, error)
+
+	// ValidateFeedsWithContext validates several feeds with cancellation support.
+	ValidateFeedsWithContext(ctx context.Context, paths []string) (*MultiFeedReport, error)
+
+	// ValidateBatch validates many independent feeds concurrently and returns
+	// each result on the channel as soon as it completes.
+	ValidateBatch(paths []string) <-chan BatchResult
+
+	// ValidateBatchWithContext validates many feeds with cancellation support.
+	ValidateBatchWithContext(ctx context.Context, paths []string) <-chan BatchResult
 }
 
 // Config contains configuration options for the validator.
@@ -85,6 +136,115 @@ type Config struct {
 	// Recommended: true for large feeds and resource-constrained servers.
 	// Default: false (for backward compatibility).
 	EnableCaching bool
+
+	// DiffThresholds configures the checks run by ValidateDiff.
+	DiffThresholds DiffThresholds
+
+	// IgnoreSubfolderFiles excludes ZIP entries that are not at the archive root
+	// from validation. Subfolder files are reported either way.
+	IgnoreSubfolderFiles bool
+
+	// ArchiveLimits bounds the size and decompressed data of ZIP archives.
+	// Zero values disable a limit. Default: DefaultArchiveLimits().
+	ArchiveLimits ArchiveLimits
+
+	// TranscodeToUTF8 decodes files detected as Windows-1252, ISO-8859-1 or UTF-16
+	// to UTF-8 so the rest of the feed can be validated. Encoding notices are
+	// reported either way. Default: false.
+	TranscodeToUTF8 bool
+
+	// RecoverMalformedCSV skips malformed CSV rows, such as a stray quote opening a
+	// field that is never closed, with a row-level csv_parsing_failed notice and
+	// resumes parsing at the next line, so later rows are still validated.
+	// Default: false (files are parsed like encoding/csv).
+	RecoverMalformedCSV bool
+
+	// CrossFeedStopDistanceMeters is the distance within which stops of different
+	// feeds are reported by ValidateFeeds as needing a transfer (0 = disabled). Default: 10.
+	CrossFeedStopDistanceMeters float64
+
+	// Fetch configures downloads made by ValidateURL. A zero MaxSize falls back
+	// to ArchiveLimits.MaxArchiveSize. Default: DefaultFetchOptions().
+	Fetch FetchOptions
+
+	// SeverityOverrides maps notice codes to the severity they are reported
+	// with instead of their default: "ERROR", "WARNING" or "INFO".
+	SeverityOverrides map[string]string
+
+	// DisabledRules lists notice codes that are never reported.
+	DisabledRules []string
+
+	// StreamBufferSize is the number of notices ValidateStream buffers before
+	// validators wait for the consumer (0 = default). Default: 256.
+	StreamBufferSize int
+
+	// Observer receives lifecycle events: feed loading, validator runs and
+	// panics, and cache accesses. Default: nil.
+	Observer Observer
+
+	// Logger receives the warnings of the loaders, the cache and the validators,
+	// such as files that failed to close. Default: warnings to stderr.
+	Logger logging.Logger
+
+	// Locale is the BCP 47 language tag of notice descriptions, impacts and
+	// example fixes, matched against SupportedLocales. Default: "en".
+	Locale string
+}
+
+// FetchOptions configures the timeout, size limit, redirects and cache
+// directory used when downloading feeds.
+type FetchOptions = fetch.Options
+
+// DownloadInfo describes how a feed validated with ValidateURL was downloaded.
+type DownloadInfo = fetch.Metadata
+
+// ErrDownloadTooLarge is returned by ValidateURL when the feed exceeds the download size limit.
+var ErrDownloadTooLarge = fetch.ErrTooLarge
+
+// DefaultFetchOptions returns a 5 minute timeout and up to 10 redirects, without
+// caching. The size limit is taken from ArchiveLimits.
+func DefaultFetchOptions() FetchOptions {
+	options := fetch.DefaultOptions()
+	options.MaxSize = 0
+	return options
+}
+
+// ArchiveLimits bounds the resources a ZIP archive may use: compressed size,
+// number of entries, per-file and total uncompressed size and compression ratio.
+type ArchiveLimits = parser.ArchiveLimits
+
+// ArchiveLimitError describes which archive limit was exceeded.
+type ArchiveLimitError = parser.ArchiveLimitError
+
+// ErrArchiveLimitExceeded is returned when a ZIP archive exceeds the configured
+// ArchiveLimits. Use errors.As with *ArchiveLimitError for details. The report
+// returned alongside the error contains an archive_limit_exceeded notice.
+var ErrArchiveLimitExceeded = parser.ErrArchiveLimitExceeded
+
+// DefaultArchiveLimits returns limits that accept very large real-world feeds while rejecting zip bombs.
+func DefaultArchiveLimits() ArchiveLimits {
+	return parser.DefaultArchiveLimits()
+}
+
+// DiffThresholds configures feed-to-feed diff validation.
+type DiffThresholds struct {
+	// TripCountChangePercent is the change in trips per route per date that is reported (default 20).
+	TripCountChangePercent float64
+
+	// StopMovedMeters is the distance a stop can move before it is reported (default 100).
+	StopMovedMeters float64
+
+	// ShapeChangedMeters is the maximum deviation of a shape before it is reported (default 200).
+	ShapeChangedMeters float64
+}
+
+// DefaultDiffThresholds returns the default diff validation thresholds.
+func DefaultDiffThresholds() DiffThresholds {
+	return DiffThresholds{
+		TripCountChangePercent: 20,
+		StopMovedMeters:        100,
+		ShapeChangedMeters:     200,
+	}
 }
 
 // ValidationMode defines preset validation configurations.
@@ -121,14 +281,25 @@ type ProgressInfo struct {
 
 // ValidationReport contains the complete validation results.
 type ValidationReport struct {
+	// SchemaVersion is the version of the JSON report format (ReportSchemaVersion).
+	SchemaVersion string `json:"schemaVersion"`
+
 	// Summary contains high-level information about the validation.
 	Summary Summary `json:"summary"`
 
 	// Notices contains all validation notices grouped by type.
 	Notices []NoticeGroup `json:"notices"`
 
+	// Entities records the relations and names of the entities referenced by
+	// the sample notices, used by the roll-ups of reports read with ParseReport.
+	// Added in report schema version 1.1.
+	Entities *EntityRelations `json:"entities,omitempty"`
+
 	// mu protects concurrent access to the report.
 	mu sync.RWMutex
+
+	// entityIndex maps entities to notices; nil for reports decoded with json.Unmarshal.
+	entityIndex *entityIndex
 }
 
 // Summary contains summary information about the validation.
@@ -147,6 +318,10 @@ type Summary struct {
 
 	// Counts contains notice counts by severity.
 	Counts NoticeCounts `json:"counts"`
+
+	// Locale is the bundled locale of the notice descriptions, e.g. "en".
+	// Added in report schema version 1.1.
+	Locale string `json:"locale,omitempty"`
 }
 
 // FeedInfo contains information about the validated GTFS feed.
@@ -174,6 +349,9 @@ type FeedInfo struct {
 
 	// ServiceDateTo is the end date of service.
 	ServiceDateTo string `json:"serviceDateTo,omitempty"`
+
+	// Download describes the HTTP download of feeds validated with ValidateURL.
+	Download *DownloadInfo `json:"download,omitempty"`
 }
 
 // NoticeCounts contains counts of notices by severity.
@@ -223,8 +401,31 @@ type NoticeGroup struct {
 
 	// SampleNotices contains sample instances of this notice.
 	SampleNotices []map[string]interface{} `json:"sampleNotices"`
+
+	// SampleLocations contains the location of each sample notice, in the same order.
+	SampleLocations []NoticeLocation `json:"sampleLocations,omitempty"`
 }
 
+// Locations returns the location of each sample notice. Reports decoded from
+// JSON written before locations were recorded derive them from the sample contexts.
+func (g NoticeGroup) Locations() []NoticeLocation {
+	if len(g.SampleLocations) == len(g.SampleNotices) {
+		return g.SampleLocations
+	}
+	locations := make([]NoticeLocation, len(g.SampleNotices))
+	for i, sample := range g.SampleNotices {
+		locations[i] = notice.LocationFromContext(sample)
+	}
+	return locations
+}
+
+// NoticeLocation points at the record a notice refers to: file, row number,
+// field name, primary key values and related entity IDs.
+type NoticeLocation = notice.NoticeLocation
+
+// EntityRef identifies a GTFS entity (type and ID) referenced by a notice.
+type EntityRef = notice.EntityRef
+
 // HasErrors returns true if the report contains any errors.
 func (r *ValidationReport) HasErrors() bool {
 	r.mu.RLock()
@@ -315,16 +516,127 @@ func WithCaching(enabled bool) Option {
 	}
 }
 
+// WithDiffThresholds sets the thresholds used by ValidateDiff.
+func WithDiffThresholds(thresholds DiffThresholds) Option {
+	return func(c *Config) {
+		c.DiffThresholds = thresholds
+	}
+}
+
+// WithIgnoreSubfolderFiles sets whether GTFS files in ZIP subfolders are excluded from validation.
+func WithIgnoreSubfolderFiles(ignore bool) Option {
+	return func(c *Config) {
+		c.IgnoreSubfolderFiles = ignore
+	}
+}
+
+// WithArchiveLimits sets the limits enforced while reading ZIP archives.
+func WithArchiveLimits(limits ArchiveLimits) Option {
+	return func(c *Config) {
+		c.ArchiveLimits = limits
+	}
+}
+
+// WithTranscoding sets whether files in another encoding than UTF-8 are transcoded
+// before validation. Invalid sequences in UTF-8 files are replaced with U+FFFD.
+func WithTranscoding(enabled bool) Option {
+	return func(c *Config) {
+		c.TranscodeToUTF8 = enabled
+	}
+}
+
+// WithCSVRecovery sets whether malformed CSV rows are skipped and reported so
+// parsing resumes at the next line.
+func WithCSVRecovery(enabled bool) Option {
+	return func(c *Config) {
+		c.RecoverMalformedCSV = enabled
+	}
+}
+
+// WithCrossFeedStopDistance sets the distance in meters within which ValidateFeeds
+// reports stops of different feeds that should be linked by transfers.
+func WithCrossFeedStopDistance(meters float64) Option {
+	return func(c *Config) {
+		c.CrossFeedStopDistanceMeters = meters
+	}
+}
+
+// WithLogger sets the logger used by the validation pipeline.
+func WithLogger(logger logging.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// WithObserver sets the observer that receives validation lifecycle events.
+func WithObserver(observer Observer) Option {
+	return func(c *Config) {
+		c.Observer = observer
+	}
+}
+
+// WithStreamBufferSize sets how many notices ValidateStream buffers before validators wait.
+func WithStreamBufferSize(size int) Option {
+	return func(c *Config) {
+		c.StreamBufferSize = size
+	}
+}
+
+// WithFetchOptions sets how ValidateURL downloads feeds.
+func WithFetchOptions(options FetchOptions) Option {
+	return func(c *Config) {
+		c.Fetch = options
+	}
+}
+
+// WithCacheDir sets the directory where ValidateURL caches downloaded feeds.
+// Feeds are downloaded again only when their ETag or Last-Modified header changed.
+func WithCacheDir(dir string) Option {
+	return func(c *Config) {
+		c.Fetch.CacheDir = dir
+	}
+}
+
+// WithSeverityOverride reports notices of a code with another severity: "ERROR", "WARNING" or "INFO".
+func WithSeverityOverride(code, severity string) Option {
+	return func(c *Config) {
+		if c.SeverityOverrides == nil {
+			c.SeverityOverrides = make(map[string]string)
+		}
+		c.SeverityOverrides[code] = severity
+	}
+}
+
+// WithDisabledRules stops notices of the given codes from being reported.
+func WithDisabledRules(codes ...string) Option {
+	return func(c *Config) {
+		c.DisabledRules = append(c.DisabledRules, codes...)
+	}
+}
+
+// WithLocale sets the language of notice descriptions in reports, e.g. "fr" or "fr-CA".
+// Languages without a bundled locale fall back to English.
+func WithLocale(locale string) Option {
+	return func(c *Config) {
+		c.Locale = locale
+	}
+}
+
 // New creates a new GTFS validator with the given options.
 func New(opts ...Option) Validator {
 	config := &Config{
-		CountryCode:       "US",
-		CurrentDate:       time.Now(),
-		ParallelWorkers:   4,
-		ValidatorVersion:  "1.0.0",
-		ValidationMode:    ValidationModeDefault,
-		MaxNoticesPerType: 100,
-		EnableCaching:     false, // Default false for backward compatibility
+		CountryCode:                 "US",
+		CurrentDate:                 time.Now(),
+		ParallelWorkers:             4,
+		ValidatorVersion:            "1.0.0",
+		ValidationMode:              ValidationModeDefault,
+		MaxNoticesPerType:           100,
+		EnableCaching:               false, // Default false for backward compatibility
+		DiffThresholds:              DefaultDiffThresholds(),
+		ArchiveLimits:               DefaultArchiveLimits(),
+		Fetch:                       DefaultFetchOptions(),
+		CrossFeedStopDistanceMeters: 10,
+		StreamBufferSize:            defaultStreamBufferSize,
 	}
 
 	for _, opt := range opts {
@@ -335,6 +647,7 @@ func New(opts ...Option) Validator {
 	if err := validateConfig(config); err != nil {
 		// For backward compatibility, we'll use default values for invalid config
 		// In a future version, this could return an error
+		config.logger().Warn("Invalid configuration, using defaults", logging.ErrorField("error", err))
 		sanitizeConfig(config)
 	}
 
@@ -359,6 +672,21 @@ func (v *validatorImpl) ValidateReader(reader io.Reader) (*ValidationReport, err
 	return v.ValidateReaderWithContext(context.Background(), reader)
 }
 
+// ValidateBytes validates a GTFS ZIP archive held in memory.
+func (v *validatorImpl) ValidateBytes(data []byte) (*ValidationReport, error) {
+	return v.ValidateBytesWithContext(context.Background(), data)
+}
+
+// ValidateFS validates a GTFS feed stored at the root of a file system.
+func (v *validatorImpl) ValidateFS(fsys fs.FS) (*ValidationReport, error) {
+	return v.ValidateFSWithContext(context.Background(), fsys)
+}
+
+// ValidateURL downloads and validates a GTFS feed.
+func (v *validatorImpl) ValidateURL(url string) (*ValidationReport, error) {
+	return v.ValidateURLWithContext(context.Background(), url)
+}
+
 // ValidateFileStream validates with streaming notice delivery.
 func (v *validatorImpl) ValidateFileStream(path string, callback NoticeCallback) (*ValidationReport, error) {
 	return v.ValidateFileStreamWithContext(context.Background(), path, callback)
@@ -383,35 +711,7 @@ func (v *validatorImpl) ValidateFileStreamWithContext(ctx context.Context, path
 	validationConfig := v.createValidationConfig()
 	internalValidator := newInternalValidatorWithStreaming(internalConfig, validationConfig, callback)
 
-	// Set up progress reporting
-	if v.config.ProgressCallback != nil {
-		internalValidator.progressCallback = v.config.ProgressCallback
-	}
-
-	// Validate based on file type
-	var internalReport *report.ValidationReport
-	var err error
-
-	if strings.HasSuffix(strings.ToLower(path), ".zip") {
-		internalReport, err = internalValidator.ValidateZipWithContext(ctx, path)
-	} else {
-		// Check if it's a directory
-		info, statErr := os.Stat(path)
-		if statErr != nil {
-			return nil, fmt.Errorf("cannot access path: %w", statErr)
-		}
-		if !info.IsDir() {
-			return nil, fmt.Errorf("path must be a ZIP file or directory")
-		}
-		internalReport, err = internalValidator.ValidateDirectoryWithContext(ctx, path)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	// Convert internal report to public API format
-	return v.convertReport(internalReport, time.Since(startTime)), nil
+	return v.validatePath(ctx, internalValidator, path, startTime)
 }
 
 // validateConfig validates the configuration and returns an error if invalid.
@@ -449,6 +749,13 @@ func validateConfig(config *Config) error {
 		errs = append(errs, errors.New("ValidatorVersion cannot be empty"))
 	}
 
+	// Validate Locale (should be a BCP 47 language tag)
+	if config.Locale != "" {
+		if _, err := language.Parse(config.Locale); err != nil {
+			errs = append(errs, fmt.Errorf("Locale must be a BCP 47 language tag, got: %s", config.Locale))
+		}
+	}
+
 	// Validate ValidationMode (should be a known mode)
 	switch config.ValidationMode {
 	case ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive:
@@ -467,6 +774,47 @@ func validateConfig(config *Config) error {
 		errs = append(errs, fmt.Errorf("MaxNoticesPerType is too high (maximum 10000): %d", config.MaxNoticesPerType))
 	}
 
+	// Validate DiffThresholds (should not be negative)
+	if config.DiffThresholds.TripCountChangePercent < 0 || config.DiffThresholds.StopMovedMeters < 0 || config.DiffThresholds.ShapeChangedMeters < 0 {
+		errs = append(errs, fmt.Errorf("DiffThresholds cannot be negative: %+v", config.DiffThresholds))
+	}
+
+	// Validate ArchiveLimits (should not be negative)
+	limits := config.ArchiveLimits
+	if limits.MaxArchiveSize < 0 || limits.MaxEntries < 0 || limits.MaxFileSize < 0 || limits.MaxUncompressedSize < 0 || limits.MaxCompressionRatio < 0 {
+		errs = append(errs, fmt.Errorf("ArchiveLimits cannot be negative: %+v", limits))
+	}
+
+	// Validate CrossFeedStopDistanceMeters (should not be negative)
+	if config.CrossFeedStopDistanceMeters < 0 {
+		errs = append(errs, fmt.Errorf("CrossFeedStopDistanceMeters cannot be negative: %v", config.CrossFeedStopDistanceMeters))
+	}
+
+	// Validate StreamBufferSize (should not be negative)
+	if config.StreamBufferSize < 0 {
+		errs = append(errs, fmt.Errorf("StreamBufferSize cannot be negative: %d", config.StreamBufferSize))
+	}
+
+	// Validate Fetch options (should not be negative)
+	if config.Fetch.Timeout < 0 || config.Fetch.MaxSize < 0 || config.Fetch.MaxRedirects < 0 {
+		errs = append(errs, fmt.Errorf("Fetch options cannot be negative: %+v", config.Fetch))
+	}
+
+	// Validate SeverityOverrides and DisabledRules (should name known codes and severities)
+	for code, severity := range config.SeverityOverrides {
+		if _, known := notice.LookupRule(code); !known {
+			errs = append(errs, fmt.Errorf("unknown notice code in SeverityOverrides: %s", code))
+		}
+		if _, err := notice.ParseSeverity(severity); err != nil {
+			errs = append(errs, fmt.Errorf("invalid severity for %s: %v", code, err))
+		}
+	}
+	for _, code := range config.DisabledRules {
+		if _, known := notice.LookupRule(code); !known {
+			errs = append(errs, fmt.Errorf("unknown notice code in DisabledRules: %s", code))
+		}
+	}
+
 	// Combine errors if any
 	if len(errs) > 0 {
 		var errStr string
@@ -516,6 +864,11 @@ func sanitizeConfig(config *Config) {
 		config.ValidatorVersion = "1.0.0"
 	}
 
+	// Sanitize Locale
+	if _, err := language.Parse(config.Locale); config.Locale != "" && err != nil {
+		config.Locale = ""
+	}
+
 	// Sanitize ValidationMode
 	switch config.ValidationMode {
 	case ValidationModePerformance, ValidationModeDefault, ValidationModeComprehensive:
@@ -532,4 +885,71 @@ func sanitizeConfig(config *Config) {
 		config.MaxNoticesPerType = 10000
 	}
 	// 0 is valid (no limit), no action needed
+
+	// Sanitize DiffThresholds
+	defaults := DefaultDiffThresholds()
+	if config.DiffThresholds.TripCountChangePercent < 0 {
+		config.DiffThresholds.TripCountChangePercent = defaults.TripCountChangePercent
+	}
+	if config.DiffThresholds.StopMovedMeters < 0 {
+		config.DiffThresholds.StopMovedMeters = defaults.StopMovedMeters
+	}
+	if config.DiffThresholds.ShapeChangedMeters < 0 {
+		config.DiffThresholds.ShapeChangedMeters = defaults.ShapeChangedMeters
+	}
+
+	// Sanitize ArchiveLimits
+	defaultLimits := DefaultArchiveLimits()
+	if config.ArchiveLimits.MaxArchiveSize < 0 {
+		config.ArchiveLimits.MaxArchiveSize = defaultLimits.MaxArchiveSize
+	}
+	if config.ArchiveLimits.MaxEntries < 0 {
+		config.ArchiveLimits.MaxEntries = defaultLimits.MaxEntries
+	}
+	if config.ArchiveLimits.MaxFileSize < 0 {
+		config.ArchiveLimits.MaxFileSize = defaultLimits.MaxFileSize
+	}
+	if config.ArchiveLimits.MaxUncompressedSize < 0 {
+		config.ArchiveLimits.MaxUncompressedSize = defaultLimits.MaxUncompressedSize
+	}
+	if config.ArchiveLimits.MaxCompressionRatio < 0 {
+		config.ArchiveLimits.MaxCompressionRatio = defaultLimits.MaxCompressionRatio
+	}
+
+	// Sanitize CrossFeedStopDistanceMeters
+	if config.CrossFeedStopDistanceMeters < 0 {
+		config.CrossFeedStopDistanceMeters = 10
+	}
+
+	// Sanitize StreamBufferSize
+	if config.StreamBufferSize < 0 {
+		config.StreamBufferSize = defaultStreamBufferSize
+	}
+
+	// Sanitize Fetch options
+	defaultFetch := DefaultFetchOptions()
+	if config.Fetch.Timeout < 0 {
+		config.Fetch.Timeout = defaultFetch.Timeout
+	}
+	if config.Fetch.MaxSize < 0 {
+		config.Fetch.MaxSize = defaultFetch.MaxSize
+	}
+	if config.Fetch.MaxRedirects < 0 {
+		config.Fetch.MaxRedirects = defaultFetch.MaxRedirects
+	}
+
+	// Sanitize SeverityOverrides and DisabledRules
+	for code, severity := range config.SeverityOverrides {
+		_, known := notice.LookupRule(code)
+		if _, err := notice.ParseSeverity(severity); !known || err != nil {
+			delete(config.SeverityOverrides, code)
+		}
+	}
+	disabled := config.DisabledRules[:0]
+	for _, code := range config.DisabledRules {
+		if _, known := notice.LookupRule(code); known {
+			disabled = append(disabled, code)
+		}
+	}
+	config.DisabledRules = disabled
 }
@@ -2,10 +2,10 @@ package accessibility
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -56,11 +56,11 @@ func (v *LevelValidator) loadLevels(loader *parser.FeedLoader) map[string]*Level
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "levels.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "levels.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "levels.txt", loader.CSVOptions())
 	if err != nil {
 		return levels
 	}
@@ -188,11 +188,11 @@ func (v *LevelValidator) loadUsedLevelsFromStops(loader *parser.FeedLoader) map[
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return usedLevels
 	}
@@ -224,11 +224,11 @@ func (v *LevelValidator) loadUsedLevelsFromPathways(loader *parser.FeedLoader) m
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "pathways.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "pathways.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "pathways.txt", loader.CSVOptions())
 	if err != nil {
 		return usedLevels
 	}
@@ -2,10 +2,10 @@ package accessibility
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -79,11 +79,11 @@ func (v *PathwayValidator) loadPathways(loader *parser.FeedLoader) []*PathwayInf
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "pathways.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "pathways.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "pathways.txt", loader.CSVOptions())
 	if err != nil {
 		return pathways
 	}
@@ -189,11 +189,11 @@ func (v *PathwayValidator) loadStopsForPathways(loader *parser.FeedLoader) map[s
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return stops
 	}
@@ -3,11 +3,11 @@ package business
 import (
 	"fmt"
 	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -62,11 +62,11 @@ func (v *BlockOverlappingValidator) loadTripBlocks(loader *parser.FeedLoader) ma
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return tripBlocks
 	}
@@ -119,11 +119,11 @@ func (v *BlockOverlappingValidator) loadTripTimeRanges(loader *parser.FeedLoader
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stop_times.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stop_times.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stop_times.txt", loader.CSVOptions())
 	if err != nil {
 		return tripTimeRanges
 	}
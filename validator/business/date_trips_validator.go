@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -78,11 +78,11 @@ func (v *DateTripsValidator) loadServices(loader *parser.FeedLoader) map[string]
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return services
 	}
@@ -152,11 +152,11 @@ func (v *DateTripsValidator) loadCalendarExceptions(loader *parser.FeedLoader) [
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return exceptions
 	}
@@ -214,11 +214,11 @@ func (v *DateTripsValidator) countTripsForService(loader *parser.FeedLoader, ser
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return 0
 	}
@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -67,11 +67,11 @@ func (v *FeedExpirationDateValidator) loadFeedInfo(loader *parser.FeedLoader) *F
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "feed_info.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "feed_info.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "feed_info.txt", loader.CSVOptions())
 	if err != nil {
 		return nil
 	}
@@ -257,11 +257,11 @@ func (v *FeedExpirationDateValidator) findLatestCalendarDate(loader *parser.Feed
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return nil
 	}
@@ -297,11 +297,11 @@ func (v *FeedExpirationDateValidator) findLatestCalendarDatesDate(loader *parser
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return nil
 	}
@@ -374,11 +374,11 @@ func (v *FeedExpirationDateValidator) addCalendarServices(loader *parser.FeedLoa
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -438,11 +438,11 @@ func (v *FeedExpirationDateValidator) addCalendarDatesServices(loader *parser.Fe
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -501,11 +501,11 @@ func (v *FeedExpirationDateValidator) countTripsForServices(loader *parser.FeedL
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return 0
 	}
@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -28,11 +28,11 @@ func (v *FeedExpirationValidator) Validate(loader *parser.FeedLoader, container
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "feed_info.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "feed_info.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "feed_info.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
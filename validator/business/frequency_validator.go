@@ -3,11 +3,11 @@ package business
 import (
 	"fmt"
 	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -57,11 +57,11 @@ func (v *FrequencyValidator) loadFrequencies(loader *parser.FeedLoader) []*Frequ
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "frequencies.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "frequencies.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "frequencies.txt", loader.CSVOptions())
 	if err != nil {
 		return frequencies
 	}
@@ -279,11 +279,11 @@ func (v *FrequencyValidator) loadTripIDs(loader *parser.FeedLoader) map[string]b
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return tripIDs
 	}
@@ -2,12 +2,12 @@ package business
 
 import (
 	"io"
-	"log"
 	"math"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -101,11 +101,11 @@ func (v *GeospatialValidator) loadGeoStops(loader *parser.FeedLoader) map[string
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return stops
 	}
@@ -180,11 +180,11 @@ func (v *GeospatialValidator) loadGeoShapes(loader *parser.FeedLoader) map[strin
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "shapes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "shapes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "shapes.txt", loader.CSVOptions())
 	if err != nil {
 		return shapes
 	}
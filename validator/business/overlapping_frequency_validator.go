@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -71,11 +71,11 @@ func (v *OverlappingFrequencyValidator) loadFrequencies(loader *parser.FeedLoade
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "frequencies.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "frequencies.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "frequencies.txt", loader.CSVOptions())
 	if err != nil {
 		return frequencies
 	}
@@ -145,11 +145,11 @@ func (v *OverlappingFrequencyValidator) loadTripInfo(loader *parser.FeedLoader)
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return trips
 	}
@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -75,11 +75,11 @@ func (v *ServiceCalendarValidator) loadCalendars(loader *parser.FeedLoader) map[
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return calendars
 	}
@@ -162,11 +162,11 @@ func (v *ServiceCalendarValidator) loadCalendarDates(loader *parser.FeedLoader)
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return calendarDates
 	}
@@ -2,12 +2,12 @@ package business
 
 import (
 	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -87,11 +87,11 @@ func (v *ServiceConsistencyValidator) loadServices(loader *parser.FeedLoader) ma
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return services
 	}
@@ -156,11 +156,11 @@ func (v *ServiceConsistencyValidator) loadServiceExceptions(loader *parser.FeedL
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return exceptions
 	}
@@ -216,11 +216,11 @@ func (v *ServiceConsistencyValidator) loadTripServices(loader *parser.FeedLoader
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return tripServices
 	}
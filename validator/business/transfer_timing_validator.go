@@ -2,11 +2,11 @@ package business
 
 import (
 	"io"
-	"log"
 	"math"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -63,11 +63,11 @@ func (v *TransferTimingValidator) loadTransfers(loader *parser.FeedLoader) []*Tr
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "transfers.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "transfers.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "transfers.txt", loader.CSVOptions())
 	if err != nil {
 		return transfers
 	}
@@ -132,11 +132,11 @@ func (v *TransferTimingValidator) loadStopLocations(loader *parser.FeedLoader) m
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return stops
 	}
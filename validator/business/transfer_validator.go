@@ -2,10 +2,10 @@ package business
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -62,11 +62,11 @@ func (v *TransferValidator) loadTransfers(loader *parser.FeedLoader) []*Transfer
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "transfers.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "transfers.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "transfers.txt", loader.CSVOptions())
 	if err != nil {
 		return transfers
 	}
@@ -131,11 +131,11 @@ func (v *TransferValidator) loadStopIDs(loader *parser.FeedLoader) map[string]bo
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return stopIDs
 	}
@@ -3,12 +3,12 @@ package business
 import (
 	"fmt"
 	"io"
-	"log"
 	"math"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -73,11 +73,11 @@ func (v *TravelSpeedValidator) loadStopLocations(loader *parser.FeedLoader) map[
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stops.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stops.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stops.txt", loader.CSVOptions())
 	if err != nil {
 		return stopLocations
 	}
@@ -128,10 +128,10 @@ func (v *TravelSpeedValidator) loadRouteTypes(loader *parser.FeedLoader) map[str
 	if reader, err := loader.GetFile("routes.txt"); err == nil {
 		defer func() {
 			if closeErr := reader.Close(); closeErr != nil {
-				log.Printf("Warning: failed to close reader %v", closeErr)
+				loader.Logger().Warn("Failed to close reader", logging.String("file", "routes.txt"), logging.ErrorField("error", closeErr))
 			}
 		}()
-		if csvFile, err := parser.NewCSVFile(reader, "routes.txt"); err == nil {
+		if csvFile, err := parser.NewCSVFileWithOptions(reader, "routes.txt", loader.CSVOptions()); err == nil {
 			for {
 				row, err := csvFile.ReadRow()
 				if err == io.EOF {
@@ -157,10 +157,10 @@ func (v *TravelSpeedValidator) loadRouteTypes(loader *parser.FeedLoader) map[str
 	if reader, err := loader.GetFile("trips.txt"); err == nil {
 		defer func() {
 			if closeErr := reader.Close(); closeErr != nil {
-				log.Printf("Warning: failed to close reader %v", closeErr)
+				loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 			}
 		}()
-		if csvFile, err := parser.NewCSVFile(reader, "trips.txt"); err == nil {
+		if csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions()); err == nil {
 			for {
 				row, err := csvFile.ReadRow()
 				if err == io.EOF {
@@ -199,11 +199,11 @@ func (v *TravelSpeedValidator) validateStopTimeSpeeds(loader *parser.FeedLoader,
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stop_times.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stop_times.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stop_times.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -2,9 +2,9 @@ package business
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -26,11 +26,11 @@ func (v *TripUsabilityValidator) Validate(loader *parser.FeedLoader, container *
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "stop_times.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "stop_times.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "stop_times.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -0,0 +1,33 @@
+package core
+
+import (
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// ArchiveLayoutValidator reports ZIP layout problems recorded while loading the feed
+type ArchiveLayoutValidator struct{}
+
+// NewArchiveLayoutValidator creates a new archive layout validator
+func NewArchiveLayoutValidator() *ArchiveLayoutValidator {
+	return &ArchiveLayoutValidator{}
+}
+
+// Validate emits a notice for each archive layout issue found by the loader
+func (v *ArchiveLayoutValidator) Validate(loader *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	for _, issue := range loader.ArchiveIssues() {
+		switch issue.Type {
+		case parser.ArchiveIssueSubfolder:
+			container.AddNotice(notice.NewArchiveFileInSubfolderNotice(issue.Filename, issue.Path, loader.HasFile(issue.Filename)))
+		case parser.ArchiveIssueDuplicateName:
+			container.AddNotice(notice.NewArchiveDuplicateFileNameNotice(issue.Filename, issue.Path, issue.IgnoredPaths))
+		case parser.ArchiveIssueNestedArchive:
+			container.AddNotice(notice.NewArchiveNestedZipNotice(issue.Path))
+		case parser.ArchiveIssueOSMetadata:
+			container.AddNotice(notice.NewArchiveOSMetadataNotice(issue.Path))
+		case parser.ArchiveIssueNonUTF8Name:
+			container.AddNotice(notice.NewArchiveNonUTF8FileNameNotice(issue.Path))
+		}
+	}
+}
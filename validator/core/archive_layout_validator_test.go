@@ -0,0 +1,154 @@
+package core
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// createZipLoader builds an in-memory archive with the given entries, in order
+func createZipLoader(t *testing.T, entries []string, options parser.ArchiveOptions) *parser.FeedLoader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, name := range entries {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create zip entry %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte("id\n" + name)); err != nil {
+			t.Fatalf("Failed to write zip entry %s: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close zip writer: %v", err)
+	}
+
+	loader, err := parser.LoadFromZipReaderWithOptions(bytes.NewReader(buf.Bytes()), int64(buf.Len()), options)
+	if err != nil {
+		t.Fatalf("Failed to load zip: %v", err)
+	}
+	return loader
+}
+
+func TestArchiveLayoutValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		entries             []string
+		options             parser.ArchiveOptions
+		expectedNoticeCodes []string
+		expectedFiles       []string
+		missingFiles        []string
+	}{
+		{
+			name:          "clean archive",
+			entries:       []string{AgencyFile, "stops.txt"},
+			expectedFiles: []string{AgencyFile, "stops.txt"},
+		},
+		{
+			name:                "feed zipped inside a folder",
+			entries:             []string{"feed/agency.txt", "feed/stops.txt"},
+			expectedNoticeCodes: []string{"archive_file_in_subfolder", "archive_file_in_subfolder"},
+			expectedFiles:       []string{AgencyFile, "stops.txt"},
+		},
+		{
+			name:                "subfolder files ignored",
+			entries:             []string{"feed/agency.txt", "stops.txt"},
+			options:             parser.ArchiveOptions{IgnoreSubfolderFiles: true},
+			expectedNoticeCodes: []string{"archive_file_in_subfolder"},
+			expectedFiles:       []string{"stops.txt"},
+			missingFiles:        []string{AgencyFile},
+		},
+		{
+			name:                "root file wins over subfolder copy",
+			entries:             []string{"old/stops.txt", "stops.txt"},
+			expectedNoticeCodes: []string{"archive_duplicate_file_name"},
+			expectedFiles:       []string{"stops.txt"},
+		},
+		{
+			name:                "same name in two subfolders",
+			entries:             []string{"a/stops.txt", "b/stops.txt"},
+			expectedNoticeCodes: []string{"archive_file_in_subfolder", "archive_duplicate_file_name"},
+			expectedFiles:       []string{"stops.txt"},
+		},
+		{
+			name:                "macOS metadata and nested zip",
+			entries:             []string{AgencyFile, "__MACOSX/._agency.txt", ".DS_Store", "backup.zip"},
+			expectedNoticeCodes: []string{"archive_os_metadata", "archive_os_metadata", "archive_nested_zip"},
+			expectedFiles:       []string{AgencyFile},
+		},
+		{
+			name:                "non UTF-8 entry name",
+			entries:             []string{AgencyFile, "arr\xeats.txt"},
+			expectedNoticeCodes: []string{"archive_non_utf8_file_name"},
+			expectedFiles:       []string{AgencyFile},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := createZipLoader(t, tt.entries, tt.options)
+			container := notice.NewNoticeContainer()
+
+			NewArchiveLayoutValidator().Validate(loader, container, gtfsvalidator.Config{})
+
+			expectedCodeCounts := make(map[string]int)
+			for _, code := range tt.expectedNoticeCodes {
+				expectedCodeCounts[code]++
+			}
+			actualCodeCounts := make(map[string]int)
+			for _, n := range container.GetNotices() {
+				actualCodeCounts[n.Code()]++
+			}
+			for code, count := range expectedCodeCounts {
+				if actualCodeCounts[code] != count {
+					t.Errorf("Expected %d notices with code '%s', got %d", count, code, actualCodeCounts[code])
+				}
+			}
+			for code := range actualCodeCounts {
+				if expectedCodeCounts[code] == 0 {
+					t.Errorf("Unexpected notice code: %s", code)
+				}
+			}
+
+			for _, filename := range tt.expectedFiles {
+				if !loader.HasFile(filename) {
+					t.Errorf("Expected %s to be used for validation", filename)
+				}
+			}
+			for _, filename := range tt.missingFiles {
+				if loader.HasFile(filename) {
+					t.Errorf("Expected %s to be ignored", filename)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveLayoutValidator_DuplicateUsesRootFile(t *testing.T) {
+	loader := createZipLoader(t, []string{"old/stops.txt", "stops.txt"}, parser.ArchiveOptions{})
+
+	reader, err := loader.GetFile("stops.txt")
+	if err != nil {
+		t.Fatalf("Failed to get stops.txt: %v", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(reader); err != nil {
+		t.Fatalf("Failed to read stops.txt: %v", err)
+	}
+	if buf.String() != "id\nstops.txt" {
+		t.Errorf("Expected root-level stops.txt, got %q", buf.String())
+	}
+
+	entries := loader.ArchiveEntries()
+	if len(entries) != 2 || entries[0].Used || !entries[1].Used {
+		t.Errorf("Unexpected archive entries: %+v", entries)
+	}
+}
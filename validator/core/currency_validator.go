@@ -2,9 +2,9 @@ package core
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -56,11 +56,11 @@ func (v *CurrencyValidator) validateFileCurrency(loader *parser.FeedLoader, cont
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return
 	}
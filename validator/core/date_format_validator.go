@@ -2,10 +2,10 @@ package core
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -41,11 +41,11 @@ func (v *DateFormatValidator) validateFileDateFields(loader *parser.FeedLoader,
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -1,9 +1,9 @@
 package core
 
 import (
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -34,11 +34,11 @@ func (v *DuplicateHeaderValidator) validateFileHeaders(loader *parser.FeedLoader
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return // File format issues, other validators handle this
 	}
@@ -2,9 +2,9 @@ package core
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -64,11 +64,11 @@ func (v *DuplicateKeyValidator) validateFileKeys(loader *parser.FeedLoader, cont
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", config.Filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, config.Filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, config.Filename, loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -103,13 +103,15 @@ func (v *DuplicateKeyValidator) validateFileKeys(loader *parser.FeedLoader, cont
 		if firstRowNumber, exists := keyMap[key]; exists {
 			// Duplicate key found
 			if config.IsComposite {
-				container.AddNotice(notice.NewDuplicateCompositeKeyNotice(
+				n := notice.NewDuplicateCompositeKeyNotice(
 					config.Filename,
 					strings.Join(config.KeyFields, "+"),
 					key,
 					firstRowNumber,
 					row.RowNumber,
-				))
+				)
+				n.SetPrimaryKey(keyValues(row, config.KeyFields))
+				container.AddNotice(n)
 			} else {
 				container.AddNotice(notice.NewDuplicateKeyNotice(
 					config.Filename,
@@ -144,6 +146,15 @@ func (v *DuplicateKeyValidator) buildKey(row *parser.CSVRow, keyFields []string)
 	return strings.Join(keyParts, "|")
 }
 
+// keyValues returns the trimmed values of the key fields, keyed by field name
+func keyValues(row *parser.CSVRow, keyFields []string) map[string]string {
+	values := make(map[string]string, len(keyFields))
+	for _, field := range keyFields {
+		values[field] = strings.TrimSpace(row.Values[field])
+	}
+	return values
+}
+
 // validateSingleRecordFile validates files that should contain only one record
 func (v *DuplicateKeyValidator) validateSingleRecordFile(container *notice.NoticeContainer, csvFile *parser.CSVFile, filename string) {
 	rowCount := 0
@@ -2,9 +2,9 @@ package core
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -35,7 +35,7 @@ func (v *EmptyFileValidator) validateFileNotEmpty(loader *parser.FeedLoader, con
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
@@ -77,11 +77,11 @@ func (v *EmptyFileValidator) validateFileNotEmpty(loader *parser.FeedLoader, con
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return // File format issues, other validators handle this
 	}
@@ -112,7 +112,8 @@ func (v *EmptyFileValidator) validateFileNotEmpty(loader *parser.FeedLoader, con
 		}
 	}
 
-	if !hasData {
+	// Rows skipped as malformed are reported as CSV parsing errors by other validators
+	if !hasData && len(csvFile.ParseErrors()) == 0 {
 		container.AddNotice(notice.NewEmptyFileNotice(filename))
 	}
 }
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// EncodingValidator checks that feed files are encoded as UTF-8
+type EncodingValidator struct{}
+
+// NewEncodingValidator creates a new encoding validator
+func NewEncodingValidator() *EncodingValidator {
+	return &EncodingValidator{}
+}
+
+// Validate detects the encoding of each file. Files in another encoding get a
+// single notice; invalid sequences in UTF-8 files are reported per field.
+func (v *EncodingValidator) Validate(loader *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	files := loader.ListFiles()
+	sort.Strings(files)
+
+	for _, filename := range files {
+		if !strings.HasSuffix(filename, ".txt") {
+			continue
+		}
+
+		info, err := loader.DetectFileEncoding(filename)
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case info.IsUTF8():
+		case info.IsUTF16() && info.BOM:
+			container.AddNotice(notice.NewUTF16ByteOrderMarkNotice(filename, string(info.Encoding), loader.TranscodingEnabled()))
+		case info.Encoding != parser.EncodingUTF8:
+			container.AddNotice(notice.NewNonUTF8EncodingNotice(filename, string(info.Encoding), loader.TranscodingEnabled()))
+		default:
+			v.validateInvalidSequences(loader, container, filename)
+		}
+	}
+}
+
+// validateInvalidSequences reports each field containing invalid UTF-8 bytes
+func (v *EncodingValidator) validateInvalidSequences(loader *parser.FeedLoader, container *notice.NoticeContainer, filename string) {
+	reader, err := loader.GetRawFile(filename)
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+
+	csvFile, err := parser.NewCSVFile(reader, filename)
+	if err != nil {
+		return
+	}
+
+	for i, header := range csvFile.Headers {
+		if !utf8.ValidString(header) {
+			container.AddNotice(notice.NewInvalidUTF8SequenceNotice(filename, 1, strings.ToValidUTF8(header, "�"), i+1, strings.ToValidUTF8(header, "�"), invalidUTF8Bytes(header)))
+		}
+	}
+
+	for {
+		row, err := csvFile.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			break
+		}
+
+		for i, header := range csvFile.Headers {
+			value := row.Values[header]
+			if utf8.ValidString(value) {
+				continue
+			}
+			container.AddNotice(notice.NewInvalidUTF8SequenceNotice(filename, row.RowNumber, strings.ToValidUTF8(header, "�"), i+1, strings.ToValidUTF8(value, "�"), invalidUTF8Bytes(value)))
+		}
+	}
+}
+
+// invalidUTF8Bytes formats the first invalid byte sequence of s in hex, e.g. "E9"
+func invalidUTF8Bytes(s string) string {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != utf8.RuneError || size != 1 {
+			i += size
+			continue
+		}
+		end := i + 1
+		for end < len(s) && end-i < utf8.UTFMax && !utf8.RuneStart(s[end]) {
+			end++
+		}
+		return fmt.Sprintf("%X", s[i:end])
+	}
+	return ""
+}
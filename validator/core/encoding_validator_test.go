@@ -0,0 +1,95 @@
+package core
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/testutil"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+func TestEncodingValidator_Validate(t *testing.T) {
+	utf16, err := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder().String("stop_id,stop_name\nS1,Main St\n")
+	if err != nil {
+		t.Fatalf("Failed to encode UTF-16: %v", err)
+	}
+
+	tests := []struct {
+		name                string
+		files               map[string]string
+		expectedNoticeCodes []string
+		expectedContext     map[string]interface{}
+	}{
+		{
+			name: "utf-8 files",
+			files: map[string]string{
+				AgencyFile:  "agency_id,agency_name\nA1,Société de Transport",
+				"stops.txt": "\ufeffstop_id,stop_name\nS1,Plaça",
+			},
+		},
+		{
+			name: "windows-1252 file",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\nS1,\x93Caf\xe9\x94\nS2,Gr\xfcn",
+			},
+			expectedNoticeCodes: []string{"non_utf8_encoding"},
+			expectedContext:     map[string]interface{}{"filename": "stops.txt", "encoding": "Windows-1252", "transcoded": false},
+		},
+		{
+			name: "utf-16 file with BOM",
+			files: map[string]string{
+				"stops.txt": utf16,
+			},
+			expectedNoticeCodes: []string{"utf16_byte_order_mark"},
+			expectedContext:     map[string]interface{}{"filename": "stops.txt", "encoding": "UTF-16LE"},
+		},
+		{
+			name: "invalid sequence in a utf-8 file",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\nS1,Plaça\nS2,Caf\xe9 Central",
+			},
+			expectedNoticeCodes: []string{"invalid_utf8_sequence"},
+			expectedContext: map[string]interface{}{
+				"filename": "stops.txt", "csvRowNumber": 3, "fieldName": "stop_name", "columnNumber": 2,
+				"fieldValue": "Caf� Central", "invalidBytes": "E9",
+			},
+		},
+		{
+			name: "invalid sequence in a header",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_n\xe4me\nS1,Plaça",
+			},
+			expectedNoticeCodes: []string{"invalid_utf8_sequence"},
+			expectedContext:     map[string]interface{}{"csvRowNumber": 1, "columnNumber": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := testutil.CreateTestFeedLoader(t, tt.files)
+			container := notice.NewNoticeContainer()
+			NewEncodingValidator().Validate(loader, container, gtfsvalidator.Config{})
+
+			notices := container.GetNotices()
+			if len(notices) != len(tt.expectedNoticeCodes) {
+				t.Fatalf("Expected %d notices, got %d", len(tt.expectedNoticeCodes), len(notices))
+			}
+			for i, code := range tt.expectedNoticeCodes {
+				if notices[i].Code() != code {
+					t.Errorf("Expected notice %s, got %s", code, notices[i].Code())
+				}
+			}
+			if tt.expectedContext == nil {
+				return
+			}
+			context := notices[0].Context()
+			for key, expected := range tt.expectedContext {
+				if context[key] != expected {
+					t.Errorf("Expected context %s=%v, got %v", key, expected, context[key])
+				}
+			}
+		})
+	}
+}
@@ -2,11 +2,11 @@ package core
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -91,11 +91,11 @@ func (v *InvalidRowValidator) validateFile(loader *parser.FeedLoader, container
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -108,7 +108,8 @@ func (v *InvalidRowValidator) validateFile(loader *parser.FeedLoader, container
 			break
 		}
 		if err != nil {
-			// CSV parsing error - check if it's a wrong number of fields error
+			// CSV parsing error - check if it's a wrong number of fields error.
+			// In recovery mode malformed rows are skipped by the parser and reported below.
 			if strings.Contains(err.Error(), "wrong number of fields") {
 				// Extract field count information if possible
 				// For now, we'll generate a wrong number of fields notice
@@ -143,6 +144,17 @@ func (v *InvalidRowValidator) validateFile(loader *parser.FeedLoader, container
 		// Check for specific invalid patterns based on file type
 		v.validateRowContent(container, filename, row)
 	}
+
+	// Malformed rows skipped by the parser
+	for _, parseErr := range csvFile.ParseErrors() {
+		container.AddNotice(notice.NewCSVParsingFailedNotice(
+			filename,
+			parseErr.RowNumber,
+			parseErr.Line,
+			parseErr.RawText,
+			parseErr.Message,
+		))
+	}
 }
 
 // validateRowContent validates the content of a row based on file-specific rules
@@ -318,6 +318,25 @@ func TestInvalidRowValidator_Validate(t *testing.T) {
 	}
 }
 
+func TestInvalidRowValidator_CSVRecovery(t *testing.T) {
+	loader := testutil.CreateTestFeedLoader(t, map[string]string{
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\nT1,08:00:00,08:00:00,\"1,1\nT1,08:10:00,08:10:00,2,-2",
+	})
+	loader.EnableCSVRecovery()
+	container := notice.NewNoticeContainer()
+
+	NewInvalidRowValidator().Validate(loader, container, gtfsvalidator.Config{})
+
+	// The malformed row is reported and the following row is still validated
+	codes := make(map[string]int)
+	for _, n := range container.GetNotices() {
+		codes[n.Code()]++
+	}
+	if len(codes) != 2 || codes["csv_parsing_failed"] != 1 || codes["negative_stop_sequence"] != 1 {
+		t.Errorf("Expected csv_parsing_failed and negative_stop_sequence, got %v", codes)
+	}
+}
+
 func TestInvalidRowValidator_ValidateRowContent(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// StrictCSVValidator reports RFC 4180 violations that the lenient parser accepts
+type StrictCSVValidator struct{}
+
+// NewStrictCSVValidator creates a new strict CSV validator
+func NewStrictCSVValidator() *StrictCSVValidator {
+	return &StrictCSVValidator{}
+}
+
+// Validate runs a strict diagnostics pass over each CSV file. Parsing for the
+// other validators stays lenient.
+func (v *StrictCSVValidator) Validate(loader *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	files := loader.ListFiles()
+	sort.Strings(files)
+
+	for _, filename := range files {
+		if !strings.HasSuffix(filename, ".txt") {
+			continue
+		}
+		// UTF-16 files are reported by the encoding validator; their bytes are not CSV text
+		if info, err := loader.DetectFileEncoding(filename); err != nil || info.IsUTF16() {
+			continue
+		}
+		v.validateFile(loader, container, filename)
+	}
+}
+
+// validateFile diagnoses a single file from its raw bytes
+func (v *StrictCSVValidator) validateFile(loader *parser.FeedLoader, container *notice.NoticeContainer, filename string) {
+	reader, err := loader.GetRawFile(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
+		}
+	}()
+
+	diagnostics, err := parser.DiagnoseCSV(reader)
+	if err != nil {
+		return
+	}
+
+	for _, issue := range diagnostics.Issues {
+		switch issue.Type {
+		case parser.CSVIssueBareQuote:
+			container.AddNotice(notice.NewCSVBareQuoteNotice(filename, issue.RowNumber, issue.Line, issue.Column))
+		case parser.CSVIssueInvalidQuote:
+			container.AddNotice(notice.NewCSVInvalidQuoteNotice(filename, issue.RowNumber, issue.Line, issue.Column))
+		case parser.CSVIssueUnterminatedQuote:
+			container.AddNotice(notice.NewCSVUnterminatedQuoteNotice(filename, issue.RowNumber, issue.Line, issue.Column))
+		case parser.CSVIssueNULByte:
+			container.AddNotice(notice.NewCSVNULByteNotice(filename, issue.RowNumber, issue.Line, issue.Column))
+		case parser.CSVIssueMixedLineEndings:
+			container.AddNotice(notice.NewCSVMixedLineEndingsNotice(filename, issue.Line, diagnostics.CRLFLines, diagnostics.LFLines))
+		case parser.CSVIssueMissingTrailingNewline:
+			container.AddNotice(notice.NewCSVMissingTrailingNewlineNotice(filename, issue.Line))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/testutil"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+func TestStrictCSVValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		files               map[string]string
+		expectedNoticeCodes []string
+		expectedContext     map[string]interface{}
+	}{
+		{
+			name: "well-formed files",
+			files: map[string]string{
+				AgencyFile:  "agency_id,agency_name\nA1,\"Metro \"\"Blue\"\"\"\n",
+				"stops.txt": "stop_id,stop_name\r\nS1,Main\r\n",
+			},
+		},
+		{
+			name: "bare quote",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\nS1,Platform 5\"\n",
+			},
+			expectedNoticeCodes: []string{"csv_bare_quote"},
+			expectedContext:     map[string]interface{}{"filename": "stops.txt", "csvRowNumber": 2, "lineNumber": 2, "column": 14},
+		},
+		{
+			name: "unterminated quote",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\nS1,\"Broken\nS2,Main\n",
+			},
+			expectedNoticeCodes: []string{"csv_unterminated_quote"},
+			expectedContext:     map[string]interface{}{"lineNumber": 2, "column": 4},
+		},
+		{
+			name: "invalid quote and NUL byte",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\nS1,\"Main\" St\nS2,Nul\x00\n",
+			},
+			expectedNoticeCodes: []string{"csv_invalid_quote", "csv_nul_byte"},
+		},
+		{
+			name: "line endings",
+			files: map[string]string{
+				"stops.txt": "stop_id,stop_name\r\nS1,Main\nS2,Second",
+			},
+			expectedNoticeCodes: []string{"csv_missing_trailing_newline", "csv_mixed_line_endings"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := testutil.CreateTestFeedLoader(t, tt.files)
+			container := notice.NewNoticeContainer()
+			NewStrictCSVValidator().Validate(loader, container, gtfsvalidator.Config{})
+
+			notices := container.GetNotices()
+			if len(notices) != len(tt.expectedNoticeCodes) {
+				t.Fatalf("Expected %d notices, got %d", len(tt.expectedNoticeCodes), len(notices))
+			}
+			for i, code := range tt.expectedNoticeCodes {
+				if notices[i].Code() != code {
+					t.Errorf("Expected notice %s, got %s", code, notices[i].Code())
+				}
+			}
+			for key, expected := range tt.expectedContext {
+				if notices[0].Context()[key] != expected {
+					t.Errorf("Expected context %s=%v, got %v", key, expected, notices[0].Context()[key])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package crossfeed contains validators that check several GTFS feeds meant to be merged
+package crossfeed
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+)
+
+// stopData holds the fields of a stop relevant for cross-feed checks
+type stopData struct {
+	Feed      int // index of the feed the stop belongs to
+	ID        string
+	Name      string
+	Lat       float64
+	Lon       float64
+	RowNumber int
+}
+
+// boundingBox is the extent of the stops of a feed
+type boundingBox struct {
+	MinLat, MinLon, MaxLat, MaxLon float64
+}
+
+// overlaps reports whether two boxes share any area
+func (b boundingBox) overlaps(other boundingBox) bool {
+	return b.MinLat <= other.MaxLat && other.MinLat <= b.MaxLat &&
+		b.MinLon <= other.MaxLon && other.MinLon <= b.MaxLon
+}
+
+// readRows calls fn for every row of the given file, ignoring malformed rows
+func readRows(loader *parser.FeedLoader, filename string, fn func(row *parser.CSVRow)) {
+	reader, err := loader.GetFile(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
+		}
+	}()
+
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
+	if err != nil {
+		return
+	}
+
+	for {
+		row, err := csvFile.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		fn(row)
+	}
+}
+
+// loadStops loads the stops and stations with coordinates of a feed, in file order
+func loadStops(loader *parser.FeedLoader, feed int) []*stopData {
+	var stops []*stopData
+	readRows(loader, "stops.txt", func(row *parser.CSVRow) {
+		stopID := strings.TrimSpace(row.Values["stop_id"])
+		locationType := strings.TrimSpace(row.Values["location_type"])
+		if stopID == "" || (locationType != "" && locationType != "0" && locationType != "1") {
+			return
+		}
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(row.Values["stop_lat"]), 64)
+		lon, lonErr := strconv.ParseFloat(strings.TrimSpace(row.Values["stop_lon"]), 64)
+		if latErr != nil || lonErr != nil {
+			return
+		}
+		stops = append(stops, &stopData{
+			Feed:      feed,
+			ID:        stopID,
+			Name:      strings.TrimSpace(row.Values["stop_name"]),
+			Lat:       lat,
+			Lon:       lon,
+			RowNumber: row.RowNumber,
+		})
+	})
+	return stops
+}
+
+// stopsBoundingBox returns the extent of the stops, or false if there are none
+func stopsBoundingBox(stops []*stopData) (boundingBox, bool) {
+	if len(stops) == 0 {
+		return boundingBox{}, false
+	}
+	box := boundingBox{MinLat: stops[0].Lat, MinLon: stops[0].Lon, MaxLat: stops[0].Lat, MaxLon: stops[0].Lon}
+	for _, stop := range stops[1:] {
+		box.MinLat = math.Min(box.MinLat, stop.Lat)
+		box.MinLon = math.Min(box.MinLon, stop.Lon)
+		box.MaxLat = math.Max(box.MaxLat, stop.Lat)
+		box.MaxLon = math.Max(box.MaxLon, stop.Lon)
+	}
+	return box, true
+}
+
+// haversineDistance calculates the distance between two coordinates in meters
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000 // Earth radius in meters
+
+	lat1Rad := lat1 * math.Pi / 180
+	lat2Rad := lat2 * math.Pi / 180
+	deltaLat := (lat2 - lat1) * math.Pi / 180
+	deltaLon := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1Rad)*math.Cos(lat2Rad)*
+			math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadius * c
+}
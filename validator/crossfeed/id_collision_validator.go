@@ -0,0 +1,64 @@
+package crossfeed
+
+import (
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// collisionFields lists the IDs that must be unique across merged feeds
+var collisionFields = []struct {
+	filename  string
+	fieldName string
+}{
+	{"agency.txt", "agency_id"},
+	{"stops.txt", "stop_id"},
+	{"routes.txt", "route_id"},
+	{"fare_attributes.txt", "fare_id"},
+}
+
+// idOccurrence is the first row of a feed using an ID
+type idOccurrence struct {
+	feed      int
+	rowNumber int
+}
+
+// IDCollisionValidator detects agency_id, stop_id, route_id and fare_id values used by more than one feed
+type IDCollisionValidator struct{}
+
+// NewIDCollisionValidator creates a new ID collision validator
+func NewIDCollisionValidator() *IDCollisionValidator {
+	return &IDCollisionValidator{}
+}
+
+// ValidateFeeds reports each ID that a feed shares with an earlier feed
+func (v *IDCollisionValidator) ValidateFeeds(feeds []validator.NamedFeed, container *notice.NoticeContainer, config validator.Config) {
+	for _, field := range collisionFields {
+		first := make(map[string]idOccurrence)
+
+		for feedIndex, feed := range feeds {
+			seen := make(map[string]bool)
+			readRows(feed.Loader, field.filename, func(row *parser.CSVRow) {
+				id := strings.TrimSpace(row.Values[field.fieldName])
+				// Duplicates within a feed are reported by the duplicate key checks
+				if id == "" || seen[id] {
+					return
+				}
+				seen[id] = true
+
+				occurrence, exists := first[id]
+				if !exists {
+					first[id] = idOccurrence{feed: feedIndex, rowNumber: row.RowNumber}
+					return
+				}
+				container.AddNotice(notice.NewCrossFeedIDCollisionNotice(
+					field.filename, field.fieldName, id,
+					feed.Name, row.RowNumber,
+					feeds[occurrence.feed].Name, occurrence.rowNumber,
+				))
+			})
+		}
+	}
+}
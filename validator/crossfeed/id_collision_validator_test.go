@@ -0,0 +1,100 @@
+package crossfeed
+
+import (
+	"testing"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/testutil"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// testFeed is an in-memory feed passed to a cross-feed validator
+type testFeed struct {
+	name  string
+	files map[string]string
+}
+
+// runCrossFeedValidator runs a cross-feed validator against in-memory feeds
+func runCrossFeedValidator(t *testing.T, v gtfsvalidator.CrossFeedValidator, testFeeds []testFeed) []notice.Notice {
+	t.Helper()
+
+	feeds := make([]gtfsvalidator.NamedFeed, len(testFeeds))
+	for i, feed := range testFeeds {
+		feeds[i] = gtfsvalidator.NamedFeed{Name: feed.name, Loader: testutil.CreateTestFeedLoader(t, feed.files)}
+	}
+	container := notice.NewNoticeContainer()
+	v.ValidateFeeds(feeds, container, gtfsvalidator.Config{})
+	return container.GetNotices()
+}
+
+func TestIDCollisionValidator_ValidateFeeds(t *testing.T) {
+	tests := []struct {
+		name            string
+		feeds           []testFeed
+		expectedCount   int
+		expectedContext map[string]interface{}
+	}{
+		{
+			name: "distinct ids",
+			feeds: []testFeed{
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name\nM1,Main\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name\nB1,Main\n"}},
+			},
+		},
+		{
+			name: "colliding stop_id",
+			feeds: []testFeed{
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name\nS1,Main\nS2,Second\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name\nB1,Other\nS2,Elsewhere\n"}},
+			},
+			expectedCount: 1,
+			expectedContext: map[string]interface{}{
+				"filename": "stops.txt", "fieldName": "stop_id", "fieldValue": "S2",
+				"feedName": "bus", "csvRowNumber": 3, "otherFeedName": "metro", "otherCsvRowNumber": 3,
+			},
+		},
+		{
+			name: "agency, route and fare ids across three feeds",
+			feeds: []testFeed{
+				{"metro", map[string]string{
+					"agency.txt":          "agency_id,agency_name\nA1,Metro\n",
+					"routes.txt":          "route_id,route_short_name\nR1,1\n",
+					"fare_attributes.txt": "fare_id,price\nF1,2.00\n",
+				}},
+				{"bus", map[string]string{"routes.txt": "route_id,route_short_name\nR1,1\n"}},
+				{"tram", map[string]string{
+					"agency.txt":          "agency_id,agency_name\nA1,Tram\n",
+					"routes.txt":          "route_id,route_short_name\nR1,T1\n",
+					"fare_attributes.txt": "fare_id,price\nF1,1.50\n",
+				}},
+			},
+			expectedCount: 4,
+		},
+		{
+			name: "duplicates within a feed and empty ids ignored",
+			feeds: []testFeed{
+				{"metro", map[string]string{"agency.txt": "agency_id,agency_name\n,Metro\n", "stops.txt": "stop_id\nS1\nS1\n"}},
+				{"bus", map[string]string{"agency.txt": "agency_id,agency_name\n,Bus\n"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notices := runCrossFeedValidator(t, NewIDCollisionValidator(), tt.feeds)
+			if len(notices) != tt.expectedCount {
+				t.Fatalf("Expected %d notices, got %d", tt.expectedCount, len(notices))
+			}
+			for _, n := range notices {
+				if n.Code() != "cross_feed_id_collision" {
+					t.Errorf("Unexpected notice code: %s", n.Code())
+				}
+			}
+			for key, expected := range tt.expectedContext {
+				if notices[0].Context()[key] != expected {
+					t.Errorf("Expected context %s=%v, got %v", key, expected, notices[0].Context()[key])
+				}
+			}
+		})
+	}
+}
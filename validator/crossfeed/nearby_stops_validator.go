@@ -0,0 +1,80 @@
+package crossfeed
+
+import (
+	"math"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// metersPerDegreeLat is the length of one degree of latitude
+const metersPerDegreeLat = 111320.0
+
+// gridCell indexes stops on a grid of thresholdMeters-sized latitude bands
+type gridCell struct {
+	lat, lon int
+}
+
+// NearbyStopsValidator detects stops of different feeds that are close enough to need a transfer
+type NearbyStopsValidator struct {
+	thresholdMeters float64
+}
+
+// NewNearbyStopsValidator creates a new nearby stops validator
+func NewNearbyStopsValidator(thresholdMeters float64) *NearbyStopsValidator {
+	return &NearbyStopsValidator{thresholdMeters: thresholdMeters}
+}
+
+// ValidateFeeds reports pairs of stops or stations from different feeds within the threshold
+func (v *NearbyStopsValidator) ValidateFeeds(feeds []validator.NamedFeed, container *notice.NoticeContainer, config validator.Config) {
+	if v.thresholdMeters <= 0 || len(feeds) < 2 {
+		return
+	}
+
+	cellSize := v.thresholdMeters / metersPerDegreeLat
+	grid := make(map[gridCell][]*stopData)
+
+	for feedIndex, feed := range feeds {
+		stops := loadStops(feed.Loader, feedIndex)
+
+		// Compare with the stops of the earlier feeds before adding this feed's stops
+		for _, stop := range stops {
+			for _, other := range v.neighbors(grid, stop, cellSize) {
+				distance := haversineDistance(other.Lat, other.Lon, stop.Lat, stop.Lon)
+				if distance > v.thresholdMeters {
+					continue
+				}
+				container.AddNotice(notice.NewCrossFeedNearbyStopsNotice(
+					stop.ID, stop.Name, feed.Name, stop.RowNumber,
+					other.ID, other.Name, feeds[other.Feed].Name, other.RowNumber,
+					distance,
+				))
+			}
+		}
+
+		for _, stop := range stops {
+			cell := gridCell{lat: int(math.Floor(stop.Lat / cellSize)), lon: int(math.Floor(stop.Lon / cellSize))}
+			grid[cell] = append(grid[cell], stop)
+		}
+	}
+}
+
+// neighbors returns the indexed stops in the cells that can be within the threshold of stop
+func (v *NearbyStopsValidator) neighbors(grid map[gridCell][]*stopData, stop *stopData, cellSize float64) []*stopData {
+	latCell := int(math.Floor(stop.Lat / cellSize))
+	lonCell := int(math.Floor(stop.Lon / cellSize))
+
+	// A degree of longitude shrinks with latitude, so more cells are needed east and west
+	lonRange := 1
+	if cos := math.Cos(stop.Lat * math.Pi / 180); cos > 0.01 {
+		lonRange = int(math.Ceil(1 / cos))
+	}
+
+	var result []*stopData
+	for lat := latCell - 1; lat <= latCell+1; lat++ {
+		for lon := lonCell - lonRange; lon <= lonCell+lonRange; lon++ {
+			result = append(result, grid[gridCell{lat: lat, lon: lon}]...)
+		}
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package crossfeed
+
+import (
+	"testing"
+)
+
+func TestNearbyStopsValidator_ValidateFeeds(t *testing.T) {
+	tests := []struct {
+		name          string
+		threshold     float64
+		feeds         []testFeed
+		expectedPairs [][2]string // stop_id pairs (stop, other stop)
+	}{
+		{
+			name:      "stops a few meters apart in different feeds",
+			threshold: 10,
+			feeds: []testFeed{
+				// M1 and B1 are ~5.5m apart, M2 and B2 ~1.1km
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nM1,Central,52.52000,13.40500\nM2,North,52.53000,13.40500\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nB1,Central,52.52005,13.40500\nB2,South,52.51000,13.40500\n"}},
+			},
+			expectedPairs: [][2]string{{"B1", "M1"}},
+		},
+		{
+			name:      "stops close in the same feed are ignored",
+			threshold: 10,
+			feeds: []testFeed{
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nM1,Central,52.52000,13.40500\nM2,Central,52.52001,13.40500\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nB1,Far,48.0,11.0\n"}},
+			},
+		},
+		{
+			name:      "east-west neighbors at high latitude",
+			threshold: 10,
+			feeds: []testFeed{
+				// ~7m apart along the parallel at 70°N
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nM1,Harbour,70.0,25.00000\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nB1,Harbour,70.0,25.00018\n"}},
+			},
+			expectedPairs: [][2]string{{"B1", "M1"}},
+		},
+		{
+			name:      "entrances and missing coordinates ignored",
+			threshold: 10,
+			feeds: []testFeed{
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon,location_type\nE1,Entrance,52.52,13.405,2\nM1,No coords,,,0\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon,location_type\nB1,Central,52.52,13.405,0\n"}},
+			},
+		},
+		{
+			name:      "disabled",
+			threshold: 0,
+			feeds: []testFeed{
+				{"metro", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nM1,Central,52.52,13.405\n"}},
+				{"bus", map[string]string{"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nB1,Central,52.52,13.405\n"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notices := runCrossFeedValidator(t, NewNearbyStopsValidator(tt.threshold), tt.feeds)
+			if len(notices) != len(tt.expectedPairs) {
+				t.Fatalf("Expected %d notices, got %d", len(tt.expectedPairs), len(notices))
+			}
+			for i, pair := range tt.expectedPairs {
+				context := notices[i].Context()
+				if notices[i].Code() != "cross_feed_nearby_stops" || context["stopId"] != pair[0] || context["otherStopId"] != pair[1] {
+					t.Errorf("Expected %s near %s, got %s %v", pair[0], pair[1], notices[i].Code(), context)
+				}
+				if distance, ok := context["distance"].(float64); !ok || distance > tt.threshold {
+					t.Errorf("Expected distance within %vm, got %v", tt.threshold, context["distance"])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package crossfeed
+
+import (
+	"strings"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// feedTimezone is the agency timezone of a feed and the area its stops cover
+type feedTimezone struct {
+	timezone  string
+	rowNumber int
+	box       boundingBox
+	hasBox    bool
+}
+
+// TimezoneConsistencyValidator detects feeds of the same region declaring different agency timezones
+type TimezoneConsistencyValidator struct{}
+
+// NewTimezoneConsistencyValidator creates a new timezone consistency validator
+func NewTimezoneConsistencyValidator() *TimezoneConsistencyValidator {
+	return &TimezoneConsistencyValidator{}
+}
+
+// ValidateFeeds reports pairs of feeds whose stops overlap but whose agency timezones differ.
+// Feeds without stop coordinates are assumed to serve the same region as the others.
+func (v *TimezoneConsistencyValidator) ValidateFeeds(feeds []validator.NamedFeed, container *notice.NoticeContainer, config validator.Config) {
+	timezones := make([]feedTimezone, len(feeds))
+	for i, feed := range feeds {
+		readRows(feed.Loader, "agency.txt", func(row *parser.CSVRow) {
+			timezone := strings.TrimSpace(row.Values["agency_timezone"])
+			if timezones[i].timezone == "" && timezone != "" {
+				timezones[i].timezone = timezone
+				timezones[i].rowNumber = row.RowNumber
+			}
+		})
+		timezones[i].box, timezones[i].hasBox = stopsBoundingBox(loadStops(feed.Loader, i))
+	}
+
+	for i := range feeds {
+		for j := 0; j < i; j++ {
+			current, other := timezones[i], timezones[j]
+			if current.timezone == "" || other.timezone == "" || current.timezone == other.timezone {
+				continue
+			}
+			if current.hasBox && other.hasBox && !current.box.overlaps(other.box) {
+				continue
+			}
+			container.AddNotice(notice.NewCrossFeedInconsistentTimezoneNotice(
+				feeds[i].Name, current.timezone, current.rowNumber,
+				feeds[j].Name, other.timezone, other.rowNumber,
+			))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package crossfeed
+
+import (
+	"testing"
+)
+
+func TestTimezoneConsistencyValidator_ValidateFeeds(t *testing.T) {
+	berlinStops := "stop_id,stop_name,stop_lat,stop_lon\nS1,Alexanderplatz,52.52,13.41\nS2,Zoo,52.51,13.33\n"
+	tokyoStops := "stop_id,stop_name,stop_lat,stop_lon\nS1,Shinjuku,35.69,139.70\n"
+
+	tests := []struct {
+		name            string
+		feeds           []testFeed
+		expectedCount   int
+		expectedContext map[string]interface{}
+	}{
+		{
+			name: "same timezone",
+			feeds: []testFeed{
+				{"metro", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Europe/Berlin\n", "stops.txt": berlinStops}},
+				{"bus", map[string]string{"agency.txt": "agency_id,agency_timezone\nB1,Europe/Berlin\n", "stops.txt": berlinStops}},
+			},
+		},
+		{
+			name: "different timezone in the same region",
+			feeds: []testFeed{
+				{"metro", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Europe/Berlin\n", "stops.txt": berlinStops}},
+				{"bus", map[string]string{"agency.txt": "agency_id,agency_timezone\nB1,\nB2,Europe/London\n", "stops.txt": berlinStops}},
+			},
+			expectedCount: 1,
+			expectedContext: map[string]interface{}{
+				"feedName": "bus", "agencyTimezone": "Europe/London", "csvRowNumber": 3,
+				"otherFeedName": "metro", "otherTimezone": "Europe/Berlin", "otherCsvRowNumber": 2,
+			},
+		},
+		{
+			name: "different timezone in distant regions",
+			feeds: []testFeed{
+				{"berlin", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Europe/Berlin\n", "stops.txt": berlinStops}},
+				{"tokyo", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Asia/Tokyo\n", "stops.txt": tokyoStops}},
+			},
+		},
+		{
+			name: "feed without stops compared with all",
+			feeds: []testFeed{
+				{"berlin", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Europe/Berlin\n", "stops.txt": berlinStops}},
+				{"tokyo", map[string]string{"agency.txt": "agency_id,agency_timezone\nA1,Asia/Tokyo\n", "stops.txt": tokyoStops}},
+				{"fares", map[string]string{"agency.txt": "agency_id,agency_timezone\nF1,Europe/Paris\n"}},
+			},
+			expectedCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notices := runCrossFeedValidator(t, NewTimezoneConsistencyValidator(), tt.feeds)
+			if len(notices) != tt.expectedCount {
+				t.Fatalf("Expected %d notices, got %d", tt.expectedCount, len(notices))
+			}
+			for _, n := range notices {
+				if n.Code() != "cross_feed_inconsistent_timezone" {
+					t.Errorf("Unexpected notice code: %s", n.Code())
+				}
+			}
+			for key, expected := range tt.expectedContext {
+				if notices[0].Context()[key] != expected {
+					t.Errorf("Expected context %s=%v, got %v", key, expected, notices[0].Context()[key])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package diff
+
+import (
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// renamedStopMaxDistance is the maximum distance in meters between a removed and an added
+// stop with the same name for the change to be reported as a rename
+const renamedStopMaxDistance = 50.0
+
+// EntityIDDiffValidator detects stop_ids and route_ids that were removed or renamed
+type EntityIDDiffValidator struct{}
+
+// NewEntityIDDiffValidator creates a new entity ID diff validator
+func NewEntityIDDiffValidator() *EntityIDDiffValidator {
+	return &EntityIDDiffValidator{}
+}
+
+// ValidateDiff reports stops and routes of the previous feed that are missing from the current feed
+func (v *EntityIDDiffValidator) ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	v.diffStops(loadStops(previous), loadStops(current), container)
+	v.diffRoutes(loadRoutes(previous), loadRoutes(current), container)
+}
+
+// diffStops reports removed and renamed stop_ids
+func (v *EntityIDDiffValidator) diffStops(previous, current map[string]*stopData, container *notice.NoticeContainer) {
+	var added []*stopData
+	for _, stopID := range sortedKeys(current) {
+		if _, existed := previous[stopID]; !existed {
+			added = append(added, current[stopID])
+		}
+	}
+	matched := make(map[string]bool)
+
+	for _, stopID := range sortedKeys(previous) {
+		if _, exists := current[stopID]; exists {
+			continue
+		}
+		removed := previous[stopID]
+
+		if replacement := v.findRenamedStop(removed, added, matched); replacement != nil {
+			matched[replacement.ID] = true
+			container.AddNotice(notice.NewStopIDRenamedNotice(removed.ID, replacement.ID, replacement.Name, replacement.RowNumber))
+			continue
+		}
+		container.AddNotice(notice.NewStopRemovedNotice(removed.ID, removed.Name))
+	}
+}
+
+// findRenamedStop returns an added stop with the same name at (almost) the same location
+func (v *EntityIDDiffValidator) findRenamedStop(removed *stopData, added []*stopData, matched map[string]bool) *stopData {
+	if removed.Name == "" || !removed.HasCoords {
+		return nil
+	}
+	for _, candidate := range added {
+		if matched[candidate.ID] || candidate.Name != removed.Name || !candidate.HasCoords {
+			continue
+		}
+		if haversineDistance(removed.Lat, removed.Lon, candidate.Lat, candidate.Lon) <= renamedStopMaxDistance {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// diffRoutes reports removed and renamed route_ids
+func (v *EntityIDDiffValidator) diffRoutes(previous, current map[string]*routeData, container *notice.NoticeContainer) {
+	added := make(map[string]*routeData)
+	for _, routeID := range sortedKeys(current) {
+		if _, existed := previous[routeID]; !existed {
+			route := current[routeID]
+			key := v.routeIdentity(route)
+			if _, taken := added[key]; !taken {
+				added[key] = route
+			}
+		}
+	}
+
+	for _, routeID := range sortedKeys(previous) {
+		if _, exists := current[routeID]; exists {
+			continue
+		}
+		removed := previous[routeID]
+
+		key := v.routeIdentity(removed)
+		if replacement, found := added[key]; found && (removed.ShortName != "" || removed.LongName != "") {
+			delete(added, key)
+			container.AddNotice(notice.NewRouteIDRenamedNotice(removed.ID, replacement.ID, replacement.ShortName, replacement.RowNumber))
+			continue
+		}
+		container.AddNotice(notice.NewRouteRemovedNotice(removed.ID, removed.ShortName, removed.LongName))
+	}
+}
+
+// routeIdentity builds a key describing a route independently of its route_id
+func (v *EntityIDDiffValidator) routeIdentity(route *routeData) string {
+	return route.AgencyID + "\x00" + route.ShortName + "\x00" + route.LongName + "\x00" + route.RouteType
+}
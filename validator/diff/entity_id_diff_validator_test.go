@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/testutil"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// runDiffValidator runs a diff validator against two in-memory feed versions
+func runDiffValidator(t *testing.T, v gtfsvalidator.DiffValidator, previousFiles, currentFiles map[string]string) []notice.Notice {
+	t.Helper()
+
+	previous := testutil.CreateTestFeedLoader(t, previousFiles)
+	current := testutil.CreateTestFeedLoader(t, currentFiles)
+	container := notice.NewNoticeContainer()
+	config := gtfsvalidator.Config{
+		CurrentDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	v.ValidateDiff(previous, current, container, config)
+	return container.GetNotices()
+}
+
+// assertNoticeCodes verifies that exactly the expected notice codes were produced
+func assertNoticeCodes(t *testing.T, notices []notice.Notice, expectedCodes []string) {
+	t.Helper()
+
+	expectedCodeCounts := make(map[string]int)
+	for _, code := range expectedCodes {
+		expectedCodeCounts[code]++
+	}
+	actualCodeCounts := make(map[string]int)
+	for _, n := range notices {
+		actualCodeCounts[n.Code()]++
+	}
+
+	for code, expected := range expectedCodeCounts {
+		if actualCodeCounts[code] != expected {
+			t.Errorf("Expected %d notices with code '%s', got %d", expected, code, actualCodeCounts[code])
+		}
+	}
+	for code := range actualCodeCounts {
+		if expectedCodeCounts[code] == 0 {
+			t.Errorf("Unexpected notice code: %s", code)
+		}
+	}
+}
+
+func TestEntityIDDiffValidator_ValidateDiff(t *testing.T) {
+	tests := []struct {
+		name                string
+		previous            map[string]string
+		current             map[string]string
+		expectedNoticeCodes []string
+	}{
+		{
+			name: "unchanged ids",
+			previous: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nR1,1,Main Line,3",
+			},
+			current: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0\nS2,New Stop,42.1,-71.1",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nR1,1,Main Line,3",
+			},
+			expectedNoticeCodes: []string{},
+		},
+		{
+			name: "removed stop and route",
+			previous: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0\nS2,Elm St,42.1,-71.1",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nR1,1,Main Line,3\nR2,2,Elm Line,3",
+			},
+			current: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nR1,1,Main Line,3",
+			},
+			expectedNoticeCodes: []string{"stop_removed", "route_removed"},
+		},
+		{
+			name: "renamed stop and route",
+			previous: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nR1,1,Main Line,3",
+			},
+			current: map[string]string{
+				"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nSTOP-1,Main St,42.0001,-71.0",
+				"routes.txt": "route_id,route_short_name,route_long_name,route_type\nROUTE-1,1,Main Line,3",
+			},
+			expectedNoticeCodes: []string{"stop_id_renamed", "route_id_renamed"},
+		},
+		{
+			name: "same name far away is not a rename",
+			previous: map[string]string{
+				"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0",
+			},
+			current: map[string]string{
+				"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nS9,Main St,43.0,-71.0",
+			},
+			expectedNoticeCodes: []string{"stop_removed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notices := runDiffValidator(t, NewEntityIDDiffValidator(), tt.previous, tt.current)
+			assertNoticeCodes(t, notices, tt.expectedNoticeCodes)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+// Package diff contains validators that compare two versions of a GTFS feed
+package diff
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+const dateLayout = "20060102"
+
+// stopData holds the fields of a stop relevant for diffing
+type stopData struct {
+	ID        string
+	Name      string
+	Lat       float64
+	Lon       float64
+	HasCoords bool
+	RowNumber int
+}
+
+// routeData holds the fields of a route relevant for diffing
+type routeData struct {
+	ID        string
+	AgencyID  string
+	ShortName string
+	LongName  string
+	RouteType string
+	RowNumber int
+}
+
+// shapePoint is a single point of a shape
+type shapePoint struct {
+	Lat      float64
+	Lon      float64
+	Sequence int
+}
+
+// readRows calls fn for every row of the given file, ignoring malformed rows
+func readRows(loader *parser.FeedLoader, filename string, fn func(row *parser.CSVRow)) {
+	reader, err := loader.GetFile(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
+		}
+	}()
+
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
+	if err != nil {
+		return
+	}
+
+	for {
+		row, err := csvFile.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		fn(row)
+	}
+}
+
+// loadStops loads stops keyed by stop_id
+func loadStops(loader *parser.FeedLoader) map[string]*stopData {
+	stops := make(map[string]*stopData)
+	readRows(loader, "stops.txt", func(row *parser.CSVRow) {
+		stopID := strings.TrimSpace(row.Values["stop_id"])
+		if stopID == "" {
+			return
+		}
+		stop := &stopData{
+			ID:        stopID,
+			Name:      strings.TrimSpace(row.Values["stop_name"]),
+			RowNumber: row.RowNumber,
+		}
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(row.Values["stop_lat"]), 64)
+		lon, lonErr := strconv.ParseFloat(strings.TrimSpace(row.Values["stop_lon"]), 64)
+		if latErr == nil && lonErr == nil {
+			stop.Lat, stop.Lon, stop.HasCoords = lat, lon, true
+		}
+		stops[stopID] = stop
+	})
+	return stops
+}
+
+// loadRoutes loads routes keyed by route_id
+func loadRoutes(loader *parser.FeedLoader) map[string]*routeData {
+	routes := make(map[string]*routeData)
+	readRows(loader, "routes.txt", func(row *parser.CSVRow) {
+		routeID := strings.TrimSpace(row.Values["route_id"])
+		if routeID == "" {
+			return
+		}
+		routes[routeID] = &routeData{
+			ID:        routeID,
+			AgencyID:  strings.TrimSpace(row.Values["agency_id"]),
+			ShortName: strings.TrimSpace(row.Values["route_short_name"]),
+			LongName:  strings.TrimSpace(row.Values["route_long_name"]),
+			RouteType: strings.TrimSpace(row.Values["route_type"]),
+			RowNumber: row.RowNumber,
+		}
+	})
+	return routes
+}
+
+// loadShapes loads shape points keyed by shape_id, ordered by shape_pt_sequence
+func loadShapes(loader *parser.FeedLoader) map[string][]shapePoint {
+	shapes := make(map[string][]shapePoint)
+	readRows(loader, "shapes.txt", func(row *parser.CSVRow) {
+		shapeID := strings.TrimSpace(row.Values["shape_id"])
+		if shapeID == "" {
+			return
+		}
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(row.Values["shape_pt_lat"]), 64)
+		lon, lonErr := strconv.ParseFloat(strings.TrimSpace(row.Values["shape_pt_lon"]), 64)
+		sequence, seqErr := strconv.Atoi(strings.TrimSpace(row.Values["shape_pt_sequence"]))
+		if latErr != nil || lonErr != nil || seqErr != nil {
+			return
+		}
+		shapes[shapeID] = append(shapes[shapeID], shapePoint{Lat: lat, Lon: lon, Sequence: sequence})
+	})
+	for _, points := range shapes {
+		sort.Slice(points, func(i, j int) bool { return points[i].Sequence < points[j].Sequence })
+	}
+	return shapes
+}
+
+// loadServiceDates expands calendar.txt and calendar_dates.txt into the set of active dates per service
+func loadServiceDates(loader *parser.FeedLoader) map[string]map[string]bool {
+	serviceDates := make(map[string]map[string]bool)
+	weekdays := []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+	readRows(loader, "calendar.txt", func(row *parser.CSVRow) {
+		serviceID := strings.TrimSpace(row.Values["service_id"])
+		start, startErr := time.Parse(dateLayout, strings.TrimSpace(row.Values["start_date"]))
+		end, endErr := time.Parse(dateLayout, strings.TrimSpace(row.Values["end_date"]))
+		if serviceID == "" || startErr != nil || endErr != nil || end.Before(start) {
+			return
+		}
+		// Guard against absurd ranges that would explode memory usage
+		if end.Sub(start) > 5*366*24*time.Hour {
+			end = start.AddDate(5, 0, 0)
+		}
+
+		dates := serviceDates[serviceID]
+		if dates == nil {
+			dates = make(map[string]bool)
+			serviceDates[serviceID] = dates
+		}
+		for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+			if strings.TrimSpace(row.Values[weekdays[day.Weekday()]]) == "1" {
+				dates[day.Format(dateLayout)] = true
+			}
+		}
+	})
+
+	readRows(loader, "calendar_dates.txt", func(row *parser.CSVRow) {
+		serviceID := strings.TrimSpace(row.Values["service_id"])
+		date := strings.TrimSpace(row.Values["date"])
+		if serviceID == "" {
+			return
+		}
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return
+		}
+
+		dates := serviceDates[serviceID]
+		if dates == nil {
+			dates = make(map[string]bool)
+			serviceDates[serviceID] = dates
+		}
+		switch strings.TrimSpace(row.Values["exception_type"]) {
+		case "1":
+			dates[date] = true
+		case "2":
+			delete(dates, date)
+		}
+	})
+
+	return serviceDates
+}
+
+// TripCounts memoizes the trips per route and date of each feed, so that the
+// validators of one diff run read trips and calendars only once per feed
+type TripCounts struct {
+	mu     sync.Mutex
+	counts map[*parser.FeedLoader]map[string]map[string]int
+}
+
+// NewTripCounts creates the trip counts shared by the validators of one diff run
+func NewTripCounts() *TripCounts {
+	return &TripCounts{counts: make(map[*parser.FeedLoader]map[string]map[string]int)}
+}
+
+// load returns the trips per route and date of a feed, counting them on first
+// use. A nil TripCounts counts them on every call.
+func (t *TripCounts) load(loader *parser.FeedLoader) map[string]map[string]int {
+	if t == nil {
+		return loadTripsPerRouteAndDate(loader)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counts, ok := t.counts[loader]
+	if !ok {
+		counts = loadTripsPerRouteAndDate(loader)
+		t.counts[loader] = counts
+	}
+	return counts
+}
+
+// loadTripsPerRouteAndDate counts the trips operated by each route on each service date
+func loadTripsPerRouteAndDate(loader *parser.FeedLoader) map[string]map[string]int {
+	tripsPerRouteService := make(map[string]map[string]int)
+	readRows(loader, "trips.txt", func(row *parser.CSVRow) {
+		routeID := strings.TrimSpace(row.Values["route_id"])
+		serviceID := strings.TrimSpace(row.Values["service_id"])
+		if routeID == "" || serviceID == "" {
+			return
+		}
+		if tripsPerRouteService[serviceID] == nil {
+			tripsPerRouteService[serviceID] = make(map[string]int)
+		}
+		tripsPerRouteService[serviceID][routeID]++
+	})
+
+	result := make(map[string]map[string]int)
+	for serviceID, dates := range loadServiceDates(loader) {
+		routeCounts, used := tripsPerRouteService[serviceID]
+		if !used {
+			continue
+		}
+		for date := range dates {
+			if result[date] == nil {
+				result[date] = make(map[string]int)
+			}
+			for routeID, count := range routeCounts {
+				result[date][routeID] += count
+			}
+		}
+	}
+	return result
+}
+
+// currentDateString returns the configured current date formatted as YYYYMMDD
+func currentDateString(config validator.Config) string {
+	if cd, ok := config.CurrentDate.(time.Time); ok && !cd.IsZero() {
+		return cd.Format(dateLayout)
+	}
+	return time.Now().Format(dateLayout)
+}
+
+// sortedKeys returns the keys of a set in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// haversineDistance calculates the distance between two coordinates in meters
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000 // Earth radius in meters
+
+	lat1Rad := lat1 * math.Pi / 180
+	lat2Rad := lat2 * math.Pi / 180
+	deltaLat := (lat2 - lat1) * math.Pi / 180
+	deltaLon := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1Rad)*math.Cos(lat2Rad)*
+			math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadius * c
+}
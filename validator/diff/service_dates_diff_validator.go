@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// ServiceDatesDiffValidator detects upcoming dates that had service in the previous feed but have none anymore
+type ServiceDatesDiffValidator struct {
+	tripCounts *TripCounts
+}
+
+// NewServiceDatesDiffValidator creates a new service dates diff validator
+// reading the trips per route and date from tripCounts
+func NewServiceDatesDiffValidator(tripCounts *TripCounts) *ServiceDatesDiffValidator {
+	return &ServiceDatesDiffValidator{tripCounts: tripCounts}
+}
+
+// ValidateDiff reports ranges of consecutive dates whose service disappeared
+func (v *ServiceDatesDiffValidator) ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	previousCounts := v.tripCounts.load(previous)
+	currentCounts := v.tripCounts.load(current)
+	today := currentDateString(config)
+
+	var rangeStart, rangeEnd string
+	var rangeDays, rangeTrips int
+	flush := func() {
+		if rangeDays > 0 {
+			container.AddNotice(notice.NewServiceDatesRemovedNotice(rangeStart, rangeEnd, rangeDays, rangeTrips))
+		}
+		rangeStart, rangeEnd, rangeDays, rangeTrips = "", "", 0, 0
+	}
+
+	for _, date := range sortedKeys(previousCounts) {
+		if date < today {
+			continue
+		}
+		if _, stillServed := currentCounts[date]; stillServed {
+			flush()
+			continue
+		}
+
+		trips := 0
+		for _, count := range previousCounts[date] {
+			trips += count
+		}
+		if trips == 0 {
+			continue
+		}
+
+		if rangeDays > 0 && !isNextDay(rangeEnd, date) {
+			flush()
+		}
+		if rangeDays == 0 {
+			rangeStart = date
+		}
+		rangeEnd = date
+		rangeDays++
+		rangeTrips += trips
+	}
+	flush()
+}
+
+// isNextDay returns true if next is the calendar day following date
+func isNextDay(date, next string) bool {
+	parsed, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return false
+	}
+	return parsed.AddDate(0, 0, 1).Format(dateLayout) == next
+}
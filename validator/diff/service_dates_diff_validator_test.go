@@ -0,0 +1,36 @@
+package diff
+
+import (
+	"testing"
+)
+
+func TestServiceDatesDiffValidator_ValidateDiff(t *testing.T) {
+	trips := "route_id,service_id,trip_id\nR1,S,T1"
+	previous := map[string]string{
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"S,1,1,1,1,1,1,1,20241201,20250110",
+		"trips.txt": trips,
+	}
+	current := map[string]string{
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"S,1,1,1,1,1,1,1,20241201,20250110",
+		// Service removed on Jan 3-4 and Jan 8
+		"calendar_dates.txt": "service_id,date,exception_type\nS,20250103,2\nS,20250104,2\nS,20250108,2\nS,20241215,2",
+		"trips.txt":          trips,
+	}
+
+	notices := runDiffValidator(t, NewServiceDatesDiffValidator(NewTripCounts()), previous, current)
+	// Past date (20241215) is ignored, two ranges are reported
+	assertNoticeCodes(t, notices, []string{"service_dates_removed", "service_dates_removed"})
+
+	if len(notices) == 2 {
+		first := notices[0].Context()
+		if first["startDate"] != "20250103" || first["endDate"] != "20250104" || first["dayCount"] != 2 {
+			t.Errorf("Unexpected first range: %v", first)
+		}
+		second := notices[1].Context()
+		if second["startDate"] != "20250108" || second["dayCount"] != 1 {
+			t.Errorf("Unexpected second range: %v", second)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package diff
+
+import (
+	"math"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// maxComparedShapePoints caps the number of points per shape used for deviation checks
+const maxComparedShapePoints = 200
+
+// ShapeDiffValidator detects shapes whose geometry changed by more than a threshold
+type ShapeDiffValidator struct {
+	thresholdMeters float64
+}
+
+// NewShapeDiffValidator creates a new shape diff validator
+func NewShapeDiffValidator(thresholdMeters float64) *ShapeDiffValidator {
+	return &ShapeDiffValidator{thresholdMeters: thresholdMeters}
+}
+
+// ValidateDiff reports shapes present in both feed versions that deviate drastically
+func (v *ShapeDiffValidator) ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	if !previous.HasFile("shapes.txt") || !current.HasFile("shapes.txt") {
+		return
+	}
+	previousShapes := loadShapes(previous)
+	currentShapes := loadShapes(current)
+
+	for _, shapeID := range sortedKeys(currentShapes) {
+		prev, existed := previousShapes[shapeID]
+		cur := currentShapes[shapeID]
+		if !existed || len(prev) == 0 || len(cur) == 0 {
+			continue
+		}
+
+		// Symmetric deviation catches both added detours and removed sections
+		deviation := math.Max(v.maxDeviation(cur, prev), v.maxDeviation(prev, cur))
+		if deviation > v.thresholdMeters {
+			container.AddNotice(notice.NewShapeChangedSignificantlyNotice(
+				shapeID,
+				math.Round(deviation),
+				math.Round(v.shapeLength(prev)),
+				math.Round(v.shapeLength(cur)),
+			))
+		}
+	}
+}
+
+// maxDeviation returns the largest distance from a point of from to the polyline described by to
+func (v *ShapeDiffValidator) maxDeviation(from, to []shapePoint) float64 {
+	from = v.downsample(from)
+	to = v.downsample(to)
+
+	maxDistance := 0.0
+	for _, point := range from {
+		nearest := haversineDistance(point.Lat, point.Lon, to[0].Lat, to[0].Lon)
+		for i := 1; i < len(to); i++ {
+			if distance := v.distanceToSegment(point, to[i-1], to[i]); distance < nearest {
+				nearest = distance
+			}
+		}
+		if nearest > maxDistance {
+			maxDistance = nearest
+		}
+	}
+	return maxDistance
+}
+
+// distanceToSegment returns the distance in meters from p to the segment a-b using a local
+// equirectangular projection, which is accurate enough for segments of a few kilometers
+func (v *ShapeDiffValidator) distanceToSegment(p, a, b shapePoint) float64 {
+	const metersPerDegree = 111320.0
+	cosLat := math.Cos(p.Lat * math.Pi / 180)
+
+	ax, ay := (a.Lon-p.Lon)*metersPerDegree*cosLat, (a.Lat-p.Lat)*metersPerDegree
+	bx, by := (b.Lon-p.Lon)*metersPerDegree*cosLat, (b.Lat-p.Lat)*metersPerDegree
+	dx, dy := bx-ax, by-ay
+
+	t := 0.0
+	if lengthSquared := dx*dx + dy*dy; lengthSquared > 0 {
+		t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/lengthSquared))
+	}
+	return math.Hypot(ax+t*dx, ay+t*dy)
+}
+
+// downsample keeps at most maxComparedShapePoints evenly spaced points, always including the last one
+func (v *ShapeDiffValidator) downsample(points []shapePoint) []shapePoint {
+	if len(points) <= maxComparedShapePoints {
+		return points
+	}
+	step := float64(len(points)-1) / float64(maxComparedShapePoints-1)
+	result := make([]shapePoint, 0, maxComparedShapePoints)
+	for i := 0; i < maxComparedShapePoints; i++ {
+		result = append(result, points[int(math.Round(float64(i)*step))])
+	}
+	return result
+}
+
+// shapeLength returns the length of a shape in meters
+func (v *ShapeDiffValidator) shapeLength(points []shapePoint) float64 {
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		length += haversineDistance(points[i-1].Lat, points[i-1].Lon, points[i].Lat, points[i].Lon)
+	}
+	return length
+}
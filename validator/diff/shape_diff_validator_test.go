@@ -0,0 +1,27 @@
+package diff
+
+import (
+	"testing"
+)
+
+func TestShapeDiffValidator_ValidateDiff(t *testing.T) {
+	previous := map[string]string{
+		"shapes.txt": "shape_id,shape_pt_lat,shape_pt_lon,shape_pt_sequence\n" +
+			"A,42.0,-71.0,1\nA,42.0,-71.1,2\n" +
+			"B,42.0,-71.0,1\nB,42.0,-71.1,2",
+	}
+	current := map[string]string{
+		"shapes.txt": "shape_id,shape_pt_lat,shape_pt_lon,shape_pt_sequence\n" +
+			// A is densified along the same line, which is not a significant change
+			"A,42.0,-71.0,1\nA,42.0,-71.05,2\nA,42.0,-71.1,3\n" +
+			// B takes a ~5km detour
+			"B,42.0,-71.0,1\nB,42.05,-71.05,2\nB,42.0,-71.1,3",
+	}
+
+	notices := runDiffValidator(t, NewShapeDiffValidator(200), previous, current)
+	assertNoticeCodes(t, notices, []string{"shape_changed_significantly"})
+
+	if len(notices) == 1 && notices[0].Context()["shapeId"] != "B" {
+		t.Errorf("Expected shape B to be reported, got %v", notices[0].Context()["shapeId"])
+	}
+}
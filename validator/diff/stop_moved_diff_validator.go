@@ -0,0 +1,41 @@
+package diff
+
+import (
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// StopMovedDiffValidator detects stops whose location changed by more than a threshold
+type StopMovedDiffValidator struct {
+	thresholdMeters float64
+}
+
+// NewStopMovedDiffValidator creates a new stop moved diff validator
+func NewStopMovedDiffValidator(thresholdMeters float64) *StopMovedDiffValidator {
+	return &StopMovedDiffValidator{thresholdMeters: thresholdMeters}
+}
+
+// ValidateDiff reports stops that moved further than the threshold
+func (v *StopMovedDiffValidator) ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	previousStops := loadStops(previous)
+	currentStops := loadStops(current)
+
+	for _, stopID := range sortedKeys(currentStops) {
+		cur := currentStops[stopID]
+		prev, existed := previousStops[stopID]
+		if !existed || !prev.HasCoords || !cur.HasCoords {
+			continue
+		}
+
+		distance := haversineDistance(prev.Lat, prev.Lon, cur.Lat, cur.Lon)
+		if distance > v.thresholdMeters {
+			container.AddNotice(notice.NewStopMovedNotice(
+				stopID, cur.Name,
+				prev.Lat, prev.Lon,
+				cur.Lat, cur.Lon,
+				distance, cur.RowNumber,
+			))
+		}
+	}
+}
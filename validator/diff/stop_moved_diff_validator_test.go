@@ -0,0 +1,28 @@
+package diff
+
+import (
+	"testing"
+)
+
+func TestStopMovedDiffValidator_ValidateDiff(t *testing.T) {
+	previous := map[string]string{
+		"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0,-71.0\nS2,Elm St,42.1,-71.1\nS3,Oak St,42.2,-71.2",
+	}
+	current := map[string]string{
+		// S1 moved ~11m, S2 moved ~1.1km, S3 lost its coordinates
+		"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\nS1,Main St,42.0001,-71.0\nS2,Elm St,42.11,-71.1\nS3,Oak St,,",
+	}
+
+	notices := runDiffValidator(t, NewStopMovedDiffValidator(100), previous, current)
+	assertNoticeCodes(t, notices, []string{"stop_moved"})
+
+	if len(notices) == 1 {
+		context := notices[0].Context()
+		if context["stopId"] != "S2" {
+			t.Errorf("Expected stop S2 to be reported, got %v", context["stopId"])
+		}
+		if distance, ok := context["distance"].(float64); !ok || distance < 1000 || distance > 1200 {
+			t.Errorf("Expected distance around 1.1km, got %v", context["distance"])
+		}
+	}
+}
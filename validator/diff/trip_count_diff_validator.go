@@ -0,0 +1,135 @@
+package diff
+
+import (
+	"math"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// TripCountDiffValidator detects routes whose daily trip count changed by more than a threshold
+type TripCountDiffValidator struct {
+	thresholdPercent float64
+	tripCounts       *TripCounts
+}
+
+// NewTripCountDiffValidator creates a new trip count diff validator reading the
+// trips per route and date from tripCounts
+func NewTripCountDiffValidator(thresholdPercent float64, tripCounts *TripCounts) *TripCountDiffValidator {
+	return &TripCountDiffValidator{thresholdPercent: thresholdPercent, tripCounts: tripCounts}
+}
+
+// routeTripChange aggregates the dates on which a route's trip count changed
+type routeTripChange struct {
+	firstDate     string
+	dateCount     int
+	previousCount int
+	currentCount  int
+	changePercent float64
+}
+
+// ValidateDiff compares trips per route per date on the dates covered by both feed versions
+func (v *TripCountDiffValidator) ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config validator.Config) {
+	previousCounts := v.tripCounts.load(previous)
+	currentCounts := v.tripCounts.load(current)
+	if len(previousCounts) == 0 || len(currentCounts) == 0 {
+		return
+	}
+
+	// Only compare dates covered by both feed versions, starting today
+	previousDates := sortedKeys(previousCounts)
+	currentDates := sortedKeys(currentCounts)
+	from := maxString(previousDates[0], currentDates[0], currentDateString(config))
+	to := minString(previousDates[len(previousDates)-1], currentDates[len(currentDates)-1])
+
+	currentRoutes := loadRoutes(current)
+	changes := make(map[string]*routeTripChange)
+
+	for _, date := range previousDates {
+		if date < from || date > to {
+			continue
+		}
+		currentRouteCounts, hasService := currentCounts[date]
+		if !hasService {
+			// Dates without any service are reported by ServiceDatesDiffValidator
+			continue
+		}
+
+		// Routes without previous trips on this date only appear in the current counts
+		routeIDs := make(map[string]bool)
+		for routeID := range previousCounts[date] {
+			routeIDs[routeID] = true
+		}
+		for routeID := range currentRouteCounts {
+			routeIDs[routeID] = true
+		}
+
+		for routeID := range routeIDs {
+			if _, exists := currentRoutes[routeID]; !exists {
+				// Removed routes are reported by EntityIDDiffValidator
+				continue
+			}
+			previousCount := previousCounts[date][routeID]
+			currentCount := currentRouteCounts[routeID]
+			if previousCount == currentCount {
+				continue
+			}
+			// Trips on a date the route previously had none count as a 100% increase
+			changePercent := 100.0
+			if previousCount > 0 {
+				changePercent = float64(currentCount-previousCount) / float64(previousCount) * 100
+			}
+			if math.Abs(changePercent) <= v.thresholdPercent {
+				continue
+			}
+
+			change, exists := changes[routeID]
+			if !exists {
+				changes[routeID] = &routeTripChange{
+					firstDate:     date,
+					dateCount:     1,
+					previousCount: previousCount,
+					currentCount:  currentCount,
+					changePercent: changePercent,
+				}
+				continue
+			}
+			change.dateCount++
+		}
+	}
+
+	for _, routeID := range sortedKeys(changes) {
+		change := changes[routeID]
+		container.AddNotice(notice.NewTripCountChangedNotice(
+			routeID,
+			change.firstDate,
+			change.dateCount,
+			change.previousCount,
+			change.currentCount,
+			math.Round(change.changePercent*10)/10,
+		))
+	}
+}
+
+// maxString returns the lexicographically largest string
+func maxString(values ...string) string {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value > result {
+			result = value
+		}
+	}
+	return result
+}
+
+// minString returns the lexicographically smallest string
+func minString(values ...string) string {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package diff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/testutil"
+	gtfsvalidator "github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+func TestTripCountDiffValidator_ValidateDiff(t *testing.T) {
+	calendar := "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+		"WK,1,1,1,1,1,0,0,20250101,20250131"
+	routes := "route_id,route_short_name,route_type\nR1,1,3\nR2,2,3"
+
+	tests := []struct {
+		name                string
+		currentRoutes       string
+		previousTrips       string
+		currentTrips        string
+		expectedNoticeCodes []string
+	}{
+		{
+			name:                "same trip counts",
+			previousTrips:       "route_id,service_id,trip_id\nR1,WK,T1\nR1,WK,T2\nR2,WK,T3",
+			currentTrips:        "route_id,service_id,trip_id\nR1,WK,T1\nR1,WK,T2\nR2,WK,T3",
+			expectedNoticeCodes: []string{},
+		},
+		{
+			name:                "trip count halved on one route",
+			previousTrips:       "route_id,service_id,trip_id\nR1,WK,T1\nR1,WK,T2\nR2,WK,T3",
+			currentTrips:        "route_id,service_id,trip_id\nR1,WK,T1\nR2,WK,T3",
+			expectedNoticeCodes: []string{"trip_count_changed"},
+		},
+		{
+			name:                "all trips of a route removed",
+			previousTrips:       "route_id,service_id,trip_id\nR1,WK,T1\nR2,WK,T3",
+			currentTrips:        "route_id,service_id,trip_id\nR1,WK,T1",
+			expectedNoticeCodes: []string{"trip_count_changed"},
+		},
+		{
+			name:                "trips added to a route without previous trips",
+			previousTrips:       "route_id,service_id,trip_id\nR1,WK,T1",
+			currentTrips:        "route_id,service_id,trip_id\nR1,WK,T1\nR2,WK,T3",
+			expectedNoticeCodes: []string{"trip_count_changed"},
+		},
+		{
+			name:                "route only in the new feed",
+			currentRoutes:       routes + "\nR3,3,3",
+			previousTrips:       "route_id,service_id,trip_id\nR1,WK,T1\nR2,WK,T3",
+			currentTrips:        "route_id,service_id,trip_id\nR1,WK,T1\nR2,WK,T3\nR3,WK,T4\nR3,WK,T5",
+			expectedNoticeCodes: []string{"trip_count_changed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := map[string]string{"calendar.txt": calendar, "routes.txt": routes, "trips.txt": tt.previousTrips}
+			currentRoutes := tt.currentRoutes
+			if currentRoutes == "" {
+				currentRoutes = routes
+			}
+			current := map[string]string{"calendar.txt": calendar, "routes.txt": currentRoutes, "trips.txt": tt.currentTrips}
+
+			notices := runDiffValidator(t, NewTripCountDiffValidator(20, NewTripCounts()), previous, current)
+			assertNoticeCodes(t, notices, tt.expectedNoticeCodes)
+
+			for _, n := range notices {
+				// January 2025 has 23 weekdays
+				if count := n.Context()["affectedDateCount"]; count != 23 {
+					t.Errorf("Expected 23 affected dates, got %v", count)
+				}
+				if n.Context()["previousTripCount"] == 0 && n.Context()["changePercent"] != 100.0 {
+					t.Errorf("Expected a 100%% increase for a route without previous trips, got %v", n.Context()["changePercent"])
+				}
+			}
+		})
+	}
+}
+
+func TestTripCounts_ReadsEachFeedOnce(t *testing.T) {
+	files := map[string]string{
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"WK,1,1,1,1,1,0,0,20250101,20250131",
+		"routes.txt": "route_id,route_short_name,route_type\nR1,1,3",
+		"trips.txt":  "route_id,service_id,trip_id\nR1,WK,T1",
+	}
+	previous := testutil.CreateTestFeedLoader(t, files)
+	current := testutil.CreateTestFeedLoader(t, files)
+
+	reads := make(map[*parser.FeedLoader]int)
+	for _, loader := range []*parser.FeedLoader{previous, current} {
+		loader.SetAccessRecorder(func(filename string) {
+			if filename == "trips.txt" {
+				reads[loader]++
+			}
+		})
+	}
+
+	tripCounts := NewTripCounts()
+	container := notice.NewNoticeContainer()
+	config := gtfsvalidator.Config{CurrentDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	NewTripCountDiffValidator(20, tripCounts).ValidateDiff(previous, current, container, config)
+	NewServiceDatesDiffValidator(tripCounts).ValidateDiff(previous, current, container, config)
+
+	if reads[previous] != 1 || reads[current] != 1 {
+		t.Errorf("Expected trips.txt to be read once per feed, got %d and %d", reads[previous], reads[current])
+	}
+}
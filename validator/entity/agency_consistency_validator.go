@@ -2,9 +2,9 @@ package entity
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -50,11 +50,11 @@ func (v *AgencyConsistencyValidator) loadAgencies(loader *parser.FeedLoader) []*
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "agency.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "agency.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "agency.txt", loader.CSVOptions())
 	if err != nil {
 		return agencies
 	}
@@ -115,11 +115,11 @@ func (v *AgencyConsistencyValidator) validateRouteAgencyReferences(loader *parse
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "routes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "routes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "routes.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -2,10 +2,10 @@ package entity
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -48,11 +48,11 @@ func (v *AttributionWithoutRoleValidator) loadAttributions(loader *parser.FeedLo
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "attributions.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "attributions.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "attributions.txt", loader.CSVOptions())
 	if err != nil {
 		return attributions
 	}
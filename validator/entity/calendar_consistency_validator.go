@@ -2,11 +2,11 @@ package entity
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -84,11 +84,11 @@ func (v *CalendarConsistencyValidator) loadCalendarServices(loader *parser.FeedL
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar.txt", loader.CSVOptions())
 	if err != nil {
 		return services
 	}
@@ -167,11 +167,11 @@ func (v *CalendarConsistencyValidator) loadCalendarDates(loader *parser.FeedLoad
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "calendar_dates.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "calendar_dates.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "calendar_dates.txt", loader.CSVOptions())
 	if err != nil {
 		return calendarDates
 	}
@@ -339,11 +339,11 @@ func (v *CalendarConsistencyValidator) getUsedServiceIDs(loader *parser.FeedLoad
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return usedServices
 	}
@@ -2,10 +2,10 @@ package entity
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -61,11 +61,11 @@ func (v *DuplicateRouteNameValidator) loadRoutes(loader *parser.FeedLoader) []Ro
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "routes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "routes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "routes.txt", loader.CSVOptions())
 	if err != nil {
 		return routes
 	}
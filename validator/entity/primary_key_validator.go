@@ -2,8 +2,8 @@ package entity
 
 import (
 	"io"
-	"log"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -34,11 +34,11 @@ func (v *PrimaryKeyValidator) validateFile(loader *parser.FeedLoader, container
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -78,13 +78,15 @@ func (v *PrimaryKeyValidator) validateFile(loader *parser.FeedLoader, container
 				))
 			} else {
 				// For composite keys, we'll use the first field in the notice
-				container.AddNotice(notice.NewDuplicateKeyNotice(
+				n := notice.NewDuplicateKeyNotice(
 					filename,
 					primaryKeyFields[0],
 					key, // Use the composite key as the value
 					firstRow,
 					row.RowNumber,
-				))
+				)
+				n.SetPrimaryKey(primaryKeyValues(row, primaryKeyFields))
+				container.AddNotice(n)
 			}
 		} else {
 			seenKeys[key] = row.RowNumber
@@ -92,6 +94,15 @@ func (v *PrimaryKeyValidator) validateFile(loader *parser.FeedLoader, container
 	}
 }
 
+// primaryKeyValues returns the row's values of the primary key fields
+func primaryKeyValues(row *parser.CSVRow, fields []string) map[string]string {
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		values[field] = row.Values[field]
+	}
+	return values
+}
+
 // buildCompositeKey builds a composite key from multiple fields
 func (v *PrimaryKeyValidator) buildCompositeKey(row *parser.CSVRow, fields []string) string {
 	if len(fields) == 1 {
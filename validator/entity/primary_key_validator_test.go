@@ -120,6 +120,29 @@ func TestPrimaryKeyValidator_Validate(t *testing.T) {
 	}
 }
 
+func TestPrimaryKeyValidator_CompositeKeyLocation(t *testing.T) {
+	loader := testutil.CreateTestFeedLoader(t, map[string]string{
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\nT1,08:00:00,08:00:00,S1,1\nT1,08:05:00,08:05:00,S2,1",
+	})
+	container := notice.NewNoticeContainer()
+	NewPrimaryKeyValidator().Validate(loader, container, gtfsvalidator.Config{})
+
+	notices := container.GetNotices()
+	if len(notices) != 1 {
+		t.Fatalf("Expected 1 notice, got %d", len(notices))
+	}
+	location := notices[0].Location()
+	if location.File != "stop_times.txt" || location.RowNumber != 2 || len(location.OtherRowNumbers) != 1 || location.OtherRowNumbers[0] != 3 {
+		t.Errorf("Unexpected location: %+v", location)
+	}
+	if location.PrimaryKey["trip_id"] != "T1" || location.PrimaryKey["stop_sequence"] != "1" {
+		t.Errorf("Expected composite primary key, got %v", location.PrimaryKey)
+	}
+	if ids := location.EntityIDs("trip"); len(ids) != 1 || ids[0] != "T1" {
+		t.Errorf("Expected trip T1 to be related, got %v", ids)
+	}
+}
+
 func TestPrimaryKeyValidator_GetPrimaryKeyFields(t *testing.T) {
 	validator := NewPrimaryKeyValidator()
 
@@ -2,12 +2,12 @@ package entity
 
 import (
 	"io"
-	"log"
 	"math"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -63,11 +63,11 @@ func (v *ShapeValidator) loadShapes(loader *parser.FeedLoader) map[string]*Shape
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "shapes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "shapes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "shapes.txt", loader.CSVOptions())
 	if err != nil {
 		return shapes
 	}
@@ -315,11 +315,11 @@ func (v *ShapeValidator) loadUsedShapes(loader *parser.FeedLoader) map[string]bo
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return usedShapes
 	}
@@ -2,10 +2,10 @@ package fare
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -86,11 +86,11 @@ func (v *FareValidator) loadFareAttributes(loader *parser.FeedLoader) map[string
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "fare_attributes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "fare_attributes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "fare_attributes.txt", loader.CSVOptions())
 	if err != nil {
 		return fareAttributes
 	}
@@ -166,11 +166,11 @@ func (v *FareValidator) loadFareRules(loader *parser.FeedLoader) []*FareRuleInfo
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "fare_rules.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "fare_rules.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "fare_rules.txt", loader.CSVOptions())
 	if err != nil {
 		return fareRules
 	}
@@ -2,9 +2,9 @@ package validator
 
 import (
 	"io"
-	"log"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 )
@@ -35,20 +35,17 @@ func (v *FileStructureValidator) validateFile(loader *parser.FeedLoader, contain
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", filename), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, filename)
+	csvFile, err := parser.NewCSVFileWithOptions(reader, filename, loader.CSVOptions())
 	if err != nil {
 		if strings.Contains(err.Error(), "empty file") {
 			container.AddNotice(notice.NewEmptyFileNotice(filename))
 		} else {
 			// Add a CSV parsing error notice
-			container.AddNotice(notice.NewBaseNotice("csv_parsing_failed", notice.ERROR, map[string]interface{}{
-				"filename": filename,
-				"error":    err.Error(),
-			}))
+			container.AddNotice(newFileParsingFailedNotice(filename, err))
 		}
 		return
 	}
@@ -56,10 +53,7 @@ func (v *FileStructureValidator) validateFile(loader *parser.FeedLoader, contain
 	// Check for empty file (no data rows)
 	err = csvFile.ReadAll()
 	if err != nil && err != io.EOF {
-		container.AddNotice(notice.NewBaseNotice("csv_parsing_failed", notice.ERROR, map[string]interface{}{
-			"filename": filename,
-			"error":    err.Error(),
-		}))
+		container.AddNotice(newFileParsingFailedNotice(filename, err))
 		return
 	}
 
@@ -176,3 +170,13 @@ func contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+// newFileParsingFailedNotice reports a file that could not be parsed as CSV
+func newFileParsingFailedNotice(filename string, err error) notice.Notice {
+	n := notice.NewBaseNotice("csv_parsing_failed", notice.ERROR, map[string]interface{}{
+		"filename": filename,
+		"error":    err.Error(),
+	})
+	n.SetLocation(notice.NoticeLocation{File: filename})
+	return n
+}
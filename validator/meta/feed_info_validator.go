@@ -2,10 +2,10 @@ package meta
 
 import (
 	"io"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -63,11 +63,11 @@ func (v *FeedInfoValidator) loadFeedInfo(loader *parser.FeedLoader) []*FeedInfo
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "feed_info.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "feed_info.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "feed_info.txt", loader.CSVOptions())
 	if err != nil {
 		return feedInfos
 	}
@@ -2,10 +2,10 @@ package relationship
 
 import (
 	"io"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -66,11 +66,11 @@ func (v *AttributionValidator) loadAttributions(loader *parser.FeedLoader) []*At
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "attributions.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "attributions.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "attributions.txt", loader.CSVOptions())
 	if err != nil {
 		return attributions
 	}
@@ -350,11 +350,11 @@ func (v *AttributionValidator) loadAgencyIDs(loader *parser.FeedLoader) map[stri
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "agency.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "agency.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "agency.txt", loader.CSVOptions())
 	if err != nil {
 		return agencies
 	}
@@ -386,11 +386,11 @@ func (v *AttributionValidator) loadRouteIDs(loader *parser.FeedLoader) map[strin
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "routes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "routes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "routes.txt", loader.CSVOptions())
 	if err != nil {
 		return routes
 	}
@@ -422,11 +422,11 @@ func (v *AttributionValidator) loadTripIDs(loader *parser.FeedLoader) map[string
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "trips.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "trips.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "trips.txt", loader.CSVOptions())
 	if err != nil {
 		return trips
 	}
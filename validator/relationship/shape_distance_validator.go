@@ -2,11 +2,11 @@ package relationship
 
 import (
 	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
 	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
 	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
 	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
@@ -36,11 +36,11 @@ func (v *ShapeDistanceValidator) Validate(loader *parser.FeedLoader, container *
 	}
 	defer func() {
 		if closeErr := reader.Close(); closeErr != nil {
-			log.Printf("Warning: failed to close reader %v", closeErr)
+			loader.Logger().Warn("Failed to close reader", logging.String("file", "shapes.txt"), logging.ErrorField("error", closeErr))
 		}
 	}()
 
-	csvFile, err := parser.NewCSVFile(reader, "shapes.txt")
+	csvFile, err := parser.NewCSVFileWithOptions(reader, "shapes.txt", loader.CSVOptions())
 	if err != nil {
 		return
 	}
@@ -18,3 +18,21 @@ type Validator interface {
 	// Validate performs validation and adds notices to the container
 	Validate(loader *parser.FeedLoader, container *notice.NoticeContainer, config Config)
 }
+
+// DiffValidator is the interface for validators that compare two versions of a feed
+type DiffValidator interface {
+	// ValidateDiff compares the previous feed version with the current one and adds notices to the container
+	ValidateDiff(previous *parser.FeedLoader, current *parser.FeedLoader, container *notice.NoticeContainer, config Config)
+}
+
+// NamedFeed is a feed passed to cross-feed validators, identified by a name used in notices
+type NamedFeed struct {
+	Name   string
+	Loader *parser.FeedLoader
+}
+
+// CrossFeedValidator is the interface for validators that check several feeds meant to be merged
+type CrossFeedValidator interface {
+	// ValidateFeeds checks the feeds against each other and adds notices to the container
+	ValidateFeeds(feeds []NamedFeed, container *notice.NoticeContainer, config Config)
+}
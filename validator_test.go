@@ -128,6 +128,28 @@ func TestWithOptions(t *testing.T) {
 		}
 	})
 
+	t.Run("WithDiffThresholds", func(t *testing.T) {
+		thresholds := DiffThresholds{TripCountChangePercent: 10, StopMovedMeters: 50, ShapeChangedMeters: 300}
+		validator := New(WithDiffThresholds(thresholds))
+		impl := validator.(*validatorImpl)
+		if impl.config.DiffThresholds != thresholds {
+			t.Errorf("Expected diff thresholds %+v, got %+v", thresholds, impl.config.DiffThresholds)
+		}
+	})
+
+	t.Run("WithArchiveLimits", func(t *testing.T) {
+		limits := ArchiveLimits{MaxEntries: 50, MaxFileSize: 1 << 20}
+		impl := New(WithArchiveLimits(limits)).(*validatorImpl)
+		if impl.config.ArchiveLimits != limits {
+			t.Errorf("Expected archive limits %+v, got %+v", limits, impl.config.ArchiveLimits)
+		}
+
+		impl = New(WithArchiveLimits(ArchiveLimits{MaxEntries: -1})).(*validatorImpl)
+		if impl.config.ArchiveLimits.MaxEntries != DefaultArchiveLimits().MaxEntries {
+			t.Errorf("Expected negative MaxEntries to be sanitized, got %d", impl.config.ArchiveLimits.MaxEntries)
+		}
+	})
+
 	t.Run("WithProgressCallback", func(t *testing.T) {
 		called := false
 		callback := func(info ProgressInfo) {
@@ -231,6 +253,16 @@ func TestValidatorInterface(t *testing.T) {
 	_ = validator.ValidateFileWithContext
 	_ = validator.ValidateReader
 	_ = validator.ValidateReaderWithContext
+	_ = validator.ValidateBytes
+	_ = validator.ValidateBytesWithContext
+	_ = validator.ValidateFS
+	_ = validator.ValidateFSWithContext
+	_ = validator.ValidateURL
+	_ = validator.ValidateURLWithContext
+	_ = validator.ValidateFeeds
+	_ = validator.ValidateFeedsWithContext
+	_ = validator.ValidateBatch
+	_ = validator.ValidateBatchWithContext
 	_ = validator.ValidateFileStream
 	_ = validator.ValidateFileStreamWithContext
 }
@@ -313,3 +345,45 @@ func TestNoticeCallback(t *testing.T) {
 		t.Errorf("Expected notice severity ERROR, got %s", receivedNotice.Severity)
 	}
 }
+
+func TestNoticeGroup_Locations(t *testing.T) {
+	group := NoticeGroup{
+		SampleNotices: []map[string]interface{}{
+			{"filename": "stops.txt", "csvRowNumber": 3, "stopId": "S1"},
+		},
+	}
+	locations := group.Locations()
+	if len(locations) != 1 || locations[0].File != "stops.txt" || locations[0].RowNumber != 3 {
+		t.Fatalf("Expected location derived from context, got %+v", locations)
+	}
+
+	group.SampleLocations = []NoticeLocation{{File: "routes.txt"}}
+	if locations := group.Locations(); locations[0].File != "routes.txt" {
+		t.Errorf("Expected recorded location to be used, got %+v", locations)
+	}
+}
+
+func TestValidationReport_SampleLocations(t *testing.T) {
+	zipPath := CreateTempZip(t, map[string]string{
+		"agency.txt": "agency_id,agency_name,agency_url,agency_timezone\nA1,Agency,https://example.com,America/New_York",
+		"stops.txt":  "stop_id,stop_name,stop_lat,stop_lon\nS1,Stop,40.0,-73.0\nS1,Duplicate,40.1,-73.1",
+		"routes.txt": "route_id,agency_id,route_short_name,route_type\nR1,A1,1,3",
+	})
+
+	report, err := New().ValidateFile(zipPath)
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	for _, group := range report.Notices {
+		if len(group.SampleLocations) != len(group.SampleNotices) {
+			t.Errorf("%s: expected one location per sample, got %d/%d", group.Code, len(group.SampleLocations), len(group.SampleNotices))
+		}
+		if group.Code == "duplicate_key" {
+			location := group.SampleLocations[0]
+			if location.File != "stops.txt" || location.PrimaryKey["stop_id"] != "S1" {
+				t.Errorf("Unexpected duplicate_key location: %+v", location)
+			}
+		}
+	}
+}
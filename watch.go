@@ -0,0 +1,311 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theoremus-urban-solutions/gtfs-validator/logging"
+	"github.com/theoremus-urban-solutions/gtfs-validator/notice"
+	"github.com/theoremus-urban-solutions/gtfs-validator/parser"
+	"github.com/theoremus-urban-solutions/gtfs-validator/report"
+	"github.com/theoremus-urban-solutions/gtfs-validator/validator"
+)
+
+// Watcher revalidates a feed directory as its files change. Each validator's
+// notices are kept together with the files it read, so a revalidation only
+// re-runs the validators that read a changed, added or removed file.
+type Watcher struct {
+	validator *validatorImpl
+	path      string
+
+	stamps map[string]fileStamp
+	runs   []validatorRun
+	report *ValidationReport
+}
+
+// WatchResult is the outcome of one validation run of a Watcher.
+type WatchResult struct {
+	// Report is the full validation report of the feed.
+	Report *ValidationReport
+
+	// Comparison compares Report with the previous run, nil on the first run.
+	Comparison *ReportComparison
+
+	// ChangedFiles lists the files modified, added or removed since the previous run.
+	ChangedFiles []string
+
+	// ValidatorsRun is the number of validators re-run; the others reused their notices.
+	ValidatorsRun int
+
+	// ValidatorsTotal is the number of validators that make up the report.
+	ValidatorsTotal int
+
+	// Duration is how long the run took.
+	Duration time.Duration
+}
+
+// fileStamp identifies a version of a feed file
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// validatorRun holds the notices of one validator and the files it read
+type validatorRun struct {
+	files   map[string]bool
+	notices []notice.Notice
+}
+
+// NewWatcher creates a watcher for a feed directory, configured like New.
+// Parsed data caching is not used, as it hides which files a validator reads.
+func NewWatcher(path string, opts ...Option) (*Watcher, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot access path: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, errors.New("watch requires a feed directory")
+	}
+
+	v, ok := New(opts...).(*validatorImpl)
+	if !ok {
+		return nil, errors.New("unexpected validator implementation")
+	}
+	return &Watcher{validator: v, path: path}, nil
+}
+
+// Validate validates the feed, re-running only the validators affected by the
+// files changed since the previous run. The first run validates everything.
+func (w *Watcher) Validate(ctx context.Context) (*WatchResult, error) {
+	startTime := time.Now()
+
+	stamps, err := snapshotFeedDir(w.path)
+	if err != nil {
+		return nil, err
+	}
+	changed, fileSetChanged := diffSnapshots(w.stamps, stamps)
+
+	loader, err := w.validator.config.loadFeed(w.path, func() (*parser.FeedLoader, error) {
+		return parser.LoadFromDirectory(w.path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load directory: %w", err)
+	}
+	defer func() {
+		if err := loader.Close(); err != nil {
+			loader.Logger().Warn("Failed to close loader", logging.String("feed", w.path), logging.ErrorField("error", err))
+		}
+	}()
+
+	affected := func(files map[string]bool) bool {
+		if fileSetChanged && files[parser.AllFiles] {
+			return true
+		}
+		for _, filename := range changed {
+			if files[filename] {
+				return true
+			}
+		}
+		return false
+	}
+
+	internalConfig := w.validator.createInternalConfig()
+	internalConfig.EnableCaching = false
+	internalValidator := newInternalValidator(internalConfig, w.validator.createValidationConfig())
+
+	internalReport, runs, rerun, err := internalValidator.ValidateIncrementalWithContext(ctx, loader, w.path, w.runs, affected)
+	validationReport, err := w.validator.finishReport(internalValidator, internalReport, startTime, err)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WatchResult{
+		Report:          validationReport,
+		ChangedFiles:    changed,
+		ValidatorsRun:   rerun,
+		ValidatorsTotal: len(runs),
+		Duration:        time.Since(startTime),
+	}
+	if w.report != nil {
+		result.Comparison = CompareReports(w.report, validationReport)
+	}
+
+	w.stamps = stamps
+	w.runs = runs
+	w.report = validationReport
+	return result, nil
+}
+
+// Watch validates the feed, then polls the directory every interval and revalidates
+// once the files have stopped changing for the debounce duration, so an editor
+// saving several files triggers a single run. Each result is passed to handle.
+// Watch returns the context error when ctx is done.
+func (w *Watcher) Watch(ctx context.Context, interval, debounce time.Duration, handle func(*WatchResult, error)) error {
+	handle(w.Validate(ctx))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var pending map[string]fileStamp
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		stamps, err := snapshotFeedDir(w.path)
+		if err != nil {
+			handle(nil, err)
+			continue
+		}
+		if changed, _ := diffSnapshots(w.stamps, stamps); len(changed) == 0 {
+			pending = nil
+			continue
+		}
+		if changed, _ := diffSnapshots(pending, stamps); pending == nil || len(changed) > 0 {
+			pending = stamps
+			lastChange = time.Now()
+		}
+		if time.Since(lastChange) < debounce {
+			continue
+		}
+
+		pending = nil
+		handle(w.Validate(ctx))
+	}
+}
+
+// snapshotFeedDir records the size and modification time of the feed files in dir
+func snapshotFeedDir(dir string) (map[string]fileStamp, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	stamps := make(map[string]fileStamp)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || (!strings.HasSuffix(name, ".txt") && !strings.HasSuffix(name, ".geojson")) {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			// Removed since the directory was read
+			continue
+		}
+		stamps[name] = fileStamp{size: info.Size(), modTime: info.ModTime()}
+	}
+	return stamps, nil
+}
+
+// diffSnapshots returns the sorted names of files modified, added or removed
+// between two snapshots, and whether files were added or removed
+func diffSnapshots(previous, current map[string]fileStamp) ([]string, bool) {
+	var changed []string
+	fileSetChanged := false
+	for name, stamp := range current {
+		previousStamp, existed := previous[name]
+		if !existed {
+			fileSetChanged = true
+		}
+		if !existed || previousStamp != stamp {
+			changed = append(changed, name)
+		}
+	}
+	for name := range previous {
+		if _, exists := current[name]; !exists {
+			fileSetChanged = true
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed, fileSetChanged
+}
+
+// ValidateIncrementalWithContext validates a feed, reusing the notices of the previous
+// runs whose files are not affected. Validators run one at a time so the files each one
+// reads can be recorded. It returns the report, the runs to pass to the next call and
+// the number of validators that were re-run.
+func (v *internalValidator) ValidateIncrementalWithContext(ctx context.Context, loader *parser.FeedLoader, feedPath string, previous []validatorRun, affected func(files map[string]bool) bool) (*report.ValidationReport, []validatorRun, int, error) {
+	startTime := time.Now()
+
+	if v.config.TranscodeToUTF8 {
+		loader.EnableTranscoding()
+	}
+	if v.config.RecoverMalformedCSV {
+		loader.EnableCSVRecovery()
+	}
+	v.feedLoader = loader
+
+	v.checkRequiredFiles()
+	v.initializeValidators()
+
+	validatorConfig := validator.Config{
+		CountryCode:     v.config.CountryCode,
+		CurrentDate:     v.config.CurrentDate,
+		MaxMemory:       v.config.MaxMemory,
+		ParallelWorkers: v.config.ParallelWorkers,
+	}
+
+	// The validator set only changes with the configuration, which a Watcher keeps
+	if len(previous) != len(v.validators) {
+		previous = nil
+	}
+
+	runs := make([]validatorRun, len(v.validators))
+	rerun := 0
+	for i, validatorImpl := range v.validators {
+		select {
+		case <-ctx.Done():
+			return nil, nil, 0, ctx.Err()
+		default:
+		}
+
+		if previous != nil && !affected(previous[i].files) {
+			runs[i] = previous[i]
+		} else {
+			runs[i] = v.runRecordingValidator(validatorImpl, validatorConfig)
+			rerun++
+		}
+
+		for _, n := range runs[i].notices {
+			v.noticeContainer.AddNotice(n)
+		}
+	}
+
+	feedInfo := v.collectFeedStatistics()
+	feedInfo.FeedPath = feedPath
+	return v.generateReport(feedInfo, startTime), runs, rerun, nil
+}
+
+// runRecordingValidator runs a validator into its own notice container while recording the files it reads.
+func (v *internalValidator) runRecordingValidator(validatorImpl validator.Validator, validatorConfig validator.Config) validatorRun {
+	run := validatorRun{files: make(map[string]bool)}
+	// The report keeps the first notices of each type in validator order, so each
+	// run never needs more notices per type than the report does
+	container := notice.NewNoticeContainerWithLimit(v.noticeContainer.MaxNoticesPerType())
+
+	var mu sync.Mutex
+	v.feedLoader.SetAccessRecorder(func(filename string) {
+		mu.Lock()
+		run.files[filename] = true
+		mu.Unlock()
+	})
+	defer v.feedLoader.SetAccessRecorder(nil)
+
+	v.runValidator(validatorImpl, container, func(container *notice.NoticeContainer) {
+		validatorImpl.Validate(v.feedLoader, container, validatorConfig)
+	})
+
+	run.notices = container.GetNotices()
+	return run
+}
@@ -0,0 +1,208 @@
+package gtfsvalidator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeFeedFile replaces a file of a feed directory
+func writeFeedFile(t *testing.T, dir, filename, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, filename), []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", filename, err)
+	}
+}
+
+func TestWatcher_Validate(t *testing.T) {
+	dir := writeFeedDir(t, "feed", MinimalValidGTFS())
+	watcher, err := NewWatcher(dir)
+	if err != nil {
+		t.Fatalf("NewWatcher failed: %v", err)
+	}
+	ctx := context.Background()
+
+	// assertMatchesFullValidation checks an incremental report against validating from scratch
+	assertMatchesFullValidation := func(t *testing.T, result *WatchResult) {
+		t.Helper()
+		full, err := New().ValidateFile(dir)
+		if err != nil {
+			t.Fatalf("ValidateFile failed: %v", err)
+		}
+		if result.Report.Summary.Counts != full.Summary.Counts {
+			t.Errorf("Expected counts %+v, got %+v", full.Summary.Counts, result.Report.Summary.Counts)
+		}
+	}
+
+	first, err := watcher.Validate(ctx)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if first.Comparison != nil {
+		t.Error("Expected no comparison on the first run")
+	}
+	if first.ValidatorsTotal == 0 || first.ValidatorsRun != first.ValidatorsTotal {
+		t.Errorf("Expected all validators to run, got %d of %d", first.ValidatorsRun, first.ValidatorsTotal)
+	}
+	assertMatchesFullValidation(t, first)
+
+	unchanged, err := watcher.Validate(ctx)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if unchanged.ValidatorsRun != 0 || len(unchanged.ChangedFiles) != 0 {
+		t.Errorf("Expected nothing to re-run, got %d validators for %v", unchanged.ValidatorsRun, unchanged.ChangedFiles)
+	}
+	if unchanged.Comparison == nil || unchanged.Comparison.HasChanges() {
+		t.Error("Expected an empty comparison for an unchanged feed")
+	}
+
+	writeFeedFile(t, dir, "stops.txt", `stop_id,stop_name,stop_lat,stop_lon
+stop_1,First Stop,95.0,-73.9851
+stop_2,Second Stop,40.7614,-73.9776`)
+	edited, err := watcher.Validate(ctx)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !reflect.DeepEqual(edited.ChangedFiles, []string{"stops.txt"}) {
+		t.Errorf("Expected stops.txt to be changed, got %v", edited.ChangedFiles)
+	}
+	if edited.ValidatorsRun == 0 || edited.ValidatorsRun >= edited.ValidatorsTotal {
+		t.Errorf("Expected only the validators reading stops.txt to re-run, got %d of %d", edited.ValidatorsRun, edited.ValidatorsTotal)
+	}
+	if len(edited.Comparison.NewCodes) == 0 {
+		t.Error("Expected new notice codes for an out-of-range latitude")
+	}
+	assertMatchesFullValidation(t, edited)
+
+	writeFeedFile(t, dir, "feed_info.txt", `feed_publisher_name,feed_publisher_url,feed_lang
+Test,https://example.com,en`)
+	added, err := watcher.Validate(ctx)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !reflect.DeepEqual(added.ChangedFiles, []string{"feed_info.txt"}) {
+		t.Errorf("Expected feed_info.txt to be added, got %v", added.ChangedFiles)
+	}
+	assertMatchesFullValidation(t, added)
+}
+
+func TestWatcher_ValidateKeepsNoticesBeyondDefaultLimit(t *testing.T) {
+	files := MinimalValidGTFS()
+	var stops strings.Builder
+	stops.WriteString(files["stops.txt"])
+	for i := 0; i < 150; i++ {
+		fmt.Fprintf(&stops, "\nextra_%d,Extra Stop,95.0,-73.9851", i)
+	}
+	files["stops.txt"] = stops.String()
+	dir := writeFeedDir(t, "feed", files)
+
+	// Comprehensive mode keeps up to 1000 notices per type
+	watcher, err := NewWatcher(dir, WithValidationMode(ValidationModeComprehensive))
+	if err != nil {
+		t.Fatalf("NewWatcher failed: %v", err)
+	}
+	result, err := watcher.Validate(context.Background())
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if count := countNotices(result.Report, "invalid_coordinate"); count < 150 {
+		t.Errorf("Expected every invalid_coordinate notice, got %d", count)
+	}
+}
+
+func TestWatcher_ValidateBoundsStoredNotices(t *testing.T) {
+	files := MinimalValidGTFS()
+	var stops strings.Builder
+	stops.WriteString(files["stops.txt"])
+	for i := 0; i < 80; i++ {
+		fmt.Fprintf(&stops, "\nextra_%d,Extra Stop,95.0,-73.9851", i)
+	}
+	files["stops.txt"] = stops.String()
+	dir := writeFeedDir(t, "feed", files)
+
+	// Performance mode keeps up to 50 notices per type
+	watcher, err := NewWatcher(dir, WithValidationMode(ValidationModePerformance))
+	if err != nil {
+		t.Fatalf("NewWatcher failed: %v", err)
+	}
+	result, err := watcher.Validate(context.Background())
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if count := countNotices(result.Report, "invalid_coordinate"); count != 50 {
+		t.Errorf("Expected 50 invalid_coordinate notices, got %d", count)
+	}
+
+	// Notices kept between runs are bounded by the same limit
+	for _, run := range watcher.runs {
+		counts := make(map[string]int)
+		for _, n := range run.notices {
+			counts[n.Code()]++
+			if counts[n.Code()] > 50 {
+				t.Fatalf("Expected at most 50 stored %s notices per validator", n.Code())
+			}
+		}
+	}
+}
+
+func TestWatcher_Watch(t *testing.T) {
+	dir := writeFeedDir(t, "feed", MinimalValidGTFS())
+	watcher, err := NewWatcher(dir)
+	if err != nil {
+		t.Fatalf("NewWatcher failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var results []*WatchResult
+	err = watcher.Watch(ctx, 10*time.Millisecond, 30*time.Millisecond, func(result *WatchResult, err error) {
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+			cancel()
+			return
+		}
+		results = append(results, result)
+		switch len(results) {
+		case 1:
+			writeFeedFile(t, dir, "routes.txt", `route_id,agency_id,route_short_name,route_long_name,route_type
+route_1,test_agency,1,Main Street,3`)
+		default:
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("Expected Watch to stop with context.Canceled, got %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(results))
+	}
+	if !reflect.DeepEqual(results[1].ChangedFiles, []string{"routes.txt"}) {
+		t.Errorf("Expected routes.txt to be changed, got %v", results[1].ChangedFiles)
+	}
+}
+
+func TestNewWatcher_RequiresDirectory(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"zip file", CreateTempZip(t, MinimalValidGTFS())},
+		{"missing path", filepath.Join(t.TempDir(), "missing")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWatcher(tt.path); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}